@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+)
+
+const testArtifactOtherJobID = "cccccccc-0000-0000-0000-000000000002"
+
+// setupArtifactDiff is setupArtifactDownload with a second job whose
+// artifacts differ from the first: the coverage report is unchanged, the
+// lockfile moves a version, the test results are gone and a bundle report
+// is new.
+func setupArtifactDiff(t *testing.T) *testenv.TestEnv {
+	t.Helper()
+	fake, env := setupArtifactDownload(t)
+	fake.AddJobArtifactsV3(testArtifactJobID,
+		fakeArtifactV3("coverage/index.html", fake.URL()+"/artifacts/coverage/index.html", 0),
+		fakeArtifactV3("test-results.xml", fake.URL()+"/artifacts/test-results.xml", 0),
+		fakeArtifactV3("package-lock.txt", fake.URL()+"/artifacts/a/package-lock.txt", 0),
+	)
+	fake.AddJobArtifactsV3(testArtifactOtherJobID,
+		fakeArtifactV3("coverage/index.html", fake.URL()+"/artifacts/b/coverage/index.html", 0),
+		fakeArtifactV3("package-lock.txt", fake.URL()+"/artifacts/b/package-lock.txt", 0),
+		fakeArtifactV3("bundle-size.txt", fake.URL()+"/artifacts/b/bundle-size.txt", 0),
+	)
+	fake.AddStaticFile("/artifacts/a/package-lock.txt", "left-pad 1.0.0\nreact 18.2.0\n")
+	fake.AddStaticFile("/artifacts/b/package-lock.txt", "left-pad 1.0.0\nreact 18.3.1\n")
+	fake.AddStaticFile("/artifacts/b/coverage/index.html", artifactCoverage)
+	fake.AddStaticFile("/artifacts/b/bundle-size.txt", "main.js 120 KiB\n")
+	return env
+}
+
+func TestArtifactDiff(t *testing.T) {
+	env := setupArtifactDiff(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"artifact", "diff", testArtifactJobID, testArtifactOtherJobID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+}
+
+func TestArtifactDiff_JSON(t *testing.T) {
+	env := setupArtifactDiff(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"artifact", "diff", testArtifactJobID, testArtifactOtherJobID, "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+
+	var got struct {
+		Changes []struct {
+			Path   string `json:"path"`
+			Status string `json:"status"`
+			Diff   string `json:"diff"`
+		} `json:"changes"`
+		Unchanged int `json:"unchanged"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &got))
+	assert.Check(t, cmp.Equal(got.Unchanged, 1))
+	assert.Assert(t, cmp.Len(got.Changes, 3))
+	assert.Check(t, cmp.Equal(got.Changes[0].Path, "bundle-size.txt"))
+	assert.Check(t, cmp.Equal(got.Changes[0].Status, "added"))
+	assert.Check(t, cmp.Equal(got.Changes[1].Status, "changed"))
+	assert.Check(t, cmp.Contains(got.Changes[1].Diff, "+react 18.3.1\n"))
+	assert.Check(t, cmp.Equal(got.Changes[2].Status, "removed"))
+}
+
+func TestArtifactDiff_Pattern(t *testing.T) {
+	env := setupArtifactDiff(t)
+
+	for _, tc := range []struct {
+		name   string
+		args   []string
+		stdout string
+		stderr string
+	}{
+		{
+			name:   "only the matching artifacts are compared",
+			args:   []string{"--pattern", "*.txt", "--json", "--jq", ".changes[].path"},
+			stdout: "bundle-size.txt\npackage-lock.txt\n",
+		},
+		{
+			name:   "nothing changed among the matches",
+			args:   []string{"--pattern", "coverage/**"},
+			stderr: "No differences in 1 artifact(s).",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"artifact", "diff", testArtifactJobID, testArtifactOtherJobID}, tc.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+
+			assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+			assert.Check(t, cmp.Equal(result.Stdout, tc.stdout))
+			assert.Check(t, cmp.Contains(result.Stderr, tc.stderr))
+		})
+	}
+}
+
+func TestArtifactDiff_InvalidArgs(t *testing.T) {
+	env := setupArtifactDiff(t)
+
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing second job", []string{testArtifactJobID}, "job-b"},
+		{"bad pattern", []string{testArtifactJobID, testArtifactOtherJobID, "--pattern", "[abc"}, "[abc"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"artifact", "diff"}, tc.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+
+			assert.Check(t, cmp.Equal(result.ExitCode, 2))
+			assert.Check(t, cmp.Contains(result.Stderr, tc.want))
+		})
+	}
+}
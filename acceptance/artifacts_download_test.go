@@ -0,0 +1,355 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"crypto/md5" //#nosec:G501 // the fake store's ETags are MD5s
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/httprecorder"
+)
+
+const (
+	artifactCoverage = "<html>coverage</html>"
+	artifactResults  = "<testsuite><testcase name=\"ok\"/></testsuite>"
+)
+
+// setupArtifactDownload is setupArtifactFake with the artifacts' content
+// served, so they can be downloaded.
+func setupArtifactDownload(t *testing.T) (*fakes.CircleCI, *testenv.TestEnv) {
+	t.Helper()
+	fake, env := setupArtifactFake(t)
+	fake.AddStaticFile("/artifacts/coverage/index.html", artifactCoverage)
+	fake.AddStaticFile("/artifacts/test-results.xml", artifactResults)
+	return fake, env
+}
+
+// artifactFetches returns the GETs of path that fetched content, leaving out
+// the one-byte requests that ask the store for its size and ETag.
+func artifactFetches(fake *fakes.CircleCI, path string) []httprecorder.Request {
+	var fetches []httprecorder.Request
+	for _, req := range fake.FindRequests(http.MethodGet, url.URL{Path: path}) {
+		if req.Header.Get("Range") != "bytes=0-0" {
+			fetches = append(fetches, req)
+		}
+	}
+	return fetches
+}
+
+func TestArtifact_Pattern(t *testing.T) {
+	_, env := setupArtifactFake(t)
+
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"include", []string{"--pattern", "*.xml"}, "test-results.xml"},
+		{"exclude", []string{"--exclude", "coverage/**"}, "test-results.xml"},
+		{"both", []string{"--pattern", "**", "--exclude", "*.xml"}, "coverage/index.html"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"artifact", testArtifactJobID, "--json", "--jq", ".[].path"}, tc.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+
+			assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+			assert.Check(t, cmp.Equal(result.Stdout, tc.want+"\n"))
+		})
+	}
+}
+
+func TestArtifact_Pattern_NoMatch(t *testing.T) {
+	_, env := setupArtifactFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"artifact", testArtifactJobID, "--pattern", "*.png"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Equal(result.Stdout, ""))
+	assert.Check(t, cmp.Contains(result.Stderr, "None of the 2 artifact(s) match the given patterns."))
+}
+
+// TestArtifact_Download_SkipsPresent downloads twice into the same directory:
+// the second run finds both files already there and fetches nothing.
+func TestArtifact_Download_SkipsPresent(t *testing.T) {
+	fake, env := setupArtifactDownload(t)
+	downloadDir := t.TempDir()
+	download := func() binary.CLIResult {
+		return binary.RunCLI(t, binary.RunOpts{
+			Binary:  binaryPath,
+			Args:    []string{"artifact", testArtifactJobID, "--output", downloadDir, "--parallel", "2"},
+			Env:     env.Environ(),
+			WorkDir: t.TempDir(),
+		})
+	}
+
+	first := download()
+	assert.Equal(t, first.ExitCode, 0, "stderr: %s", first.Stderr)
+	assert.Check(t, cmp.Contains(first.Stderr, "Downloaded 2 artifact(s)\n"))
+
+	second := download()
+	assert.Equal(t, second.ExitCode, 0, "stderr: %s", second.Stderr)
+	assert.Check(t, cmp.Contains(second.Stderr, "Downloaded 0 artifact(s), 2 already present"))
+	assert.Check(t, cmp.Len(artifactFetches(fake, "/artifacts/test-results.xml"), 1))
+	assert.Check(t, cmp.Len(artifactFetches(fake, "/artifacts/coverage/index.html"), 1))
+}
+
+// TestArtifact_Download_Resume finishes a download an earlier attempt left
+// part done, asking only for the bytes still missing.
+func TestArtifact_Download_Resume(t *testing.T) {
+	fake, env := setupArtifactDownload(t)
+	downloadDir := t.TempDir()
+	sum := md5.Sum([]byte(artifactResults)) //#nosec:G401 // see the import
+	part := filepath.Join(downloadDir, "test-results.xml.part")
+	assert.NilError(t, os.WriteFile(part, []byte(artifactResults[:12]), 0o600))
+	assert.NilError(t, os.WriteFile(part+".etag", []byte(`"`+hex.EncodeToString(sum[:])+`"`), 0o600))
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"artifact", testArtifactJobID, "--output", downloadDir, "--pattern", "*.xml"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "Downloaded 1 artifact(s), 1 resumed"))
+	data, err := os.ReadFile(filepath.Join(downloadDir, "test-results.xml"))
+	assert.NilError(t, err)
+	assert.Check(t, cmp.Equal(string(data), artifactResults))
+	_, err = os.Stat(part)
+	assert.Check(t, os.IsNotExist(err), "partial download is cleaned up")
+
+	reqs := artifactFetches(fake, "/artifacts/test-results.xml")
+	assert.Assert(t, cmp.Len(reqs, 1))
+	assert.Check(t, cmp.Equal(reqs[0].Header.Get("Range"), "bytes=12-"))
+	_, err = os.Stat(filepath.Join(downloadDir, "coverage"))
+	assert.Check(t, os.IsNotExist(err), "--pattern limits what is downloaded")
+}
+
+// TestArtifact_Download_HEADRejected runs skip and resume against a store
+// that refuses HEAD, as storage behind GET-signed URLs does: neither relies
+// on it.
+func TestArtifact_Download_HEADRejected(t *testing.T) {
+	for _, status := range []int{http.StatusForbidden, http.StatusMethodNotAllowed} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			fake, env := setupArtifactDownload(t)
+			fake.SetStaticFileHEADStatus(status)
+			downloadDir := t.TempDir()
+			sum := md5.Sum([]byte(artifactResults)) //#nosec:G401 // see the import
+			part := filepath.Join(downloadDir, "test-results.xml.part")
+			assert.NilError(t, os.WriteFile(part, []byte(artifactResults[:12]), 0o600))
+			assert.NilError(t, os.WriteFile(part+".etag", []byte(`"`+hex.EncodeToString(sum[:])+`"`), 0o600))
+			download := func() binary.CLIResult {
+				return binary.RunCLI(t, binary.RunOpts{
+					Binary:  binaryPath,
+					Args:    []string{"artifact", testArtifactJobID, "--output", downloadDir},
+					Env:     env.Environ(),
+					WorkDir: t.TempDir(),
+				})
+			}
+
+			first := download()
+			assert.Equal(t, first.ExitCode, 0, "stderr: %s", first.Stderr)
+			assert.Check(t, cmp.Contains(first.Stderr, "Downloaded 2 artifact(s), 1 resumed\n"))
+
+			second := download()
+			assert.Equal(t, second.ExitCode, 0, "stderr: %s", second.Stderr)
+			assert.Check(t, cmp.Contains(second.Stderr, "Downloaded 0 artifact(s), 2 already present"))
+			assert.Check(t, cmp.Len(fake.FindRequests(http.MethodHead, url.URL{Path: "/artifacts/test-results.xml"}), 0))
+			reqs := artifactFetches(fake, "/artifacts/test-results.xml")
+			assert.Assert(t, cmp.Len(reqs, 1))
+			assert.Check(t, cmp.Equal(reqs[0].Header.Get("Range"), "bytes=12-"))
+		})
+	}
+}
+
+const (
+	latestProjectID = "a0000000-0000-4000-8000-0000000a7001"
+	latestBuildJob  = "d0000000-0000-4000-8000-0000000a7202"
+)
+
+// setupArtifactLatest registers three runs on main, newest first: one whose
+// build failed, one whose build succeeded and produced artifacts, and an older
+// success that must not be reached.
+func setupArtifactLatest(t *testing.T) *testenv.TestEnv {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, watchSlug, latestProjectID)
+
+	for i, outcome := range []string{"failed", "succeeded", "succeeded"} {
+		n := string(rune('1' + i))
+		runID := "f0000000-0000-4000-8000-0000000a700" + n
+		wfID := "b0000000-0000-4000-8000-0000000a700" + n
+		fake.AddRunV3(runID, latestProjectID, fakeRunV3(runID, latestProjectID, "ended", outcome, "main", "abc1234def5678"))
+		fake.AddRunWorkflowsV3(runID, fakeWorkflowV3(wfID, "build-and-test", runID, latestProjectID, "ended", outcome))
+		build := fakeJobV3("d0000000-0000-4000-8000-0000000a720"+n, "build", wfID, latestProjectID)
+		build.Outcome = outcome
+		fake.AddWorkflowJobsV3(wfID,
+			fakeJobV3("d0000000-0000-4000-8000-0000000a710"+n, "lint", wfID, latestProjectID),
+			build,
+		)
+	}
+	fake.AddJobArtifactsV3(latestBuildJob,
+		fakeArtifactV3("dist/app.tar.gz", fake.URL()+"/artifacts/dist/app.tar.gz", 0),
+	)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return env
+}
+
+func TestArtifact_Latest(t *testing.T) {
+	env := setupArtifactLatest(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"artifact", "--latest", "--project", watchSlug, "--branch", "main", "--job", "build"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+}
+
+func TestArtifact_Latest_JobNotFound(t *testing.T) {
+	env := setupArtifactLatest(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"artifact", "--latest", "--project", watchSlug, "--job", "deploy"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 5))
+	assert.Check(t, cmp.Contains(result.Stderr, `No "deploy" job succeeded in the last 3 runs on main of `+watchSlug))
+}
+
+func TestArtifact_InvalidFlags(t *testing.T) {
+	env := testenv.New(t)
+	env.Token = testToken
+
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"latest without job", []string{"--latest"}, "--latest requires --job"},
+		{"latest with job ID", []string{testArtifactJobID, "--latest", "--job", "build"}, "Pass either a job ID or --latest, not both."},
+		{"job without latest", []string{testArtifactJobID, "--job", "build"}, "--job only applies with --latest."},
+		{"parallel below one", []string{testArtifactJobID, "--parallel", "0"}, "--parallel must be at least 1."},
+		{"malformed pattern", []string{testArtifactJobID, "--pattern", "[unclosed"}, `invalid pattern "[unclosed"`},
+		{"browse with json", []string{testArtifactJobID, "--browse", "--json"}, "--browse and --json cannot be used together."},
+		{"browse without a terminal", []string{testArtifactJobID, "--browse"}, "--browse needs an interactive terminal."},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"artifact"}, tc.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+
+			assert.Check(t, cmp.Equal(result.ExitCode, 2))
+			assert.Check(t, cmp.Contains(result.Stderr, tc.want))
+		})
+	}
+}
+
+// TestArtifact_Browse drives --browse against the real binary: the preview
+// follows the cursor, space marks files in two places, and enter downloads them
+// together under ./artifacts.
+func TestArtifact_Browse(t *testing.T) {
+	_, env := setupArtifactDownload(t)
+	workDir := t.TempDir()
+	console := binary.RunCLIInteractive(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"artifact", testArtifactJobID, "--browse"},
+		Env:     env.Environ(),
+		WorkDir: workDir,
+	})
+
+	assert.Assert(t, t.Run("the tree opens with the directory's files previewed", func(t *testing.T) {
+		_, err := console.ExpectString("coverage/  (1 file)")
+		assert.NilError(t, err)
+		_, err = console.ExpectString("index.html")
+		assert.NilError(t, err)
+	}))
+
+	assert.Assert(t, t.Run("moving to a file previews its content", func(t *testing.T) {
+		_, err := console.Send(keyDown)
+		assert.NilError(t, err)
+		_, err = console.ExpectString(`<testcase name="ok"/>`)
+		assert.NilError(t, err)
+	}))
+
+	assert.Assert(t, t.Run("space marks, enter downloads the marked files", func(t *testing.T) {
+		_, err := console.Send(" " + keyUp + " ")
+		assert.NilError(t, err)
+		_, err = console.ExpectString("2 files marked")
+		assert.NilError(t, err)
+		_, err = console.Send("\r")
+		assert.NilError(t, err)
+		_, err = console.ExpectString("Downloaded 2 files to ./artifacts")
+		assert.NilError(t, err)
+	}))
+
+	assert.Assert(t, t.Run("quitting reports the downloads", func(t *testing.T) {
+		_, err := console.Send("q")
+		assert.NilError(t, err)
+		_, err = console.ExpectString("Downloaded 2 artifact(s) to ./artifacts")
+		assert.NilError(t, err)
+	}))
+
+	t.Run("both files landed under ./artifacts", func(t *testing.T) {
+		body, err := os.ReadFile(filepath.Join(workDir, "artifacts", "coverage", "index.html"))
+		assert.NilError(t, err)
+		assert.Check(t, cmp.Equal(string(body), artifactCoverage))
+
+		body, err = os.ReadFile(filepath.Join(workDir, "artifacts", "test-results.xml"))
+		assert.NilError(t, err)
+		assert.Check(t, cmp.Equal(string(body), artifactResults))
+	})
+}
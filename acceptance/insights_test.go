@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+// insightsRuns builds n runs of a workflow on branch, newest first, the
+// oldest taking base seconds and each later one step seconds longer.
+func insightsRuns(branch string, n int, base, step int64) []fakes.InsightsRun {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	runs := make([]fakes.InsightsRun, n)
+	for i := range runs {
+		nth := n - 1 - i // 0 is the oldest
+		runs[i] = fakes.InsightsRun{
+			ID:        fmt.Sprintf("e0000000-0000-4000-8000-%012d", i),
+			Branch:    branch,
+			Status:    "success",
+			Duration:  base + int64(nth)*step,
+			CreatedAt: start.Add(time.Duration(nth) * time.Hour),
+		}
+	}
+	return runs
+}
+
+// setupInsights registers two workflows on the default branch — build, with
+// 25 runs to page through, and deploy — the build workflow's jobs and their
+// daily trend, its two branches, and two flaky tests.
+func setupInsights(t *testing.T) *testenv.TestEnv {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+
+	build := fakes.InsightsSummary{Name: "build", SuccessRate: 0.92, TotalRuns: 25, FailedRuns: 2,
+		Throughput: 0.8, MTTR: 3720, CreditsUsed: 12500, P50: 252, P95: 423}
+	deploy := fakes.InsightsSummary{Name: "deploy", SuccessRate: 1, TotalRuns: 4, Throughput: 0.1, CreditsUsed: 800, P50: 95, P95: 110}
+	fake.AddWorkflowInsights(watchSlug, "", build, deploy)
+	fake.AddWorkflowInsights(watchSlug, fakes.InsightsAllBranches, deploy)
+	fake.AddWorkflowRunInsights(watchSlug, "build", insightsRuns("main", 25, 60, 10)...)
+	fake.AddWorkflowRunInsights(watchSlug, "deploy", insightsRuns("main", 4, 90, 5)...)
+
+	fake.AddJobInsights(watchSlug, "build", "",
+		fakes.InsightsSummary{Name: "test", SuccessRate: 0.9, TotalRuns: 25, FailedRuns: 3, Throughput: 0.8, CreditsUsed: 9000, P50: 180, P95: 300},
+		fakes.InsightsSummary{Name: "lint", SuccessRate: 1, TotalRuns: 25, Throughput: 0.8, CreditsUsed: 500, P50: 30, P95: 41},
+	)
+	day := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	for i, median := range []int64{90, 150, 240} {
+		fake.AddJobInsightsSeries(watchSlug, "build",
+			fakes.InsightsJobBucket{Name: "test", Timestamp: day.AddDate(0, 0, i), TotalRuns: 8, Median: median},
+			fakes.InsightsJobBucket{Name: "lint", Timestamp: day.AddDate(0, 0, i), TotalRuns: 8, Median: 30},
+		)
+	}
+
+	fake.SetInsightsBranches(watchSlug, "build", "main", "feature/login", "stale")
+	fake.AddWorkflowInsights(watchSlug, "main", build)
+	fake.AddWorkflowInsights(watchSlug, "feature/login", fakes.InsightsSummary{Name: "build", SuccessRate: 0.5,
+		TotalRuns: 6, FailedRuns: 3, Throughput: 0.2, MTTR: 600, CreditsUsed: 3000, P50: 300, P95: 480})
+	fake.AddWorkflowRunInsights(watchSlug, "build", insightsRuns("feature/login", 6, 400, -40)...)
+
+	fake.AddFlakyTests(watchSlug,
+		fakes.FlakyTest{TestName: "TestLogin", Classname: "auth", JobName: "test", WorkflowName: "build", TimesFlaked: 3, TimeWasted: 540},
+		fakes.FlakyTest{TestName: "TestCheckout", Classname: "cart", JobName: "test", WorkflowName: "build", TimesFlaked: 7, TimeWasted: 1260},
+	)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return env
+}
+
+func TestInsights(t *testing.T) {
+	env := setupInsights(t)
+
+	for _, tc := range []struct {
+		name string
+		args []string
+	}{
+		{"Workflows", []string{"workflows"}},
+		{"Jobs", []string{"jobs", "build"}},
+		{"Branches", []string{"branches", "build"}},
+		{"FlakyTests", []string{"flaky-tests"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"insights", "--project", watchSlug}, tc.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+
+			assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+			assert.Check(t, golden.String(result.Stdout, "TestInsights_"+tc.name+".txt"))
+		})
+	}
+}
+
+func TestInsightsWorkflows_JSON(t *testing.T) {
+	env := setupInsights(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"insights", "workflows", "--project", watchSlug, "--window", "7d", "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+
+	var got struct {
+		Project string `json:"project"`
+		Window  string `json:"window"`
+		Items   []struct {
+			Name        string  `json:"name"`
+			SuccessRate float64 `json:"success_rate"`
+			DurationP95 int64   `json:"duration_p95"`
+			MTTR        *int64  `json:"mttr"`
+			Trend       []int64 `json:"trend"`
+		} `json:"items"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &got))
+	assert.Check(t, cmp.Equal(got.Project, watchSlug))
+	assert.Check(t, cmp.Equal(got.Window, "7d"))
+	assert.Assert(t, cmp.Len(got.Items, 2))
+	build := got.Items[0]
+	assert.Check(t, cmp.Equal(build.SuccessRate, 0.92))
+	assert.Check(t, cmp.Equal(build.DurationP95, int64(423)))
+	assert.Assert(t, build.MTTR != nil)
+	assert.Check(t, cmp.Equal(*build.MTTR, int64(3720)))
+	// The fake lists every branch's runs without ?branch=: 25 on main and 6 on
+	// feature/login, paged in and cut to the last 30.
+	assert.Check(t, cmp.Len(build.Trend, 30))
+}
+
+func TestInsightsWorkflows_AllBranches(t *testing.T) {
+	env := setupInsights(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"insights", "workflows", "--project", watchSlug, "--all-branches", "--json", "--jq", ".items[].name"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Equal(result.Stdout, "deploy\n"))
+}
+
+func TestInsightsBranches_Limit(t *testing.T) {
+	env := setupInsights(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"insights", "branches", "build", "--project", watchSlug, "--limit", "1", "--json", "--jq", ".items[].name"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Equal(result.Stdout, "main\n"))
+}
+
+func TestInsights_InvalidArgs(t *testing.T) {
+	env := setupInsights(t)
+
+	for _, tc := range []struct {
+		name string
+		args []string
+		code int
+		want string
+	}{
+		{"missing workflow", []string{"jobs"}, 2, "<workflow>"},
+		{"bad window", []string{"workflows", "--window", "14d"}, 2, `--window must be one of 24h, 7d, 30d, 60d, 90d, not "14d".`},
+		{"branch and all branches", []string{"workflows", "--branch", "main", "--all-branches"}, 2, "--branch and --all-branches cannot be used together."},
+		{"negative limit", []string{"branches", "build", "--limit", "-1"}, 2, "--limit must be zero or greater"},
+		{"unknown workflow", []string{"jobs", "nope"}, 5, `No insights found for workflow "nope".`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"insights", "--project", watchSlug}, tc.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+
+			assert.Check(t, cmp.Equal(result.ExitCode, tc.code))
+			assert.Check(t, cmp.Contains(result.Stderr, tc.want))
+		})
+	}
+}
+
+func TestInsights_ProjectNotFound(t *testing.T) {
+	env := setupInsights(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"insights", "flaky-tests", "--project", "gh/acme/unknown"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 5))
+	assert.Check(t, cmp.Contains(result.Stderr, `No insights found for project "gh/acme/unknown".`))
+}
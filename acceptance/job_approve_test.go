@@ -0,0 +1,249 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"net/http"
+	"net/url"
+	"runtime"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/httprecorder"
+)
+
+const (
+	testApprovalJobID      = "d0000000-0000-4000-8000-0000000000a1"
+	testApprovalWorkflowID = "c0000000-0000-4000-8000-0000000000a1"
+)
+
+// fakeApprovalJobV3 returns an approval job still waiting on a decision.
+func fakeApprovalJobV3(id, name, workflowID, projectID string) fakes.JobV3 {
+	job := fakeJobV3(id, name, workflowID, projectID)
+	job.Type = "approval"
+	job.Phase = "created"
+	job.Outcome = ""
+	job.StartedAt = ""
+	job.EndedAt = ""
+	return job
+}
+
+func setupJobApproveFake(t *testing.T) (*fakes.CircleCI, *testenv.TestEnv) {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+	approval := fakeApprovalJobV3(testApprovalJobID, "hold", testApprovalWorkflowID, runTestProjectID)
+	fake.AddJobV3(approval)
+	fake.AddWorkflowJobsV3(testApprovalWorkflowID,
+		fakeJobV3("d0000000-0000-4000-8000-0000000000b1", "build", testApprovalWorkflowID, runTestProjectID),
+		approval,
+	)
+	fake.SetJobApproveResponse(testApprovalWorkflowID, testApprovalJobID, http.StatusAccepted)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return fake, env
+}
+
+func TestJobApprove(t *testing.T) {
+	fake, env := setupJobApproveFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "approve", "--force", testApprovalJobID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+
+	t.Run("check request", func(t *testing.T) {
+		assert.Check(t, cmp.DeepEqual(fake.LastRequest(), &httprecorder.Request{
+			Method: http.MethodPost,
+			URL:    url.URL{Path: "/api/v2/workflow/" + testApprovalWorkflowID + "/approve/" + testApprovalJobID},
+			Header: http.Header{
+				"Authorization": {"Bearer test-token"},
+				"User-Agent":    {httpcl.UserAgent(runtime.GOOS, runtime.GOARCH, "dev", "")},
+			},
+			Body: new(``),
+		}, ignoreCommonHeaders))
+	})
+}
+
+func TestJobApprove_ByName(t *testing.T) {
+	fake, env := setupJobApproveFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "approve", "hold", "--workflow", testApprovalWorkflowID, "--force"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+	assert.Check(t, cmp.Equal(fake.LastRequest().URL.Path,
+		"/api/v2/workflow/"+testApprovalWorkflowID+"/approve/"+testApprovalJobID))
+}
+
+func TestJobApprove_NameRequiresWorkflow(t *testing.T) {
+	_, env := setupJobApproveFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "approve", "hold", "--force"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr) // ExitBadArguments
+	assert.Check(t, golden.String(result.Stderr, t.Name()+".stderr.txt"))
+}
+
+func TestJobApprove_NotApproval(t *testing.T) {
+	_, env := setupJobApproveFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "approve", "build", "--workflow", testApprovalWorkflowID, "--force"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr) // ExitBadArguments
+	assert.Check(t, golden.String(result.Stderr, t.Name()+".stderr.txt"))
+}
+
+func TestJobApprove_RequiresForce(t *testing.T) {
+	// In non-interactive mode (no TTY), --force is required.
+	_, env := setupJobApproveFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "approve", testApprovalJobID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 6, "stderr: %s", result.Stderr) // ExitCancelled
+	assert.Check(t, golden.String(result.Stderr, t.Name()+".stderr.txt"))
+}
+
+func TestJobApprove_NotFound(t *testing.T) {
+	_, env := setupJobApproveFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "approve", "--force", "00000000-0000-0000-0000-000000000000"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 5, "stderr: %s", result.Stderr) // ExitNotFound
+}
+
+func TestJobCancel(t *testing.T) {
+	fake := fakes.NewCircleCI(t)
+	fake.SetJobCancelResponse(testJobID, http.StatusAccepted)
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "cancel", "--force", testJobID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+
+	t.Run("check request", func(t *testing.T) {
+		assert.Check(t, cmp.DeepEqual(fake.LastRequest(), &httprecorder.Request{
+			Method: http.MethodPost,
+			URL:    url.URL{Path: "/api/v2/jobs/" + testJobID + "/cancel"},
+			Header: http.Header{
+				"Authorization": {"Bearer test-token"},
+				"User-Agent":    {httpcl.UserAgent(runtime.GOOS, runtime.GOARCH, "dev", "")},
+			},
+			Body: new(``),
+		}, ignoreCommonHeaders))
+	})
+}
+
+func TestJobCancel_RequiresForce(t *testing.T) {
+	// In non-interactive mode (no TTY), --force is required.
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fakes.NewCircleCI(t).URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "cancel", testJobID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 6, "stderr: %s", result.Stderr) // ExitCancelled
+	assert.Check(t, golden.String(result.Stderr, t.Name()+".stderr.txt"))
+}
+
+func TestJobCancel_NotFound(t *testing.T) {
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fakes.NewCircleCI(t).URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "cancel", "--force", "00000000-0000-0000-0000-000000000000"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 5, "stderr: %s", result.Stderr) // ExitNotFound
+}
+
+func TestJobCancel_InvalidJobID(t *testing.T) {
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fakes.NewCircleCI(t).URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "cancel", "--force", "not-a-uuid"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr) // ExitBadArguments
+	assert.Check(t, golden.String(result.Stderr, t.Name()+".stderr.txt"))
+}
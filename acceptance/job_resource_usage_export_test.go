@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+func setupUsageExport(t *testing.T) *testenv.TestEnv {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+	fake.AddJobResourceUsage(testJobID, parallelUsage())
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return env
+}
+
+// TestJobResourceUsageExport_SVG writes the charts as an SVG, which is text and
+// so can be pinned whole: both panels, a line and legend entry per execution,
+// and the limits ruled across.
+func TestJobResourceUsageExport_SVG(t *testing.T) {
+	env := setupUsageExport(t)
+	dir := t.TempDir()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "get", testJobID, "--export", "chart.svg"},
+		Env:     env.Environ(),
+		WorkDir: dir,
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Equal(result.Stdout, ""))
+	assert.Check(t, cmp.Contains(result.Stderr, "Wrote CPU and memory charts for 3 executions to chart.svg"))
+	svg, err := os.ReadFile(filepath.Join(dir, "chart.svg"))
+	assert.NilError(t, err)
+	assert.Check(t, golden.String(string(svg), t.Name()+".svg"))
+}
+
+func TestJobResourceUsageExport_PNG(t *testing.T) {
+	env := setupUsageExport(t)
+	dir := t.TempDir()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "get", testJobID, "--export", "chart.png"},
+		Env:     env.Environ(),
+		WorkDir: dir,
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	f, err := os.Open(filepath.Join(dir, "chart.png"))
+	assert.NilError(t, err)
+	defer func() { _ = f.Close() }()
+	cfg, err := png.DecodeConfig(f)
+	assert.NilError(t, err)
+	assert.Check(t, cfg.Width > 0 && cfg.Height > 0)
+}
+
+// TestJobResourceUsageExport_CSV writes one row per sample of every
+// execution, with the executions of different lengths unpadded.
+func TestJobResourceUsageExport_CSV(t *testing.T) {
+	env := setupUsageExport(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "get", testJobID, "--csv"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".csv"))
+}
+
+func TestJobResourceUsageExport_InvalidFlags(t *testing.T) {
+	env := setupUsageExport(t)
+
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"unknown format", []string{"--export", "chart.jpg"}, `Cannot tell the image format of "chart.jpg"`},
+		{"csv and json", []string{"--csv", "--json"}, "--csv and --json cannot be used together"},
+		{"export and json", []string{"--export", "chart.svg", "--json"}, "--export and --json cannot be used together"},
+		{"export and jq", []string{"--export", "chart.svg", "--jq", ".id"}, "--export and --jq cannot be used together"},
+		{"csv and jq", []string{"--csv", "--jq", ".id"}, "--csv and --jq cannot be used together"},
+		{"export and follow", []string{"--export", "chart.svg", "--follow"}, "cannot be used with --follow"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"job", "resource-usage", "get", testJobID}, tc.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+
+			assert.Check(t, cmp.Equal(result.ExitCode, 2))
+			assert.Check(t, cmp.Contains(result.Stderr, tc.want))
+		})
+	}
+}
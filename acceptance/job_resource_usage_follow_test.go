@@ -0,0 +1,212 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+// followedJob is the job --follow tests watch: spin-up for the first 10s, then
+// the tests from 15s on. While running, the test step has not stopped.
+func followedJob(phase string) fakes.JobV3 {
+	tests := fakes.JobStep{Name: "Run integration tests", Type: "run", Num: 102, Phase: "running", StartedAt: "2026-05-19T20:00:15Z"}
+	if phase == "ended" {
+		tests.Phase, tests.Outcome, tests.EndedAt = "ended", "succeeded", "2026-05-19T20:01:00Z"
+	}
+	job := fakes.JobV3{
+		ID:        testJobID,
+		Name:      "test",
+		Type:      "build",
+		Phase:     phase,
+		StartedAt: "2026-05-19T20:00:00Z",
+		Executions: [][]fakes.JobStep{{
+			{Name: "Spin up environment", Type: "spinup_environment", Num: 0, Phase: "ended", Outcome: "succeeded", StartedAt: "2026-05-19T20:00:00Z", EndedAt: "2026-05-19T20:00:10Z"},
+			tests,
+		}},
+	}
+	if phase == "ended" {
+		job.Outcome, job.EndedAt = "succeeded", "2026-05-19T20:01:00Z"
+	}
+	return job
+}
+
+// partialUsage is singleExecutionUsage as it looked halfway through the job.
+func partialUsage() fakes.ResourceUsage {
+	u := singleExecutionUsage()
+	u.Executions[0].CPUCores = u.Executions[0].CPUCores[:2]
+	u.Executions[0].MemoryBytes = u.Executions[0].MemoryBytes[:2]
+	return u
+}
+
+// finishAfterFirstPoll lets the CLI see the job running with two samples, then
+// finishes it with all four.
+func finishAfterFirstPoll(t *testing.T, fake *fakes.CircleCI) {
+	t.Helper()
+	fake.AddJobV3(followedJob("running"))
+	fake.AddJobResourceUsage(testJobID, partialUsage())
+
+	usageURL := url.URL{Path: "/api/v3/jobs/" + testJobID + "/resource-usage"}
+	go func() {
+		deadline := time.Now().Add(20 * time.Second)
+		for len(fake.FindRequests("GET", usageURL)) == 0 && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		fake.AddJobResourceUsage(testJobID, singleExecutionUsage())
+		fake.AddJobV3(followedJob("ended"))
+	}()
+}
+
+type followedSample struct {
+	Execution      int     `json:"execution"`
+	Sample         int     `json:"sample"`
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+	CPUCores       float64 `json:"cpu_cores"`
+	MemoryBytes    int64   `json:"memory_bytes"`
+	Step           string  `json:"step"`
+}
+
+func decodeSamples(t *testing.T, out string) []followedSample {
+	t.Helper()
+	var samples []followedSample
+	sc := bufio.NewScanner(strings.NewReader(out))
+	for sc.Scan() {
+		var s followedSample
+		assert.NilError(t, json.Unmarshal(sc.Bytes(), &s), "line: %s", sc.Text())
+		samples = append(samples, s)
+	}
+	return samples
+}
+
+// TestJobResourceUsageFollow_JSONL follows a job from running to ended through
+// a pipe: every sample is written exactly once, as the poll that first sees it
+// comes back, tagged with the step that was running when it was taken.
+func TestJobResourceUsageFollow_JSONL(t *testing.T) {
+	fake := fakes.NewCircleCI(t)
+	finishAfterFirstPoll(t, fake)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	env.Extra["CIRCLE_RESOURCE_USAGE_POLL_MS"] = "50"
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "get", testJobID, "--follow"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	samples := decodeSamples(t, result.Stdout)
+	assert.Equal(t, len(samples), 4, "stdout: %s", result.Stdout)
+	for i, s := range samples {
+		assert.Check(t, cmp.Equal(s.Sample, i))
+		assert.Check(t, cmp.Equal(s.ElapsedSeconds, float64(15*i)))
+	}
+	assert.Check(t, cmp.Equal(samples[0].Step, "Spin up environment"))
+	assert.Check(t, cmp.Equal(samples[1].Step, "Run integration tests"))
+	assert.Check(t, cmp.Equal(samples[3].CPUCores, 1.75))
+	assert.Check(t, cmp.Equal(samples[3].MemoryBytes, int64(1536*mib)))
+}
+
+// TestJobResourceUsageFollow_Ended returns straight away for a job that has
+// already finished: one poll, every sample.
+func TestJobResourceUsageFollow_Ended(t *testing.T) {
+	fake := fakes.NewCircleCI(t)
+	fake.AddJobV3(followedJob("ended"))
+	fake.AddJobResourceUsage(testJobID, singleExecutionUsage())
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "get", testJobID, "--follow", "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Len(decodeSamples(t, result.Stdout), 4))
+	usageURL := url.URL{Path: "/api/v3/jobs/" + testJobID + "/resource-usage"}
+	assert.Check(t, cmp.Len(fake.FindRequests("GET", usageURL), 1))
+}
+
+// TestJobResourceUsageFollow_NoUsage keeps the not-found error for a job that
+// ended without recording any samples; only a running job is given time.
+func TestJobResourceUsageFollow_NoUsage(t *testing.T) {
+	fake := fakes.NewCircleCI(t)
+	fake.AddJobV3(followedJob("ended"))
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "get", testJobID, "--follow"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 5), "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Equal(result.Stdout, ""))
+}
+
+// TestJobResourceUsageFollow_TTY redraws the report in a terminal until the
+// job ends, with the running step marked on the chart while it lasts.
+func TestJobResourceUsageFollow_TTY(t *testing.T) {
+	fake := fakes.NewCircleCI(t)
+	finishAfterFirstPoll(t, fake)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	env.Extra["CIRCLE_RESOURCE_USAGE_POLL_MS"] = "50"
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "get", testJobID, "--follow"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+		TTY:     true,
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stdout: %s", result.Stdout)
+	assert.Check(t, cmp.Contains(result.Stdout, "Following job "+testJobID))
+	assert.Check(t, cmp.Contains(result.Stdout, "Job Resource Usage"))
+	assert.Check(t, cmp.Contains(result.Stdout, "Run integration tests"))
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const recommendProjectID = "a0000000-0000-4000-8000-0000000ec001"
+
+// setupRecommend registers three finished runs, newest first, each running
+// the same four jobs: build, which barely uses its large class; test, which
+// saturates it; lint, on a macOS class off the Docker ladder; and an approval.
+// The oldest run's test job recorded no usage.
+func setupRecommend(t *testing.T) *testenv.TestEnv {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, watchSlug, recommendProjectID)
+
+	for i := 1; i <= 3; i++ {
+		runID := fmt.Sprintf("f0000000-0000-4000-8000-0000000ec00%d", i)
+		wfID := fmt.Sprintf("b0000000-0000-4000-8000-0000000ec00%d", i)
+		jobID := func(n int) string { return fmt.Sprintf("d0000000-0000-4000-8000-0000000ec%d0%d", i, n) }
+
+		fake.AddRunV3(runID, recommendProjectID, fakeRunV3(runID, recommendProjectID, "ended", "succeeded", "main", "abc1234def5678"))
+		fake.AddRunWorkflowsV3(runID, fakeWorkflowV3(wfID, "build-and-test", runID, recommendProjectID, "ended", "succeeded"))
+
+		hold := fakeJobV3(jobID(4), "hold", wfID, recommendProjectID)
+		hold.Type = "approval"
+		fake.AddWorkflowJobsV3(wfID,
+			fakeJobV3(jobID(1), "build", wfID, recommendProjectID),
+			fakeJobV3(jobID(2), "test", wfID, recommendProjectID),
+			fakeJobV3(jobID(3), "lint", wfID, recommendProjectID),
+			hold,
+		)
+
+		fake.AddJobResourceUsage(jobID(1), fakes.ResourceUsage{
+			ClassName: "large", CPUCount: 4, MemoryLimitBytes: 8 * 1024 * mib,
+			Executions: []fakes.ResourceUsageExecution{{
+				IntervalMS:  15000,
+				CPUCores:    []float64{0.5, 1.0, 1.2, 0.8},
+				MemoryBytes: []int64{512 * mib, 1024 * mib, int64(i) * 512 * mib, 768 * mib},
+			}},
+		})
+		if i < 3 {
+			fake.AddJobResourceUsage(jobID(2), fakes.ResourceUsage{
+				ClassName: "large", CPUCount: 4, MemoryLimitBytes: 8 * 1024 * mib,
+				Executions: []fakes.ResourceUsageExecution{{
+					IntervalMS:  15000,
+					CPUCores:    []float64{3.5, 4, 4, 4, 3.8, 2},
+					MemoryBytes: []int64{4096 * mib, 6144 * mib, 7168 * mib, 7168 * mib, 6144 * mib, 2048 * mib},
+				}},
+			})
+		}
+		fake.AddJobResourceUsage(jobID(3), fakes.ResourceUsage{
+			ClassName: "macos.m1.medium.gen1", CPUCount: 4, MemoryLimitBytes: 6 * 1024 * mib,
+			Executions: []fakes.ResourceUsageExecution{{
+				IntervalMS:  15000,
+				CPUCores:    []float64{1, 2},
+				MemoryBytes: []int64{2048 * mib, 3072 * mib},
+			}},
+		})
+	}
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return env
+}
+
+func TestJobResourceUsageRecommend_Output(t *testing.T) {
+	env := setupRecommend(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "recommend", "--project", watchSlug},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+}
+
+// TestJobResourceUsageRecommend_JobName stops scanning once the named job has
+// --runs runs, and reports only that job.
+func TestJobResourceUsageRecommend_JobName(t *testing.T) {
+	env := setupRecommend(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "recommend", "--project", watchSlug, "--job-name", "build", "--runs", "2", "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	var out struct {
+		RunsScanned int `json:"runs_scanned"`
+		Jobs        []struct {
+			Job            string `json:"job"`
+			Runs           int    `json:"runs"`
+			Recommendation struct {
+				Action             string  `json:"action"`
+				ResourceClass      string  `json:"resource_class"`
+				CreditsSavedPerRun float64 `json:"credits_saved_per_run"`
+				OOMRisk            string  `json:"oom_risk"`
+			} `json:"recommendation"`
+		} `json:"jobs"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	assert.Check(t, cmp.Equal(out.RunsScanned, 2))
+	assert.Assert(t, cmp.Len(out.Jobs, 1))
+	j := out.Jobs[0]
+	assert.Check(t, cmp.Equal(j.Job, "build"))
+	assert.Check(t, cmp.Equal(j.Runs, 2))
+	assert.Check(t, cmp.Equal(j.Recommendation.Action, "downsize"))
+	assert.Check(t, cmp.Equal(j.Recommendation.ResourceClass, "medium"))
+	assert.Check(t, cmp.Equal(j.Recommendation.CreditsSavedPerRun, 10.0))
+	assert.Check(t, cmp.Equal(j.Recommendation.OOMRisk, "low"))
+}
+
+func TestJobResourceUsageRecommend_JobNotFound(t *testing.T) {
+	env := setupRecommend(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "recommend", "--project", watchSlug, "--job-name", "deploy"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 5))
+	assert.Check(t, cmp.Contains(result.Stderr, `No job named "deploy" ran in the last 3 runs`))
+}
+
+func TestJobResourceUsageRecommend_InvalidRuns(t *testing.T) {
+	env := setupRecommend(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "resource-usage", "recommend", "--project", watchSlug, "--runs", "0"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 2))
+	assert.Check(t, cmp.Contains(result.Stderr, "--runs must be between 1 and 100"))
+}
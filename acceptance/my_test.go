@@ -232,3 +232,104 @@ func TestMyRuns_NoToken(t *testing.T) {
 
 	assert.Equal(t, result.ExitCode, 3, "stderr: %s", result.Stderr) // ExitAuthError
 }
+
+// setupMyApprovalsFake follows two projects, each with an on-hold run. Only the
+// web run's workflow still has an approval job awaiting a decision; the api
+// run's approval was already given. A running run, and a followed project the
+// V3 API cannot resolve, are both skipped.
+func setupMyApprovalsFake(t *testing.T) *testenv.TestEnv {
+	t.Helper()
+	const (
+		webID    = "a0000000-0000-4000-8000-00000000aa01"
+		apiID    = "a0000000-0000-4000-8000-00000000aa02"
+		webRunID = "e0000000-0000-4000-8000-00000000c001"
+		apiRunID = "e0000000-0000-4000-8000-00000000c002"
+		webWfID  = "c0000000-0000-4000-8000-00000000c001"
+		apiWfID  = "c0000000-0000-4000-8000-00000000c002"
+	)
+	fake := fakes.NewCircleCI(t)
+	for _, repo := range []string{"web", "api", "gone"} {
+		fake.AddFollowedProject(fakes.FollowedProject{
+			Username: "acme",
+			Reponame: repo,
+			VCSType:  "github",
+			Name:     repo,
+		})
+	}
+	addProjectBySlug(fake, "gh/acme/web", webID)
+	addProjectBySlug(fake, "gh/acme/api", apiID)
+
+	fake.AddRunV3(webRunID, webID, fakeRunV3(webRunID, webID, "on_hold", "", "main", "abc1234def5678"))
+	fake.AddRunV3(apiRunID, apiID, fakeRunV3(apiRunID, apiID, "on_hold", "", "release", "deadbeef12345678"))
+	fake.AddRunV3("e0000000-0000-4000-8000-00000000c003", webID,
+		fakeRunV3("e0000000-0000-4000-8000-00000000c003", webID, "running", "", "feature", "1111111122222222"))
+
+	fake.AddRunWorkflowsV3(webRunID, fakeWorkflowV3(webWfID, "build-deploy", webRunID, webID, "started", ""))
+	fake.AddWorkflowJobsV3(webWfID,
+		fakeJobV3("d0000000-0000-4000-8000-00000000c001", "build", webWfID, webID),
+		fakeApprovalJobV3("d0000000-0000-4000-8000-00000000c002", "hold", webWfID, webID),
+	)
+	approved := fakeJobV3("d0000000-0000-4000-8000-00000000c003", "hold", apiWfID, apiID)
+	approved.Type = "approval"
+	fake.AddRunWorkflowsV3(apiRunID, fakeWorkflowV3(apiWfID, "release", apiRunID, apiID, "started", ""))
+	fake.AddWorkflowJobsV3(apiWfID, approved)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return env
+}
+
+func TestMyApprovals(t *testing.T) {
+	env := setupMyApprovalsFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"my", "approvals"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+}
+
+func TestMyApprovals_JSON(t *testing.T) {
+	env := setupMyApprovalsFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"my", "approvals", "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	var out []map[string]any
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	assert.Assert(t, cmp.Len(out, 1))
+	assert.Check(t, cmp.Equal(out[0]["project"], "gh/acme/web"))
+	assert.Check(t, cmp.Equal(out[0]["job"], "hold"))
+	assert.Check(t, cmp.Equal(out[0]["job_id"], "d0000000-0000-4000-8000-00000000c002"))
+	assert.Check(t, cmp.Equal(out[0]["workflow_id"], "c0000000-0000-4000-8000-00000000c001"))
+	assert.Check(t, cmp.Equal(out[0]["run_id"], "e0000000-0000-4000-8000-00000000c001"))
+}
+
+func TestMyApprovals_Empty(t *testing.T) {
+	fake := fakes.NewCircleCI(t)
+	// No followed projects.
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"my", "approvals"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, strings.Contains(result.Stderr, "No pending approvals."))
+}
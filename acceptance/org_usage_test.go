@@ -0,0 +1,209 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const (
+	usageCSVHeader = "ORGANIZATION_NAME,PROJECT_NAME,WORKFLOW_NAME,JOB_NAME,RESOURCE_CLASS,TOTAL_CREDITS\n"
+	usageCSVPart1  = usageCSVHeader +
+		"myorg,api,build,test,medium,1200\n" +
+		"myorg,api,build,lint,small,150.5\n" +
+		"myorg,web,deploy,e2e,large,600\n"
+	usageCSVPart2 = usageCSVHeader +
+		"myorg,web,deploy,build,medium,300\n" +
+		"myorg,docs,build,build,small,\n"
+)
+
+// usageDates is a range ending yesterday, so it is always a valid export.
+func usageDates() (string, string) {
+	now := time.Now().UTC()
+	return now.AddDate(0, 0, -14).Format(time.DateOnly), now.AddDate(0, 0, -1).Format(time.DateOnly)
+}
+
+func setupUsageFake(t *testing.T, e fakes.UsageExport) (*fakes.CircleCI, *testenv.TestEnv) {
+	t.Helper()
+	fake, env := setupProjectFake(t)
+	fake.AddOrg(orgSettingsOrgID, orgSettingsOrgSlug, "myorg", "github")
+	fake.SetUsageExport(orgSettingsOrgID, e)
+	env.Extra["CIRCLE_USAGE_POLL_MS"] = "10"
+	return fake, env
+}
+
+func TestOrgUsageExport(t *testing.T) {
+	fake, env := setupUsageFake(t, fakes.UsageExport{
+		Files: map[string]string{"usage-part-1": usageCSVPart1, "usage-part-2": usageCSVPart2},
+		Polls: 2,
+	})
+	from, to := usageDates()
+	dir := t.TempDir()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"org", "usage", "export", "--org", orgSettingsOrgSlug, "--from", from, "--to", to, "--output", "exports", "--summary"},
+		Env:     env.Environ(),
+		WorkDir: dir,
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+	assert.Check(t, cmp.Contains(result.Stderr, "Wrote 2 CSV file(s) to exports"))
+
+	t.Run("the files are written decompressed", func(t *testing.T) {
+		got, err := os.ReadFile(filepath.Join(dir, "exports", "usage-part-1.csv")) //#nosec:G304 // test output
+		assert.NilError(t, err)
+		assert.Check(t, cmp.Equal(string(got), usageCSVPart1))
+	})
+
+	t.Run("the export covers whole days", func(t *testing.T) {
+		var body map[string]any
+		reqs := fake.FindRequests(http.MethodPost, url.URL{Path: "/api/v2/organizations/" + orgSettingsOrgID + "/usage_export_job"})
+		assert.Assert(t, cmp.Len(reqs, 1))
+		assert.NilError(t, reqs[0].Decode(&body))
+		end, err := time.Parse(time.DateOnly, to)
+		assert.NilError(t, err)
+		assert.Check(t, cmp.Equal(body["start"], from+"T00:00:00Z"))
+		assert.Check(t, cmp.Equal(body["end"], end.AddDate(0, 0, 1).Format(time.RFC3339)))
+	})
+
+	t.Run("downloads do not send the API token", func(t *testing.T) {
+		n := 0
+		for _, req := range fake.AllRequests() {
+			if strings.HasPrefix(req.URL.Path, "/usage-exports/") {
+				n++
+				assert.Check(t, cmp.Equal(req.Header.Get("Authorization"), ""))
+			}
+		}
+		assert.Check(t, cmp.Equal(n, 2))
+	})
+}
+
+func TestOrgUsageExport_JSON(t *testing.T) {
+	_, env := setupUsageFake(t, fakes.UsageExport{
+		Files: map[string]string{"usage": usageCSVPart1},
+	})
+	from, to := usageDates()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"org", "usage", "export", "--org", orgSettingsOrgSlug, "--from", from, "--to", to, "--summary", "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	var out struct {
+		ExportID string   `json:"export_id"`
+		From     string   `json:"from"`
+		Files    []string `json:"files"`
+		Summary  struct {
+			TotalCredits float64 `json:"total_credits"`
+			Projects     []struct {
+				Name    string  `json:"name"`
+				Credits float64 `json:"credits"`
+			} `json:"projects"`
+		} `json:"summary"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	assert.Check(t, out.ExportID != "")
+	assert.Check(t, cmp.Equal(out.From, from))
+	assert.Check(t, cmp.DeepEqual(out.Files, []string{"usage.csv"}))
+	assert.Check(t, cmp.Equal(out.Summary.TotalCredits, 1950.5))
+	assert.Assert(t, cmp.Len(out.Summary.Projects, 2))
+	assert.Check(t, cmp.Equal(out.Summary.Projects[0].Name, "api"))
+}
+
+func TestOrgUsageExport_Failed(t *testing.T) {
+	_, env := setupUsageFake(t, fakes.UsageExport{Polls: 1, FailureReason: "no usage data for this range"})
+	from, to := usageDates()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"org", "usage", "export", "--org", orgSettingsOrgSlug, "--from", from, "--to", to},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 1)
+	assert.Check(t, cmp.Contains(result.Stderr, "no usage data for this range"))
+}
+
+func TestOrgUsageExport_Timeout(t *testing.T) {
+	_, env := setupUsageFake(t, fakes.UsageExport{Polls: 1000})
+	from, to := usageDates()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"org", "usage", "export", "--org", orgSettingsOrgSlug, "--from", from, "--to", to, "--timeout", "50ms"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 8)
+	assert.Check(t, cmp.Contains(result.Stderr, "was not ready within 50ms"))
+}
+
+func TestOrgUsageExport_InvalidDates(t *testing.T) {
+	_, env := setupUsageFake(t, fakes.UsageExport{})
+	from, _ := usageDates()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "malformed", args: []string{"--from", "09/01/2026", "--to", from}, want: "--from must be a date as YYYY-MM-DD"},
+		{name: "reversed", args: []string{"--from", from, "--to", "2000-01-01"}, want: "--to must not be before --from"},
+		{name: "too long", args: []string{"--from", "2000-01-01", "--to", "2000-03-01"}, want: "at most 32 days"},
+		{name: "too old", args: []string{"--from", "2000-01-01", "--to", "2000-01-31"}, want: "within the last 365 days"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"org", "usage", "export", "--org", orgSettingsOrgSlug}, tc.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+			assert.Equal(t, result.ExitCode, 2)
+			assert.Check(t, cmp.Contains(result.Stderr, tc.want))
+		})
+	}
+}
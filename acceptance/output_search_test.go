@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const (
+	grepRunID  = "f0000000-0000-4000-8000-00000000e001"
+	grepWfID   = "b0000000-0000-4000-8000-00000000e001"
+	grepLintID = "d0000000-0000-4000-8000-00000000e001"
+	grepTestID = "d0000000-0000-4000-8000-00000000e002"
+)
+
+// setupOutputSearchFake registers a run whose test job ran three parallel
+// executions; only the third printed the connection error, on stdout, and
+// the first warned about it on stderr.
+func setupOutputSearchFake(t *testing.T) *testenv.TestEnv {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+
+	fake.AddRunV3(grepRunID, runTestProjectID,
+		fakeRunV3(grepRunID, runTestProjectID, "ended", "failed", "main", "abc1234def5678"))
+	fake.AddRunWorkflowsV3(grepRunID,
+		fakeWorkflowV3(grepWfID, "build", grepRunID, runTestProjectID, "ended", "failed"))
+
+	steps := func() []fakes.JobStep {
+		return []fakes.JobStep{
+			compareStep(0, "Spin up environment", "succeeded", 0, 5),
+			compareStep(101, "Run tests", "succeeded", 5, 20),
+		}
+	}
+	lint := fakeJobV3(grepLintID, "lint", grepWfID, runTestProjectID)
+	lint.Executions = [][]fakes.JobStep{steps()}
+	fake.AddJobV3(lint)
+	test := fakeJobV3(grepTestID, "test", grepWfID, runTestProjectID)
+	test.Outcome = "failed"
+	test.Executions = [][]fakes.JobStep{steps(), steps(), steps()}
+	fake.AddJobV3(test)
+	hold := fakeJobV3("d0000000-0000-4000-8000-00000000e003", "hold", grepWfID, runTestProjectID)
+	hold.Type = "approval"
+	hold.StartedAt = ""
+	fake.AddWorkflowJobsV3(grepWfID, lint, test, hold)
+
+	fake.AddJobStdout(grepLintID, 0, 101, []byte("lint ok\n"))
+	fake.AddJobStdout(grepTestID, 0, 101, []byte("ok  pkg/a\n"))
+	fake.AddJobStderr(grepTestID, 0, 101, []byte("warning: Connection refused, retrying\n"))
+	fake.AddJobStdout(grepTestID, 1, 101, []byte("ok  pkg/b\n"))
+	fake.AddJobStdout(grepTestID, 2, 101, []byte("=== RUN TestDB\ndialing db\ndial tcp 10.0.0.1:5432: connection refused\n--- FAIL: TestDB\nFAIL pkg/c\n"))
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return env
+}
+
+func TestJobOutputSearch(t *testing.T) {
+	env := setupOutputSearchFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "output", "search", grepTestID, "-C", "1", "connection refused"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	golden.Assert(t, result.Stdout, t.Name()+".txt")
+}
+
+func TestJobOutputSearch_IgnoreCaseJSON(t *testing.T) {
+	env := setupOutputSearchFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "output", "search", grepTestID, "-i", "-B", "1", "connection refused", "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+
+	var out struct {
+		StepsSearched int `json:"steps_searched"`
+		Matches       []struct {
+			Job        string   `json:"job"`
+			Execution  int      `json:"execution"`
+			StepNum    int      `json:"step_num"`
+			LineNumber int      `json:"line_number"`
+			Before     []string `json:"before"`
+			After      []string `json:"after"`
+		} `json:"matches"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	assert.Check(t, cmp.Equal(out.StepsSearched, 6))
+	assert.Assert(t, cmp.Len(out.Matches, 2))
+	assert.Check(t, cmp.Equal(out.Matches[0].Execution, 0), "stderr is searched too")
+	assert.Check(t, cmp.Equal(out.Matches[1].Execution, 2))
+	assert.Check(t, cmp.Equal(out.Matches[1].LineNumber, 3))
+	assert.Check(t, cmp.DeepEqual(out.Matches[1].Before, []string{"dialing db"}))
+	assert.Check(t, cmp.DeepEqual(out.Matches[1].After, []string{}))
+}
+
+func TestJobOutputSearch_Execution(t *testing.T) {
+	env := setupOutputSearchFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "output", "search", grepTestID, "--execution", "1", "refused"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Equal(result.Stdout, ""))
+	assert.Check(t, cmp.Contains(result.Stderr, `No matches for "refused" in 2 steps.`))
+}
+
+func TestJobOutputSearch_InvalidRegex(t *testing.T) {
+	env := setupOutputSearchFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"job", "output", "search", grepTestID, "("},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 2)
+	assert.Check(t, cmp.Contains(result.Stderr, "not a valid regular expression"))
+}
+
+func TestRunGrep(t *testing.T) {
+	env := setupOutputSearchFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "grep", grepRunID, "ok|FAIL"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	golden.Assert(t, result.Stdout, t.Name()+".txt")
+}
+
+func TestRunGrep_UnknownJob(t *testing.T) {
+	env := setupOutputSearchFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "grep", grepRunID, "--job", "deploy", "error"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 5)
+	assert.Check(t, cmp.Contains(result.Stderr, `no job named "deploy"`))
+}
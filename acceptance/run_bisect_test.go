@@ -0,0 +1,295 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const bisectSlug = "gh/testorg/testrepo"
+
+// initBisectRepo creates a repository of n commits "c0".."c<n-1>" on main with
+// a github.com/testorg/testrepo origin, and returns their SHAs.
+func initBisectRepo(t *testing.T, dir string, n int) []string {
+	t.Helper()
+	shas := make([]string, n)
+	for i := range n {
+		if i == 0 {
+			bisectGit(t, dir, "init", "--initial-branch=main")
+			bisectGit(t, dir, "remote", "add", "origin", "https://github.com/testorg/testrepo")
+		}
+		bisectGit(t, dir, "commit", "--allow-empty", "-m", fmt.Sprintf("c%d", i))
+		shas[i] = strings.TrimSpace(bisectGit(t, dir, "rev-parse", "HEAD"))
+	}
+	return shas
+}
+
+func bisectGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@test.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@test.com",
+	)
+	out, err := cmd.CombinedOutput()
+	assert.NilError(t, err, "git %v: %s", args, out)
+	return string(out)
+}
+
+func bisectRunID(i int) string { return fmt.Sprintf("f0000000-0000-4000-8000-0000000b15%02d", i) }
+
+// addBisectRun registers an ended run for commit i that checked out sha. The
+// test job fails from testFailsFrom onwards; an unrelated e2e job fails from
+// runFailsFrom, so the run as a whole goes red earlier than the test job does.
+// With onTrigger the run only appears once a run is triggered, as a new one
+// would.
+func addBisectRun(fake *fakes.CircleCI, i int, sha string, onTrigger bool) {
+	const runFailsFrom, testFailsFrom = 3, 5
+	runID := bisectRunID(i)
+	wfID := fmt.Sprintf("b0000000-0000-4000-8000-0000000b15%02d", i)
+
+	outcome := "succeeded"
+	if i >= runFailsFrom {
+		outcome = "failed"
+	}
+	run := fakeRunV3(runID, runTestProjectID, "ended", outcome, "main", sha)
+	if onTrigger {
+		fake.AddRunV3OnTrigger(bisectSlug, runID, runTestProjectID, run)
+	} else {
+		fake.AddRunV3(runID, runTestProjectID, run)
+	}
+	fake.AddRunWorkflowsV3(runID, fakeWorkflowV3(wfID, "build", runID, runTestProjectID, "ended", outcome))
+
+	test := fakeJobV3(fmt.Sprintf("d0000000-0000-4000-8000-0000000b15%02d", i), "test", wfID, runTestProjectID)
+	if i >= testFailsFrom {
+		test.Outcome = "failed"
+	}
+	e2e := fakeJobV3(fmt.Sprintf("d0000000-0000-4000-8000-0000000b16%02d", i), "e2e", wfID, runTestProjectID)
+	e2e.Outcome = outcome
+	fake.AddWorkflowJobsV3(wfID, test, e2e)
+}
+
+func setupRunBisect(t *testing.T, skip ...int) (*fakes.CircleCI, *testenv.TestEnv, string, []string) {
+	t.Helper()
+	dir := t.TempDir()
+	shas := initBisectRepo(t, dir, 8)
+
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, bisectSlug, runTestProjectID)
+outer:
+	for i := 1; i < len(shas); i++ {
+		for _, s := range skip {
+			if s == i {
+				continue outer
+			}
+		}
+		addBisectRun(fake, i, shas[i], false)
+	}
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	serveOrigin(t, env, dir, shas[len(shas)-1]+":refs/heads/main")
+	return fake, env, dir, shas
+}
+
+func runBisectCLI(t *testing.T, env *testenv.TestEnv, dir string, args ...string) binary.CLIResult {
+	t.Helper()
+	return binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    append([]string{"run", "bisect"}, args...),
+		Env:     env.Environ(),
+		WorkDir: dir,
+	})
+}
+
+func TestRunBisect(t *testing.T) {
+	_, env, dir, shas := setupRunBisect(t)
+
+	result := runBisectCLI(t, env, dir, "--good", shas[0])
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stdout, "First failing commit: "+shas[3]))
+	assert.Check(t, cmp.Contains(result.Stdout, "c3"))
+	assert.Check(t, cmp.Contains(result.Stdout, "/pipeline/"+bisectRunID(3)))
+	assert.Check(t, cmp.Contains(result.Stderr, "Bisecting 7 commit(s)"))
+}
+
+func TestRunBisect_Job(t *testing.T) {
+	_, env, dir, shas := setupRunBisect(t)
+
+	result := runBisectCLI(t, env, dir, "--good", shas[0], "--bad", shas[7], "--job", "test", "--json")
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	var out struct {
+		FirstBad struct {
+			SHA   string `json:"sha"`
+			RunID string `json:"run_id"`
+		} `json:"first_bad"`
+		Tested []struct {
+			SHA     string `json:"sha"`
+			Verdict string `json:"verdict"`
+		} `json:"tested"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out), result.Stdout)
+	assert.Check(t, cmp.Equal(out.FirstBad.SHA, shas[5]))
+	assert.Check(t, cmp.Equal(out.FirstBad.RunID, bisectRunID(5)))
+	for _, c := range out.Tested {
+		want := "good"
+		if indexOf(shas, c.SHA) >= 5 {
+			want = "bad"
+		}
+		assert.Check(t, cmp.Equal(c.Verdict, want), "commit %s", c.SHA)
+	}
+}
+
+func indexOf(shas []string, sha string) int {
+	for i, s := range shas {
+		if s == sha {
+			return i
+		}
+	}
+	return -1
+}
+
+// TestRunBisect_NeedsRunThenResume covers a candidate with no run and no branch
+// to trigger one on: the bisect stops with the push command to run, refuses to
+// start over on top of the saved state, and --resume finishes once the run
+// exists.
+func TestRunBisect_NeedsRunThenResume(t *testing.T) {
+	fake, env, dir, shas := setupRunBisect(t, 3)
+
+	result := runBisectCLI(t, env, dir, "--good", shas[0])
+	assert.Equal(t, result.ExitCode, 5, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "git push origin "+shas[3]+":refs/heads/bisect/"+shas[3][:7]))
+	assert.Check(t, cmp.Contains(result.Stderr, "circleci run bisect --resume"))
+
+	result = runBisectCLI(t, env, dir, "--good", shas[0])
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "--reset"))
+
+	addBisectRun(fake, 3, shas[3], false)
+	result = runBisectCLI(t, env, dir, "--resume")
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stdout, "First failing commit: "+shas[3]))
+
+	// A finished bisect clears its state.
+	result = runBisectCLI(t, env, dir, "--resume")
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr)
+}
+
+// setupBisectTrigger leaves commit 3 without a run, with origin's release
+// branch at it and a stale fetched origin/stale ref claiming the same. The run
+// a trigger creates checks out checkedOut.
+func setupBisectTrigger(t *testing.T, checkedOut int) (*testenv.TestEnv, string, []string) {
+	t.Helper()
+	fake, env, dir, shas := setupRunBisect(t, 3)
+	serveOrigin(t, env, dir, shas[7]+":refs/heads/main", shas[3]+":refs/heads/release")
+	bisectGit(t, dir, "update-ref", "refs/remotes/origin/stale", shas[3])
+	addBisectRun(fake, 3, shas[checkedOut], true)
+	fake.SetTriggerPipelineRunResponse(bisectSlug, map[string]any{
+		"id":         bisectRunID(3),
+		"state":      "created",
+		"number":     3,
+		"created_at": "2024-06-01T00:00:00Z",
+	})
+	return env, dir, shas
+}
+
+func TestRunBisect_TriggersOnRemoteBranch(t *testing.T) {
+	env, dir, shas := setupBisectTrigger(t, 3)
+
+	result := runBisectCLI(t, env, dir, "--good", shas[0])
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "triggered run "+bisectRunID(3)+" on release"))
+	assert.Check(t, cmp.Contains(result.Stdout, "First failing commit: "+shas[3]))
+}
+
+// TestRunBisect_TriggeredRunTestsAnotherCommit covers origin's branch moving
+// between the lookup and the run starting: the run's verdict is not pinned on
+// the commit it never tested.
+func TestRunBisect_TriggeredRunTestsAnotherCommit(t *testing.T) {
+	env, dir, shas := setupBisectTrigger(t, 4)
+
+	result := runBisectCLI(t, env, dir, "--good", shas[0])
+
+	assert.Equal(t, result.ExitCode, 1, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "checked out "+shas[4][:7]+", not "+shas[3][:7]))
+	assert.Check(t, cmp.Contains(result.Stderr, "git push origin "+shas[3]+":refs/heads/bisect/"+shas[3][:7]))
+	assert.Check(t, !strings.Contains(result.Stdout, "First failing commit"))
+}
+
+// TestRunBisect_JudgesLatestAttempt covers a run whose build workflow failed
+// and passed on rerun: only the rerun counts, so the commit is good.
+func TestRunBisect_JudgesLatestAttempt(t *testing.T) {
+	fake, env, dir, shas := setupRunBisect(t, 2)
+	runID := bisectRunID(2)
+	fake.AddRunV3(runID, runTestProjectID, fakeRunV3(runID, runTestProjectID, "ended", "succeeded", "main", shas[2]))
+	failed := fakeWorkflowV3("b0000000-0000-4000-8000-0000000b1502", "build", runID, runTestProjectID, "ended", "failed")
+	rerun := fakeWorkflowV3("b0000000-0000-4000-8000-0000000b1702", "build", runID, runTestProjectID, "ended", "succeeded")
+	rerun.CreatedAt = "2020-01-01T13:00:00Z"
+	fake.AddRunWorkflowsV3(runID, failed, rerun)
+
+	result := runBisectCLI(t, env, dir, "--good", shas[0], "--workflow", "build")
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stdout, "First failing commit: "+shas[3]))
+}
+
+func TestRunBisect_Reset(t *testing.T) {
+	_, env, dir, shas := setupRunBisect(t, 3)
+
+	result := runBisectCLI(t, env, dir, "--good", shas[0])
+	assert.Equal(t, result.ExitCode, 5, "stderr: %s", result.Stderr)
+
+	result = runBisectCLI(t, env, dir, "--reset")
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "Bisect abandoned"))
+
+	result = runBisectCLI(t, env, dir, "--resume")
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "no interrupted bisect"))
+}
+
+func TestRunBisect_BadRange(t *testing.T) {
+	_, env, dir, shas := setupRunBisect(t)
+
+	result := runBisectCLI(t, env, dir, "--good", shas[5], "--bad", shas[2])
+
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "first-parent history"))
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const bulkCancelProjectID = "a0000000-0000-4000-8000-0000000cb001"
+
+// bulkCancelRun is one run in the bulk cancel fixture, with the workflow a
+// cancel of it would stop.
+type bulkCancelRun struct {
+	id, wfID, branch, phase string
+	age                     time.Duration
+}
+
+var bulkCancelRuns = []bulkCancelRun{
+	{id: "f0000000-0000-4000-8000-0000000cb001", wfID: "b0000000-0000-4000-8000-0000000cb001", branch: "main", phase: "running", age: time.Hour},
+	{id: "f0000000-0000-4000-8000-0000000cb002", wfID: "b0000000-0000-4000-8000-0000000cb002", branch: "main", phase: "running", age: 2 * time.Hour},
+	{id: "f0000000-0000-4000-8000-0000000cb003", wfID: "b0000000-0000-4000-8000-0000000cb003", branch: "main", phase: "on_hold", age: 3 * time.Hour},
+	{id: "f0000000-0000-4000-8000-0000000cb004", wfID: "b0000000-0000-4000-8000-0000000cb004", branch: "feature", phase: "running", age: 4 * time.Hour},
+	{id: "f0000000-0000-4000-8000-0000000cb005", wfID: "b0000000-0000-4000-8000-0000000cb005", branch: "main", phase: "ended", age: 5 * time.Hour},
+}
+
+// setupBulkCancel registers bulkCancelRuns, newest first, each with one
+// workflow that accepts a cancel. now anchors the runs' ages.
+func setupBulkCancel(t *testing.T, now time.Time) (*fakes.CircleCI, *testenv.TestEnv) {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, watchSlug, bulkCancelProjectID)
+	for _, r := range bulkCancelRuns {
+		outcome := ""
+		wfPhase := "started"
+		if r.phase == "ended" {
+			outcome = "succeeded"
+			wfPhase = "ended"
+		}
+		run := fakeRunV3(r.id, bulkCancelProjectID, r.phase, outcome, r.branch, "abc1234def5678")
+		run.CreatedAt = now.Add(-r.age).Format(v3TimeFormat)
+		fake.AddRunV3(r.id, bulkCancelProjectID, run)
+		fake.AddRunWorkflowsV3(r.id, fakeWorkflowV3(r.wfID, "build", r.id, bulkCancelProjectID, wfPhase, outcome))
+		fake.SetCancelResponse(r.wfID, http.StatusAccepted)
+	}
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return fake, env
+}
+
+// cancelledRuns returns the fixture runs whose workflow received a cancel.
+func cancelledRuns(fake *fakes.CircleCI) []string {
+	var out []string
+	for _, r := range bulkCancelRuns {
+		if len(fake.FindRequests(http.MethodPost, url.URL{Path: "/api/v3/workflows/" + r.wfID + "/cancel"})) > 0 {
+			out = append(out, r.id)
+		}
+	}
+	return out
+}
+
+func TestRunCancelBulk(t *testing.T) {
+	runs := bulkCancelRuns
+	tests := []struct {
+		name       string
+		args       []string
+		want       []string
+		wantStderr string
+	}{
+		{
+			name: "branch",
+			args: []string{"--branch", "main"},
+			want: []string{runs[0].id, runs[1].id, runs[2].id},
+		},
+		{
+			name:       "keep latest",
+			args:       []string{"--branch", "main", "--keep-latest"},
+			want:       []string{runs[1].id, runs[2].id},
+			wantStderr: "Keeping the latest run on main: " + runs[0].id,
+		},
+		{
+			name: "all branches running only",
+			args: []string{"--all-branches", "--status", "running"},
+			want: []string{runs[0].id, runs[1].id, runs[3].id},
+		},
+		{
+			name: "older than",
+			args: []string{"--branch", "main", "--older-than", "90m"},
+			want: []string{runs[1].id, runs[2].id},
+		},
+		{
+			name: "keep latest before older than",
+			args: []string{"--all-branches", "--keep-latest", "--older-than", "150m"},
+			want: []string{runs[2].id},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, env := setupBulkCancel(t, time.Now().UTC())
+
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"run", "cancel", "--project", watchSlug, "--force"}, tt.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+
+			assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+			assert.Check(t, cmp.DeepEqual(cancelledRuns(fake), tt.want))
+			assert.Check(t, cmp.Contains(result.Stdout, "Runs to cancel"))
+			if tt.wantStderr != "" {
+				assert.Check(t, cmp.Contains(result.Stderr, tt.wantStderr))
+			}
+		})
+	}
+}
+
+// TestRunCancelBulk_KeepLatestSuperseded covers a force-push whose run has
+// already finished: the older run still going is superseded, not "latest",
+// even though it is the newest run matching --status.
+func TestRunCancelBulk_KeepLatestSuperseded(t *testing.T) {
+	now := time.Now().UTC()
+	fake, env := setupBulkCancel(t, now)
+	for _, r := range []bulkCancelRun{
+		{id: "f0000000-0000-4000-8000-0000000cb011", wfID: "b0000000-0000-4000-8000-0000000cb011", branch: "release", phase: "ended", age: 10 * time.Minute},
+		{id: "f0000000-0000-4000-8000-0000000cb012", wfID: "b0000000-0000-4000-8000-0000000cb012", branch: "release", phase: "running", age: 30 * time.Minute},
+	} {
+		run := fakeRunV3(r.id, bulkCancelProjectID, r.phase, "", r.branch, "abc1234def5678")
+		run.CreatedAt = now.Add(-r.age).Format(v3TimeFormat)
+		fake.AddRunV3(r.id, bulkCancelProjectID, run)
+		fake.AddRunWorkflowsV3(r.id, fakeWorkflowV3(r.wfID, "build", r.id, bulkCancelProjectID, "started", ""))
+		fake.SetCancelResponse(r.wfID, http.StatusAccepted)
+	}
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "cancel", "--project", watchSlug, "--branch", "release", "--keep-latest", "--force"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, !strings.Contains(result.Stderr, "Keeping the latest run"), "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Len(fake.FindRequests(http.MethodPost, url.URL{Path: "/api/v3/workflows/b0000000-0000-4000-8000-0000000cb012/cancel"}), 1))
+	assert.Check(t, cmp.Len(fake.FindRequests(http.MethodPost, url.URL{Path: "/api/v3/workflows/b0000000-0000-4000-8000-0000000cb011/cancel"}), 0))
+}
+
+func TestRunCancelBulk_Output(t *testing.T) {
+	_, env := setupBulkCancel(t, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "cancel", "--project", watchSlug, "--branch", "main", "--force"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+}
+
+func TestRunCancelBulk_RequiresForce(t *testing.T) {
+	fake, env := setupBulkCancel(t, time.Now().UTC())
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "cancel", "--project", watchSlug, "--branch", "main"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 6, "stderr: %s", result.Stderr)
+	// The table still lists what would have been cancelled.
+	assert.Check(t, cmp.Contains(result.Stdout, bulkCancelRuns[0].id))
+	assert.Check(t, cmp.Contains(result.Stderr, "Cancelling 3 runs will stop all of their in-progress jobs."))
+	assert.Check(t, cmp.Len(cancelledRuns(fake), 0))
+}
+
+func TestRunCancelBulk_NoMatches(t *testing.T) {
+	fake, env := setupBulkCancel(t, time.Now().UTC())
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "cancel", "--project", watchSlug, "--branch", "nope", "--force"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "No matching runs to cancel."))
+	assert.Check(t, cmp.Len(cancelledRuns(fake), 0))
+}
+
+func TestRunCancelBulk_InvalidFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "finished status", args: []string{"--branch", "main", "--status", "success"}, want: `Status "success" cannot be cancelled.`},
+		{name: "branch and all branches", args: []string{"--branch", "main", "--all-branches"}, want: "--branch and --all-branches cannot be used together."},
+		{name: "run and branch", args: []string{"75", "--branch", "main"}, want: "A run cannot be given together with --branch or --all-branches."},
+		{name: "keep latest without branch", args: []string{"75", "--keep-latest"}, want: "--keep-latest selects runs to cancel in bulk"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := testenv.New(t)
+			env.Token = testToken
+
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"run", "cancel", "--project", watchSlug, "--force"}, tt.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+
+			assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr)
+			assert.Check(t, cmp.Contains(result.Stderr, tt.want))
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const (
+	compareSlug      = "gh/testorg/testrepo"
+	compareBaseRunID = "f0000000-0000-4000-8000-00000000c0a1"
+	compareHeadRunID = "f0000000-0000-4000-8000-00000000c0b1"
+	compareBaseWfID  = "b0000000-0000-4000-8000-00000000c0a1"
+	compareHeadWfID  = "b0000000-0000-4000-8000-00000000c0b1"
+)
+
+// compareStep is a step that starts at offset seconds into its job and runs for
+// dur seconds.
+func compareStep(num int, name, outcome string, offset, dur int) fakes.JobStep {
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC).Add(time.Duration(offset) * time.Second)
+	step := fakes.JobStep{
+		Name:      name,
+		Type:      "run",
+		Num:       num,
+		Phase:     "ended",
+		Outcome:   outcome,
+		StartedAt: start.Format(v3TimeFormat),
+		EndedAt:   start.Add(time.Duration(dur) * time.Second).Format(v3TimeFormat),
+	}
+	if outcome == "failed" {
+		step.ExitCode = new(1)
+	}
+	return step
+}
+
+// compareJob registers an ended job of the given duration, its step detail and
+// the resource class it ran on.
+func compareJob(fake *fakes.CircleCI, id, name, wfID, outcome, class string, dur int, steps ...fakes.JobStep) fakes.JobV3 {
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	job := fakeJobV3(id, name, wfID, runTestProjectID)
+	job.Outcome = outcome
+	job.StartedAt = start.Format(v3TimeFormat)
+	job.EndedAt = start.Add(time.Duration(dur) * time.Second).Format(v3TimeFormat)
+	job.Executions = [][]fakes.JobStep{steps}
+	fake.AddJobV3(job)
+	if class != "" {
+		fake.AddJobResourceUsage(id, fakes.ResourceUsage{ClassName: class})
+	}
+	return job
+}
+
+// setupRunCompareFake registers a passing baseline run on main and a slower,
+// failing run on a feature branch. The head moves test to a larger resource
+// class, fails its "run tests" step, adds an e2e job and drops docs.
+func setupRunCompareFake(t *testing.T) *testenv.TestEnv {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, compareSlug, runTestProjectID)
+
+	fake.AddRunV3(compareBaseRunID, runTestProjectID,
+		fakeRunV3(compareBaseRunID, runTestProjectID, "ended", "succeeded", "main", "aaaaaaa1111111"))
+	fake.AddRunWorkflowsV3(compareBaseRunID,
+		fakeWorkflowV3(compareBaseWfID, "build", compareBaseRunID, runTestProjectID, "ended", "succeeded"))
+	fake.AddWorkflowJobsV3(compareBaseWfID,
+		compareJob(fake, "d0000000-0000-4000-8000-00000000c0a1", "lint", compareBaseWfID, "succeeded", "medium", 30,
+			compareStep(0, "Spin up environment", "succeeded", 0, 10),
+			compareStep(101, "Run linters", "succeeded", 10, 20)),
+		compareJob(fake, "d0000000-0000-4000-8000-00000000c0a2", "test", compareBaseWfID, "succeeded", "medium", 120,
+			compareStep(0, "Spin up environment", "succeeded", 0, 10),
+			compareStep(101, "Checkout code", "succeeded", 10, 5),
+			compareStep(102, "Run tests", "succeeded", 15, 100)),
+		compareJob(fake, "d0000000-0000-4000-8000-00000000c0a3", "docs", compareBaseWfID, "succeeded", "small", 20,
+			compareStep(0, "Spin up environment", "succeeded", 0, 10)),
+	)
+
+	headWf := fakeWorkflowV3(compareHeadWfID, "build", compareHeadRunID, runTestProjectID, "ended", "failed")
+	headWf.EndedAt = time.Date(2020, 1, 1, 12, 3, 30, 0, time.UTC).Format(v3TimeFormat)
+	fake.AddRunV3(compareHeadRunID, runTestProjectID,
+		fakeRunV3(compareHeadRunID, runTestProjectID, "ended", "failed", "feature", "bbbbbbb2222222"))
+	fake.AddRunWorkflowsV3(compareHeadRunID, headWf)
+	fake.AddWorkflowJobsV3(compareHeadWfID,
+		compareJob(fake, "d0000000-0000-4000-8000-00000000c0b1", "lint", compareHeadWfID, "succeeded", "medium", 32,
+			compareStep(0, "Spin up environment", "succeeded", 0, 10),
+			compareStep(101, "Run linters", "succeeded", 10, 22)),
+		compareJob(fake, "d0000000-0000-4000-8000-00000000c0b2", "test", compareHeadWfID, "failed", "large", 180,
+			compareStep(0, "Spin up environment", "succeeded", 0, 12),
+			compareStep(101, "Checkout code", "succeeded", 12, 5),
+			compareStep(102, "Run tests", "failed", 17, 160)),
+		compareJob(fake, "d0000000-0000-4000-8000-00000000c0b3", "e2e", compareHeadWfID, "succeeded", "", 60),
+	)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return env
+}
+
+func TestRunCompare(t *testing.T) {
+	env := setupRunCompareFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "compare", compareBaseRunID, compareHeadRunID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	golden.Assert(t, result.Stdout, t.Name()+".txt")
+}
+
+func TestRunCompare_LatestOn(t *testing.T) {
+	env := setupRunCompareFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "compare", "--latest-on", "main", "--project", compareSlug, "--branch", "feature"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	golden.Assert(t, result.Stdout, "TestRunCompare.txt")
+}
+
+func TestRunCompare_JSON(t *testing.T) {
+	env := setupRunCompareFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "compare", compareBaseRunID, compareHeadRunID, "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+
+	var out struct {
+		Base struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"base"`
+		DeltaSeconds *float64 `json:"delta_seconds"`
+		Workflows    []struct {
+			Name string `json:"name"`
+			Jobs []struct {
+				Name string `json:"name"`
+				Base *struct {
+					ResourceClass string `json:"resource_class"`
+				} `json:"base"`
+				Head *struct {
+					Status        string `json:"status"`
+					ResourceClass string `json:"resource_class"`
+				} `json:"head"`
+				DeltaSeconds *float64 `json:"delta_seconds"`
+			} `json:"jobs"`
+		} `json:"workflows"`
+		NewlyFailingSteps []struct {
+			Job      string `json:"job"`
+			Step     string `json:"step"`
+			ExitCode *int   `json:"exit_code"`
+		} `json:"newly_failing_steps"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out), result.Stdout)
+
+	assert.Check(t, cmp.Equal(out.Base.ID, compareBaseRunID))
+	assert.Check(t, cmp.Equal(out.Base.Status, "succeeded"))
+	assert.Assert(t, out.DeltaSeconds != nil)
+	assert.Check(t, cmp.Equal(*out.DeltaSeconds, 56.0))
+
+	assert.Assert(t, cmp.Len(out.Workflows, 1))
+	jobs := out.Workflows[0].Jobs
+	assert.Assert(t, cmp.Len(jobs, 4))
+	assert.Check(t, cmp.Equal(jobs[1].Name, "test"))
+	assert.Check(t, cmp.Equal(jobs[1].Base.ResourceClass, "medium"))
+	assert.Check(t, cmp.Equal(jobs[1].Head.ResourceClass, "large"))
+	assert.Check(t, cmp.Equal(*jobs[1].DeltaSeconds, 60.0))
+	assert.Check(t, cmp.Equal(jobs[2].Name, "e2e"))
+	assert.Check(t, jobs[2].Base == nil)
+	assert.Check(t, cmp.Equal(jobs[3].Name, "docs"))
+	assert.Check(t, jobs[3].Head == nil)
+
+	assert.Assert(t, cmp.Len(out.NewlyFailingSteps, 1))
+	assert.Check(t, cmp.Equal(out.NewlyFailingSteps[0].Job, "test"))
+	assert.Check(t, cmp.Equal(out.NewlyFailingSteps[0].Step, "Run tests"))
+	assert.Check(t, cmp.Equal(*out.NewlyFailingSteps[0].ExitCode, 1))
+}
+
+func TestRunCompare_MissingArgs(t *testing.T) {
+	env := setupRunCompareFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "compare", compareBaseRunID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "<run-b>"))
+}
@@ -23,6 +23,8 @@
 package acceptance_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -175,12 +177,53 @@ func TestRunGet_FailedContext_NoFailures(t *testing.T) {
 	assert.Check(t, cmp.Equal(result.Stderr, ""))
 }
 
-// TestRunGet_FailureReport_RejectsJSON verifies that combining --failure-report with
-// --json exits non-zero with a user-facing error.
-func TestRunGet_FailureReport_RejectsJSON(t *testing.T) {
+// setupFailureReportJSONFake registers a failed run whose single failed job has
+// a failed test, a passing test and an artifact, for the JSON report forms.
+func setupFailureReportJSONFake(t *testing.T) *fakes.CircleCI {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+	fake.AddRunV3(fcRunID, runTestProjectID, fakeRunV3(fcRunID, runTestProjectID, "ended", "failed", "main", "abc1234def5678"))
+	fake.AddRunWorkflowsV3(fcRunID, fakeWorkflowV3(fcWfID, "build", fcRunID, runTestProjectID, "ended", "failed"))
+	fake.AddWorkflowJobsV3(fcWfID,
+		fakeJobV3(fcJob1ID, "run-tests", fcWfID, runTestProjectID),
+		fakeJobV3(fcJob3ID, "lint", fcWfID, runTestProjectID),
+	)
+
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC).Format(v3TimeFormat)
+	fake.AddJobV3(fakes.JobV3{
+		ID: fcJob1ID, Name: "run-tests", Type: "build", Phase: "ended", Outcome: "failed",
+		StartedAt: now, EndedAt: now, WorkflowID: fcWfID, ProjectID: runTestProjectID,
+		Executions: [][]fakes.JobStep{{
+			{Name: "Spin up environment", Type: "spinup_environment", Num: 0, Phase: "ended", Outcome: "succeeded", StartedAt: now, EndedAt: now},
+			{Name: "run tests", Type: "run", Num: 101, Phase: "ended", Outcome: "failed", ExitCode: new(1), StartedAt: now, EndedAt: now},
+		}},
+	})
+	fake.AddJobStdoutCondensed(fcJob1ID, 0, 101, []byte("--- FAIL: TestLogin (0.01s)\n"))
+	fake.AddJobTests(fcJob1ID,
+		fakes.TestResult{Classname: "auth", Name: "TestLogin", Result: "failure", RunTime: 0.01, Message: "expected 200, got 500"},
+		fakes.TestResult{Classname: "auth", Name: "TestLogout", Result: "success", RunTime: 0.02},
+	)
+	fake.AddJobArtifactsV3(fcJob1ID, fakes.Artifact{Path: "coverage/index.html", URL: "https://output.circle-artifacts.com/coverage/index.html"})
+
+	fake.AddJobV3(fakes.JobV3{
+		ID: fcJob3ID, Name: "lint", Type: "build", Phase: "ended", Outcome: "succeeded",
+		StartedAt: now, EndedAt: now, WorkflowID: fcWfID, ProjectID: runTestProjectID,
+		Executions: [][]fakes.JobStep{{
+			{Name: "run lint", Type: "run", Num: 100, Phase: "ended", Outcome: "succeeded", ExitCode: new(0), StartedAt: now, EndedAt: now},
+		}},
+	})
+	return fake
+}
+
+// TestRunGet_FailureReport_JSON emits the versioned JSON document: failed steps
+// with their condensed output and class, plus the job's failed tests and
+// artifacts. Jobs without failed steps are left out, as in the markdown form.
+func TestRunGet_FailureReport_JSON(t *testing.T) {
+	fake := setupFailureReportJSONFake(t)
+
 	env := testenv.New(t)
 	env.Token = testToken
-	env.CircleCIURL = "https://circleci.com" // never reached
+	env.CircleCIURL = fake.URL()
 
 	result := binary.RunCLI(t, binary.RunOpts{
 		Binary:  binaryPath,
@@ -189,8 +232,67 @@ func TestRunGet_FailureReport_RejectsJSON(t *testing.T) {
 		WorkDir: t.TempDir(),
 	})
 
+	assert.Check(t, cmp.Equal(result.ExitCode, 0))
+	assert.Check(t, golden.String(normalizeAppHost(result.Stdout, fake.URL()), t.Name()+".txt"))
+	assert.Check(t, cmp.Equal(result.Stderr, ""))
+}
+
+// TestRunGet_FailureReport_Stream writes the same report as JSONL: a run record
+// followed by one self-contained record per failed job.
+func TestRunGet_FailureReport_Stream(t *testing.T) {
+	fake := setupFailureReportJSONFake(t)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "get", fcRunID, "--failure-report", "--stream"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 0))
+	assert.Check(t, golden.String(normalizeAppHost(result.Stdout, fake.URL()), t.Name()+".txt"))
+	assert.Check(t, cmp.Equal(result.Stderr, ""))
+}
+
+// TestRunGet_FailureReport_JQ filters the JSON document like any other --json
+// output.
+func TestRunGet_FailureReport_JQ(t *testing.T) {
+	fake := setupFailureReportJSONFake(t)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "get", fcRunID, "--failure-report", "--jq", ".workflows[].jobs[].failed_executions[].failed_steps[].classification.class"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 0))
+	assert.Check(t, cmp.Equal(result.Stdout, "test_assertion\n"))
+}
+
+// TestRunGet_Stream_RequiresFailureReport rejects --stream on its own.
+func TestRunGet_Stream_RequiresFailureReport(t *testing.T) {
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = "https://circleci.com" // never reached
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "get", fcRunID, "--stream"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
 	assert.Check(t, cmp.Equal(result.ExitCode, 2))
-	assert.Check(t, cmp.Contains(result.Stderr, "run.failure_report_no_json"))
+	assert.Check(t, cmp.Contains(result.Stderr, "--stream only applies to --failure-report"))
 }
 
 // TestRunGet_FailedContext_JobNotFound verifies that jobs whose GetJobV3 call
@@ -236,6 +338,97 @@ func TestRunGet_FailedContext_JobNotFound(t *testing.T) {
 	assert.Check(t, cmp.Equal(result.Stderr, ""))
 }
 
+// TestRunGet_FailedContext_Classified classifies each failed step: one job whose
+// memory peaked at its resource class limit is reported as an OOM kill, and one
+// whose output matches a rule from the user's failure-patterns.yml takes that
+// user class ahead of the built-in ones.
+func TestRunGet_FailedContext_Classified(t *testing.T) {
+	fake := fakes.NewCircleCI(t)
+	fake.AddRunV3(fcRunID, runTestProjectID, fakeRunV3(fcRunID, runTestProjectID, "ended", "failed", "main", "abc1234def5678"))
+	fake.AddRunWorkflowsV3(fcRunID, fakeWorkflowV3(fcWfID, "build", fcRunID, runTestProjectID, "ended", "failed"))
+	fake.AddWorkflowJobsV3(fcWfID,
+		fakeJobV3(fcJob1ID, "run-tests", fcWfID, runTestProjectID),
+		fakeJobV3(fcJob2ID, "e2e", fcWfID, runTestProjectID),
+	)
+
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(sec int) string { return start.Add(time.Duration(sec) * time.Second).Format(v3TimeFormat) }
+	fake.AddJobV3(fakes.JobV3{
+		ID: fcJob1ID, Name: "run-tests", Type: "build", Phase: "ended", Outcome: "failed",
+		StartedAt: at(0), EndedAt: at(40), WorkflowID: fcWfID, ProjectID: runTestProjectID,
+		Executions: [][]fakes.JobStep{{
+			{Name: "Spin up environment", Type: "spinup_environment", Num: 0, Phase: "ended", Outcome: "succeeded", StartedAt: at(0), EndedAt: at(10)},
+			{Name: "run tests", Type: "run", Num: 101, Phase: "ended", Outcome: "failed", ExitCode: new(1), StartedAt: at(10), EndedAt: at(40)},
+		}},
+	})
+	fake.AddJobStdoutCondensed(fcJob1ID, 0, 101, []byte("Segmentation fault (core dumped)\n"))
+	// Sampled every 10s: the spin-up step stays low, the test step climbs to
+	// within a few percent of the 4 GiB limit before it dies.
+	fake.AddJobResourceUsage(fcJob1ID, fakes.ResourceUsage{
+		ClassName: "medium", CPUCount: 2, MemoryLimitBytes: 4096 << 20,
+		Executions: []fakes.ResourceUsageExecution{{
+			IntervalMS:  10000,
+			CPUCores:    []float64{0.2, 1.5, 1.9, 2.0},
+			MemoryBytes: []int64{200 << 20, 2048 << 20, 3500 << 20, 4000 << 20},
+		}},
+	})
+
+	fake.AddJobV3(fakes.JobV3{
+		ID: fcJob2ID, Name: "e2e", Type: "build", Phase: "ended", Outcome: "failed",
+		StartedAt: at(0), EndedAt: at(40), WorkflowID: fcWfID, ProjectID: runTestProjectID,
+		Executions: [][]fakes.JobStep{{
+			{Name: "run e2e", Type: "run", Num: 102, Phase: "ended", Outcome: "failed", ExitCode: new(1), StartedAt: at(0), EndedAt: at(40)},
+		}},
+	})
+	fake.AddJobStdoutCondensed(fcJob2ID, 0, 102, []byte("SessionNotCreatedException: 3 tests failed\n"))
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+
+	patterns := filepath.Join(env.ConfigDir(), "circleci", "failure-patterns.yml")
+	assert.NilError(t, os.MkdirAll(filepath.Dir(patterns), 0o700))
+	assert.NilError(t, os.WriteFile(patterns, []byte(`rules:
+  - class: selenium_session
+    title: Selenium session lost
+    action: Rerun from failed; the grid drops sessions under load.
+    patterns: ["SessionNotCreatedException"]
+`), 0o600))
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "get", fcRunID, "--failure-report"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 0))
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+	assert.Check(t, cmp.Equal(result.Stderr, ""))
+}
+
+// TestRunGet_FailedContext_InvalidPatterns rejects a malformed user pattern file
+// before any API call is made.
+func TestRunGet_FailedContext_InvalidPatterns(t *testing.T) {
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = "https://circleci.com" // never reached
+
+	patterns := filepath.Join(env.ConfigDir(), "circleci", "failure-patterns.yml")
+	assert.NilError(t, os.MkdirAll(filepath.Dir(patterns), 0o700))
+	assert.NilError(t, os.WriteFile(patterns, []byte("rules:\n  - class: x\n    patterns: ['(']\n"), 0o600))
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "get", fcRunID, "--failure-report"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 2))
+	assert.Check(t, cmp.Contains(result.Stderr, `class x: invalid pattern "("`))
+}
+
 // TestRunGet_FailedContext_WorkflowsNotFound exits 0 with no output when the
 // run's workflows have not materialised yet (404).
 func TestRunGet_FailedContext_WorkflowsNotFound(t *testing.T) {
@@ -0,0 +1,361 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const (
+	listMeID       = "c0000000-0000-4000-8000-0000000000aa"
+	listOtherID    = "c0000000-0000-4000-8000-0000000000bb"
+	listDeployDef  = "f0000000-0000-4000-8000-0000000000d1"
+	listReleaseDef = "f0000000-0000-4000-8000-0000000000d2"
+)
+
+// setupRunListFilterFake registers runs that differ in one filterable field
+// each, so every filter can be shown to pick out exactly the runs it should.
+func setupRunListFilterFake(t *testing.T) (*fakes.CircleCI, *testenv.TestEnv) {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, watchSlug, runTestProjectID)
+	fake.SetMe(fakes.User{ID: listMeID, Name: "Ada Lovelace", Login: "ada"})
+	for _, def := range []struct{ id, name string }{{listDeployDef, "deploy"}, {listReleaseDef, "release"}} {
+		fake.AddPipelineDefinition(runTestProjectID, map[string]any{
+			"id":         def.id,
+			"attributes": map[string]any{"name": def.name},
+			"references": map[string]any{"project": map[string]any{"id": runTestProjectID}},
+		})
+	}
+
+	add := func(n int, user, status, trigger, def string) {
+		id := fmt.Sprintf("e0000000-0000-4000-8000-%012x", n)
+		// A full SHA whose leading digit is n, so an abbreviation picks out one run.
+		run := fakeRunV3(id, runTestProjectID, "ended", status, "main", fmt.Sprintf("%x%039x", n, 0))
+		run.UserID = user
+		run.TriggerType = trigger
+		run.PipelineDefinitionID = def
+		fake.AddRunV3(id, runTestProjectID, run)
+	}
+	add(1, listMeID, "failed", "webhook", listDeployDef)
+	add(2, listMeID, "succeeded", "webhook", listDeployDef)
+	add(3, listOtherID, "failed", "schedule", listReleaseDef)
+	add(4, listMeID, "failed", "api", listReleaseDef)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return fake, env
+}
+
+func runListIDs(t *testing.T, stdout string) []string {
+	t.Helper()
+	var out []struct {
+		ID string `json:"id"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(stdout), &out))
+	ids := make([]string, len(out))
+	for i, r := range out {
+		ids[i] = r.ID[len(r.ID)-1:]
+	}
+	return ids
+}
+
+func TestRunList_Filters(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{name: "status", args: []string{"--status", "failed"}, want: []string{"1", "3", "4"}},
+		{name: "mine", args: []string{"--mine"}, want: []string{"1", "2", "4"}},
+		{name: "actor", args: []string{"--actor", listOtherID}, want: []string{"3"}},
+		{name: "trigger", args: []string{"--trigger", "schedule"}, want: []string{"3"}},
+		{name: "pipeline definition by name", args: []string{"--pipeline-definition", "release"}, want: []string{"3", "4"}},
+		{name: "pipeline definition by id", args: []string{"--pipeline-definition", listDeployDef}, want: []string{"1", "2"}},
+		{name: "full sha", args: []string{"--sha", fmt.Sprintf("%x%039x", 2, 0)}, want: []string{"2"}},
+		{name: "abbreviated sha", args: []string{"--sha", "3000000"}, want: []string{"3"}},
+		{name: "combined", args: []string{"--mine", "--status", "failed", "--pipeline-definition", "release"}, want: []string{"4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, env := setupRunListFilterFake(t)
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"run", "list", "--project", watchSlug, "--json"}, tt.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+			assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+			assert.Check(t, cmp.DeepEqual(runListIDs(t, result.Stdout), tt.want))
+		})
+	}
+}
+
+// TestRunList_FilterFillsLimit keeps paging when a client-side filter discards
+// runs, so --limit counts matching runs rather than fetched ones.
+func TestRunList_FilterFillsLimit(t *testing.T) {
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, watchSlug, runTestProjectID)
+	for i := 1; i <= 30; i++ {
+		id := fmt.Sprintf("e0000000-0000-4000-8000-%012x", i)
+		run := fakeRunV3(id, runTestProjectID, "ended", "succeeded", "main", fmt.Sprintf("%016x", i))
+		if i%10 == 0 {
+			run.TriggerType = "schedule"
+		}
+		fake.AddRunV3(id, runTestProjectID, run)
+	}
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "list", "--project", watchSlug, "--trigger", "schedule", "--limit", "2", "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	var out []map[string]any
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	assert.Assert(t, cmp.Len(out, 2))
+	assert.Check(t, cmp.Equal(out[0]["id"], "e0000000-0000-4000-8000-00000000000a"))
+	assert.Check(t, cmp.Equal(out[1]["id"], "e0000000-0000-4000-8000-000000000014"))
+}
+
+// TestRunList_AllStreamsJSONL follows the cursor past --limit's default and
+// writes one JSON object per line.
+func TestRunList_AllStreamsJSONL(t *testing.T) {
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, watchSlug, runTestProjectID)
+	for i := 1; i <= 45; i++ {
+		id := fmt.Sprintf("e0000000-0000-4000-8000-%012x", i)
+		fake.AddRunV3(id, runTestProjectID, fakeRunV3(id, runTestProjectID, "ended", "succeeded", "main", fmt.Sprintf("%016x", i)))
+	}
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "list", "--project", watchSlug, "--all", "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	lines := strings.Split(strings.TrimSpace(result.Stdout), "\n")
+	assert.Assert(t, cmp.Len(lines, 45))
+	for i, line := range lines {
+		var rec map[string]any
+		assert.NilError(t, json.Unmarshal([]byte(line), &rec), "line %d", i+1)
+		assert.Check(t, cmp.Equal(rec["id"], fmt.Sprintf("e0000000-0000-4000-8000-%012x", i+1)))
+	}
+}
+
+func TestRunList_InvalidFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "status", args: []string{"--status", "broken"}, wantErr: `Unknown status "broken"`},
+		{name: "trigger", args: []string{"--trigger", "cron"}, wantErr: `Unknown trigger type "cron"`},
+		{name: "actor", args: []string{"--actor", "ada"}, wantErr: `--actor takes a user ID (UUID), got "ada"`},
+		{name: "sha", args: []string{"--sha", "HEAD"}, wantErr: `--sha takes a commit SHA of 4 to 40 hex digits, got "HEAD"`},
+		{name: "mine and actor", args: []string{"--mine", "--actor", listOtherID}, wantErr: "--mine and --actor cannot be used together"},
+		{name: "all and limit", args: []string{"--all", "--limit", "5"}, wantErr: "--all and --limit cannot be used together"},
+		{name: "time", args: []string{"--created-after", "last tuesday"}, wantErr: `--created-after: unrecognised date, time or age "last tuesday"`},
+		{name: "org and project", args: []string{"--org", "gh/acme"}, wantErr: "--org and --project cannot be used together"},
+		{name: "range", args: []string{"--created-after", "2026-02-01", "--created-before", "2026-01-01"}, wantErr: "--created-after must be earlier than --created-before"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := testenv.New(t)
+			env.Token = testToken
+			env.CircleCIURL = "https://circleci.com" // never reached
+
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"run", "list", "--project", watchSlug}, tt.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+			assert.Check(t, cmp.Equal(result.ExitCode, 2))
+			assert.Check(t, cmp.Contains(result.Stderr, tt.wantErr))
+		})
+	}
+}
+
+func TestRunList_PipelineDefinitionNotFound(t *testing.T) {
+	_, env := setupRunListFilterFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "list", "--project", watchSlug, "--pipeline-definition", "nightly"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 5))
+	assert.Check(t, cmp.Contains(result.Stderr, `No pipeline definition with ID or name "nightly"`))
+}
+
+// setupRunListOrgFake follows three projects in gh/acme — one the V3 API no
+// longer knows — and one in another org, with failing and passing runs on
+// main spread across them.
+func setupRunListOrgFake(t *testing.T) *testenv.TestEnv {
+	t.Helper()
+	const (
+		webID   = "a0000000-0000-4000-8000-00000000bb01"
+		apiID   = "a0000000-0000-4000-8000-00000000bb02"
+		otherID = "a0000000-0000-4000-8000-00000000bb03"
+	)
+	fake := fakes.NewCircleCI(t)
+	for _, p := range []struct{ org, repo string }{{"acme", "web"}, {"acme", "api"}, {"acme", "gone"}, {"other", "web"}} {
+		fake.AddFollowedProject(fakes.FollowedProject{Username: p.org, Reponame: p.repo, VCSType: "github", Name: p.repo})
+	}
+	addProjectBySlug(fake, "gh/acme/web", webID)
+	addProjectBySlug(fake, "gh/acme/api", apiID)
+	addProjectBySlug(fake, "gh/other/web", otherID)
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	add := func(n int, projectID, outcome string) {
+		id := fmt.Sprintf("e0000000-0000-4000-8000-%012x", n)
+		run := fakeRunV3(id, projectID, "ended", outcome, "main", fmt.Sprintf("%016x", n))
+		run.CreatedAt = base.Add(time.Duration(n) * time.Hour).Format(v3TimeFormat)
+		fake.AddRunV3(id, projectID, run)
+	}
+	// Added newest first, the order the search API returns runs in.
+	add(5, webID, "failed")
+	add(4, otherID, "failed")
+	add(3, webID, "succeeded")
+	add(2, apiID, "failed")
+	add(1, webID, "failed")
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return env
+}
+
+func TestRunList_Org(t *testing.T) {
+	env := setupRunListOrgFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "list", "--org", "github/acme", "--status", "failed", "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	var out []struct {
+		ID      string `json:"id"`
+		Project string `json:"project"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	assert.Check(t, cmp.DeepEqual(out, []struct {
+		ID      string `json:"id"`
+		Project string `json:"project"`
+	}{
+		{ID: "e0000000-0000-4000-8000-000000000005", Project: "gh/acme/web"},
+		{ID: "e0000000-0000-4000-8000-000000000002", Project: "gh/acme/api"},
+		{ID: "e0000000-0000-4000-8000-000000000001", Project: "gh/acme/web"},
+	}))
+}
+
+// TestRunList_Org_Limit takes the newest --limit runs across the org, not the
+// first --limit from whichever project is searched first.
+func TestRunList_Org_Limit(t *testing.T) {
+	env := setupRunListOrgFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "list", "--org", "gh/acme", "--limit", "2"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+}
+
+// TestRunList_Org_AllStreamsJSONL writes every run in the org, each record
+// tagged with its project, as the projects' pages arrive.
+func TestRunList_Org_AllStreamsJSONL(t *testing.T) {
+	env := setupRunListOrgFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "list", "--org", "gh/acme", "--all", "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	got := map[string]string{}
+	for i, line := range strings.Split(strings.TrimSpace(result.Stdout), "\n") {
+		var rec struct {
+			ID      string `json:"id"`
+			Project string `json:"project"`
+		}
+		assert.NilError(t, json.Unmarshal([]byte(line), &rec), "line %d", i+1)
+		got[rec.ID[len(rec.ID)-1:]] = rec.Project
+	}
+	assert.Check(t, cmp.DeepEqual(got, map[string]string{
+		"5": "gh/acme/web",
+		"3": "gh/acme/web",
+		"2": "gh/acme/api",
+		"1": "gh/acme/web",
+	}))
+}
+
+func TestRunList_Org_NoProjects(t *testing.T) {
+	env := setupRunListOrgFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "list", "--org", "gh/nobody"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Check(t, cmp.Equal(result.ExitCode, 5))
+	assert.Check(t, cmp.Contains(result.Stderr, "You do not follow any projects in gh/nobody."))
+}
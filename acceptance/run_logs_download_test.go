@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+)
+
+func TestRunLogsDownload(t *testing.T) {
+	env := setupOutputSearchFake(t)
+	out := filepath.Join(t.TempDir(), "logs")
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "logs", "download", grepRunID, "-o", out},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "Saved the output of 8 steps"))
+
+	var files []string
+	err := filepath.WalkDir(out, func(p string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			rel, _ := filepath.Rel(out, p)
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return err
+	})
+	assert.NilError(t, err)
+	sort.Strings(files)
+	assert.Check(t, cmp.DeepEqual(files, []string{
+		"build/lint/exec-0/01-spin-up-environment.log",
+		"build/lint/exec-0/02-run-tests.log",
+		"build/test/exec-0/01-spin-up-environment.log",
+		"build/test/exec-0/02-run-tests.log",
+		"build/test/exec-1/01-spin-up-environment.log",
+		"build/test/exec-1/02-run-tests.log",
+		"build/test/exec-2/01-spin-up-environment.log",
+		"build/test/exec-2/02-run-tests.log",
+		"manifest.json",
+	}))
+
+	log, err := os.ReadFile(filepath.Join(out, "build/test/exec-0/02-run-tests.log"))
+	assert.NilError(t, err)
+	assert.Check(t, cmp.Equal(string(log), "ok  pkg/a\nwarning: Connection refused, retrying\n"), "stdout then stderr")
+
+	var manifest struct {
+		SchemaVersion int `json:"schema_version"`
+		Run           struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"run"`
+		Workflows []struct {
+			Jobs []struct {
+				Name       string `json:"name"`
+				Status     string `json:"status"`
+				Executions []struct {
+					Steps []struct {
+						File        string `json:"file"`
+						StdoutBytes int    `json:"stdout_bytes"`
+						StderrBytes int    `json:"stderr_bytes"`
+					} `json:"steps"`
+				} `json:"executions"`
+			} `json:"jobs"`
+		} `json:"workflows"`
+	}
+	data, err := os.ReadFile(filepath.Join(out, "manifest.json"))
+	assert.NilError(t, err)
+	assert.NilError(t, json.Unmarshal(data, &manifest))
+	assert.Check(t, cmp.Equal(manifest.SchemaVersion, 1))
+	assert.Check(t, cmp.Equal(manifest.Run.ID, grepRunID))
+	jobs := manifest.Workflows[0].Jobs
+	assert.Assert(t, cmp.Len(jobs, 3))
+	assert.Check(t, cmp.Equal(jobs[1].Status, "failed"))
+	step := jobs[1].Executions[0].Steps[1]
+	assert.Check(t, cmp.Equal(step.File, "build/test/exec-0/02-run-tests.log"))
+	assert.Check(t, cmp.Equal(step.StdoutBytes, 10))
+	assert.Check(t, cmp.Equal(step.StderrBytes, 38))
+	assert.Check(t, cmp.Len(jobs[2].Executions, 0), "the approval never ran")
+}
+
+func TestRunLogsDownload_TarGz(t *testing.T) {
+	env := setupOutputSearchFake(t)
+	out := filepath.Join(t.TempDir(), "incident.tar.gz")
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "logs", "download", grepRunID, "-o", out},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+
+	f, err := os.Open(out)
+	assert.NilError(t, err)
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	assert.NilError(t, err)
+	tr := tar.NewReader(gz)
+
+	contents := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NilError(t, err)
+		if hdr.Typeflag == tar.TypeReg {
+			b, err := io.ReadAll(tr)
+			assert.NilError(t, err)
+			contents[hdr.Name] = string(b)
+		}
+	}
+	assert.Check(t, cmp.Len(contents, 9))
+	assert.Check(t, cmp.Contains(contents["incident/build/test/exec-2/02-run-tests.log"], "connection refused"))
+	assert.Check(t, cmp.Contains(contents["incident/manifest.json"], `"schema_version": 1`))
+}
+
+func TestRunLogsDownload_NonEmptyDir(t *testing.T) {
+	env := setupOutputSearchFake(t)
+	out := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(out, "keep.txt"), []byte("x"), 0o600))
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "logs", "download", grepRunID, "-o", out},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 2)
+	assert.Check(t, cmp.Contains(result.Stderr, "already exists and is not empty"))
+}
+
+func TestRunLogsDownload_RequiresOutput(t *testing.T) {
+	env := setupOutputSearchFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "logs", "download", grepRunID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 2)
+	assert.Check(t, cmp.Contains(result.Stderr, "--output"))
+}
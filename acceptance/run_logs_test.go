@@ -0,0 +1,162 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const (
+	logsRunID       = "e0000000-0000-4000-8000-0000000001a1"
+	logsBuildWfID   = "b0000000-0000-4000-8000-0000000001a1"
+	logsReleaseWfID = "b0000000-0000-4000-8000-0000000001a2"
+	logsTestJobID   = "d0000000-0000-4000-8000-0000000001a1" // two parallel executions
+	logsDeployJobID = "d0000000-0000-4000-8000-0000000001a2"
+	logsHoldJobID   = "d0000000-0000-4000-8000-0000000001a3" // approval — has no output
+)
+
+// setupRunLogsFake builds a finished run with two workflows: "build" runs a
+// parallel test job, "release" holds for approval and then deploys.
+func setupRunLogsFake(t *testing.T) *testenv.TestEnv {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+	fake.AddRunV3(logsRunID, runTestProjectID, fakeRunV3(logsRunID, runTestProjectID, "ended", "succeeded", "main", "abc1234def5678"))
+	fake.AddRunWorkflowsV3(logsRunID,
+		fakeWorkflowV3(logsBuildWfID, "build", logsRunID, runTestProjectID, "ended", "succeeded"),
+		fakeWorkflowV3(logsReleaseWfID, "release", logsRunID, runTestProjectID, "ended", "succeeded"),
+	)
+	fake.AddWorkflowJobsV3(logsBuildWfID, fakeJobV3(logsTestJobID, "test", logsBuildWfID, runTestProjectID))
+	hold := fakeJobV3(logsHoldJobID, "hold", logsReleaseWfID, runTestProjectID)
+	hold.Type = "approval"
+	fake.AddWorkflowJobsV3(logsReleaseWfID, hold, fakeJobV3(logsDeployJobID, "deploy-web", logsReleaseWfID, runTestProjectID))
+
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC).Format(v3TimeFormat)
+	step := func(name string, num int) fakes.JobStep {
+		return fakes.JobStep{Name: name, Type: "run", Num: num, Phase: "ended", Outcome: "succeeded", ExitCode: new(0), StartedAt: now, EndedAt: now}
+	}
+	fake.AddJobV3(fakes.JobV3{
+		ID: logsTestJobID, Name: "test", Type: "build", Phase: "ended", Outcome: "succeeded",
+		StartedAt: now, EndedAt: now, WorkflowID: logsBuildWfID, ProjectID: runTestProjectID,
+		Executions: [][]fakes.JobStep{
+			{step("Spin up environment", 0), step("Run tests", 101)},
+			{step("Spin up environment", 0), step("Run tests", 101)},
+		},
+	})
+	fake.AddJobStdout(logsTestJobID, 0, 0, []byte("Starting container\r\n"))
+	fake.AddJobStdout(logsTestJobID, 0, 101, []byte("ok  pkg/a\nok  pkg/b\n"))
+	fake.AddJobStdout(logsTestJobID, 1, 101, []byte("ok  pkg/c\nPASS")) // no trailing newline
+	fake.AddJobV3(fakes.JobV3{
+		ID: logsDeployJobID, Name: "deploy-web", Type: "build", Phase: "ended", Outcome: "succeeded",
+		StartedAt: now, EndedAt: now, WorkflowID: logsReleaseWfID, ProjectID: runTestProjectID,
+		Executions: [][]fakes.JobStep{
+			{step("Checkout code", 101), step("Deploy", 102)},
+		},
+	})
+	fake.AddJobStdout(logsDeployJobID, 0, 101, []byte("Cloning repository\n"))
+	fake.AddJobStdout(logsDeployJobID, 0, 102, []byte("Deploying web\nDone\n"))
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return env
+}
+
+func TestRunLogs(t *testing.T) {
+	env := setupRunLogsFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "logs", logsRunID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+}
+
+// TestRunLogs_Follow exits on its own once the run it follows has ended and
+// every step has been read to the end.
+func TestRunLogs_Follow(t *testing.T) {
+	env := setupRunLogsFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "logs", logsRunID, "--follow"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, "TestRunLogs.txt"))
+}
+
+func TestRunLogs_JobFilter(t *testing.T) {
+	env := setupRunLogsFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "logs", logsRunID, "--job", "^deploy-"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+}
+
+func TestRunLogs_SinceStep(t *testing.T) {
+	env := setupRunLogsFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "logs", logsRunID, "--since-step", "Deploy"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, golden.String(result.Stdout, t.Name()+".txt"))
+}
+
+func TestRunLogs_InvalidJobFilter(t *testing.T) {
+	env := setupRunLogsFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "logs", logsRunID, "--job", "deploy-("},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr) // ExitBadArguments
+	assert.Check(t, golden.String(result.Stderr, t.Name()+".stderr.txt"))
+}
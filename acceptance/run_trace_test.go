@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+)
+
+// otlpDoc is the subset of an OTLP/JSON export the tests inspect.
+type otlpDoc struct {
+	ResourceSpans []struct {
+		ScopeSpans []struct {
+			Spans []struct {
+				TraceID      string `json:"traceId"`
+				SpanID       string `json:"spanId"`
+				ParentSpanID string `json:"parentSpanId"`
+				Name         string `json:"name"`
+				Status       struct {
+					Code int `json:"code"`
+				} `json:"status"`
+			} `json:"spans"`
+		} `json:"scopeSpans"`
+	} `json:"resourceSpans"`
+}
+
+func TestRunTrace_OTLPFile(t *testing.T) {
+	env := setupRunCompareFake(t)
+	out := filepath.Join(t.TempDir(), "trace.json")
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "trace", compareHeadRunID, "-o", out},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Equal(result.Stdout, ""))
+	assert.Check(t, cmp.Contains(result.Stderr, "Wrote"))
+
+	data, err := os.ReadFile(out)
+	assert.NilError(t, err)
+	var doc otlpDoc
+	assert.NilError(t, json.Unmarshal(data, &doc))
+
+	spans := doc.ResourceSpans[0].ScopeSpans[0].Spans
+	assert.Assert(t, len(spans) > 3)
+	assert.Check(t, cmp.Equal(spans[0].TraceID, "f000000000004000800000000000c0b1"))
+	assert.Check(t, cmp.Equal(spans[0].ParentSpanID, ""))
+	assert.Check(t, cmp.Equal(spans[1].Name, "build"))
+	assert.Check(t, cmp.Equal(spans[1].ParentSpanID, spans[0].SpanID))
+	assert.Check(t, cmp.Equal(spans[1].Status.Code, 2), "the head workflow failed")
+}
+
+func TestRunTrace_Chrome(t *testing.T) {
+	env := setupRunCompareFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "trace", compareBaseRunID, "--format", "chrome"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+
+	var doc struct {
+		TraceEvents []struct {
+			Name string         `json:"name"`
+			Ph   string         `json:"ph"`
+			Cat  string         `json:"cat"`
+			Args map[string]any `json:"args"`
+		} `json:"traceEvents"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &doc))
+
+	var steps []string
+	for _, e := range doc.TraceEvents {
+		if e.Ph == "X" && e.Cat == "step" && e.Name == "Run tests" {
+			steps = append(steps, e.Args["circleci.resource_class"].(string))
+		}
+	}
+	assert.Check(t, cmp.DeepEqual(steps, []string{"medium"}))
+}
+
+func TestRunTrace_InvalidFormat(t *testing.T) {
+	env := setupRunCompareFake(t)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "trace", compareBaseRunID, "--format", "zipkin"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 2)
+	assert.Check(t, cmp.Contains(result.Stderr, `Unknown --format "zipkin"`))
+}
+
+func TestRunTrace_SendOTLP(t *testing.T) {
+	env := setupRunCompareFake(t)
+
+	var gotPath, gotAuth, gotType string
+	var got otlpDoc
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotAuth, gotType = r.URL.Path, r.Header.Get("Authorization"), r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &got)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(collector.Close)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "trace", compareBaseRunID, "--otlp-endpoint", collector.URL},
+		Env:     append(env.Environ(), "OTEL_EXPORTER_OTLP_HEADERS=Authorization=Bearer%20secret"),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Equal(result.Stdout, ""), "sending alone writes no file")
+	assert.Check(t, cmp.Contains(result.Stderr, "Sent"))
+
+	assert.Check(t, cmp.Equal(gotPath, "/v1/traces"))
+	assert.Check(t, cmp.Equal(gotAuth, "Bearer secret"))
+	assert.Check(t, cmp.Equal(gotType, "application/json"))
+	assert.Assert(t, cmp.Len(got.ResourceSpans, 1))
+	assert.Check(t, cmp.Equal(got.ResourceSpans[0].ScopeSpans[0].Spans[0].TraceID, "f000000000004000800000000000c0a1"))
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const localConfigRunID = "e0000000-0000-4000-8000-00000000cf01"
+
+// setupLocalConfigTrigger creates a repository on main with an uncommitted
+// .circleci/config.yml. When pushed is true origin's main is at HEAD;
+// otherwise it is a commit behind, though the fetched origin/main says HEAD.
+func setupLocalConfigTrigger(t *testing.T, pushed bool) (*fakes.CircleCI, *testenv.TestEnv, string) {
+	t.Helper()
+	dir := t.TempDir()
+	shas := initBisectRepo(t, dir, 2)
+	bisectGit(t, dir, "update-ref", "refs/remotes/origin/main", shas[1])
+	assert.NilError(t, os.MkdirAll(filepath.Join(dir, ".circleci"), 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, ".circleci", "config.yml"), []byte("version: 2.1\n"), 0o644))
+
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, bisectSlug, runTestProjectID)
+	fake.SetTriggerPipelineRunResponse(bisectSlug, map[string]any{
+		"id":         localConfigRunID,
+		"state":      "created",
+		"number":     42,
+		"created_at": "2026-03-01T12:00:00Z",
+	})
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	if pushed {
+		serveOrigin(t, env, dir, shas[1]+":refs/heads/main")
+	} else {
+		serveOrigin(t, env, dir, shas[0]+":refs/heads/main")
+	}
+	return fake, env, dir
+}
+
+// serveOrigin stands a bare repository in for the origin of the repository in
+// dir, holding the pushed refspecs. The CLI's git subprocesses reach it through
+// a url rewrite passed in the environment, so the project is still detected
+// from origin's configured GitHub URL.
+func serveOrigin(t *testing.T, env *testenv.TestEnv, dir string, refspecs ...string) {
+	t.Helper()
+	bare := t.TempDir()
+	bisectGit(t, bare, "init", "--bare")
+	bisectGit(t, dir, append([]string{"push", bare}, refspecs...)...)
+	env.Extra["GIT_CONFIG_COUNT"] = "1"
+	env.Extra["GIT_CONFIG_KEY_0"] = "url." + bare + ".insteadOf"
+	env.Extra["GIT_CONFIG_VALUE_0"] = "https://github.com/testorg/testrepo"
+}
+
+func runTriggerCLI(t *testing.T, env *testenv.TestEnv, dir string, args ...string) binary.CLIResult {
+	t.Helper()
+	return binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    append([]string{"run", "trigger"}, args...),
+		Env:     env.Environ(),
+		WorkDir: dir,
+	})
+}
+
+func TestRunTrigger_LocalConfig(t *testing.T) {
+	fake, env, dir := setupLocalConfigTrigger(t, true)
+	fake.SetCompileResponse(true, "version: 2.1\njobs: {}\n")
+
+	result := runTriggerCLI(t, env, dir, "--local-config", "--parameter", "deploy=false", "--json")
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	var out struct {
+		ID          string `json:"id"`
+		LocalConfig bool   `json:"local_config"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out), result.Stdout)
+	assert.Check(t, cmp.Equal(out.ID, localConfigRunID))
+	assert.Check(t, out.LocalConfig)
+
+	body := fake.LastTriggerPipelineRunBody(bisectSlug)
+	assert.Check(t, cmp.DeepEqual(body, map[string]any{
+		"config":     map[string]any{"branch": "main", "content": "version: 2.1\njobs: {}\n"},
+		"checkout":   map[string]any{"branch": "main"},
+		"parameters": map[string]any{"deploy": false},
+	}))
+
+	// run list marks the run it triggered, and only that one.
+	fake.AddRunV3(localConfigRunID, runTestProjectID, fakeRunV3(localConfigRunID, runTestProjectID, "created", "", "main", ""))
+	other := "e0000000-0000-4000-8000-00000000cf02"
+	fake.AddRunV3(other, runTestProjectID, fakeRunV3(other, runTestProjectID, "ended", "succeeded", "main", ""))
+
+	result = binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "list", "--project", bisectSlug},
+		Env:     env.Environ(),
+		WorkDir: dir,
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	for _, line := range strings.Split(result.Stdout, "\n") {
+		switch {
+		case strings.Contains(line, localConfigRunID):
+			assert.Check(t, cmp.Contains(line, "main (local config)"))
+		case strings.Contains(line, other):
+			assert.Check(t, !strings.Contains(line, "local config"), line)
+		}
+	}
+}
+
+func TestRunTrigger_LocalConfig_Unpushed(t *testing.T) {
+	fake, env, dir := setupLocalConfigTrigger(t, false)
+
+	result := runTriggerCLI(t, env, dir, "--config-file", ".circleci/config.yml")
+
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "is not the tip of origin/main"))
+	assert.Check(t, cmp.Contains(result.Stderr, "git push origin HEAD:main"))
+	assert.Check(t, cmp.Nil(fake.LastTriggerPipelineRunBody(bisectSlug)))
+
+	result = runTriggerCLI(t, env, dir, "--config-file", ".circleci/config.yml", "--allow-unpushed")
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stdout, "Triggered run #42 ("+localConfigRunID+") on main with local config .circleci/config.yml"))
+}
+
+func TestRunTrigger_LocalConfig_OriginUnreachable(t *testing.T) {
+	fake, env, dir := setupLocalConfigTrigger(t, true)
+	env.Extra["GIT_CONFIG_KEY_0"] = "url." + filepath.Join(t.TempDir(), "missing") + ".insteadOf"
+
+	result := runTriggerCLI(t, env, dir, "--local-config")
+
+	assert.Equal(t, result.ExitCode, 1, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "Could not check that HEAD is pushed: git ls-remote origin"))
+	assert.Check(t, cmp.Contains(result.Stderr, "--allow-unpushed"))
+	assert.Check(t, cmp.Nil(fake.LastTriggerPipelineRunBody(bisectSlug)))
+}
+
+func TestRunTrigger_LocalConfig_Invalid(t *testing.T) {
+	fake, env, dir := setupLocalConfigTrigger(t, true)
+	fake.SetCompileResponse(false, "", "jobs: build is not defined")
+
+	result := runTriggerCLI(t, env, dir, "--local-config")
+
+	assert.Equal(t, result.ExitCode, 7, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "jobs: build is not defined"))
+	assert.Check(t, cmp.Nil(fake.LastTriggerPipelineRunBody(bisectSlug)))
+}
+
+func TestRunTrigger_LocalConfig_BadFlags(t *testing.T) {
+	_, env, dir := setupLocalConfigTrigger(t, true)
+
+	result := runTriggerCLI(t, env, dir, "--allow-unpushed")
+	assert.Check(t, cmp.Equal(result.ExitCode, 2))
+	assert.Check(t, cmp.Contains(result.Stderr, "--allow-unpushed only applies with --config-file"))
+
+	result = runTriggerCLI(t, env, dir, "--local-config", "--config-file", "ci.yml")
+	assert.Check(t, cmp.Equal(result.ExitCode, 2))
+	assert.Check(t, cmp.Contains(result.Stderr, "--local-config and --config-file cannot be used together"))
+
+	result = runTriggerCLI(t, env, dir, "--config-file", "missing.yml")
+	assert.Check(t, cmp.Equal(result.ExitCode, 2))
+	assert.Check(t, cmp.Contains(result.Stderr, "Could not read missing.yml"))
+}
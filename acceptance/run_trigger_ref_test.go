@@ -0,0 +1,237 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const triggeredRunID = "f0000000-0000-4000-8000-00000000e001"
+
+// setupTriggerRef creates a repository of three commits whose origin has
+// feature at the first and main at the last, fetched as origin/feature and
+// origin/main, and a fake that accepts triggers for its project.
+func setupTriggerRef(t *testing.T) (*fakes.CircleCI, *testenv.TestEnv, string, []string) {
+	t.Helper()
+	dir := t.TempDir()
+	shas := initBisectRepo(t, dir, 3)
+	bisectGit(t, dir, "update-ref", "refs/remotes/origin/feature", shas[0])
+	bisectGit(t, dir, "update-ref", "refs/remotes/origin/main", shas[2])
+
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, bisectSlug, runTestProjectID)
+	triggered := map[string]any{
+		"id":         triggeredRunID,
+		"state":      "created",
+		"number":     44,
+		"created_at": "2026-03-01T12:00:00Z",
+	}
+	fake.SetTriggerResponse(bisectSlug, triggered)
+	fake.SetTriggerPipelineRunResponse(bisectSlug, triggered)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	serveOrigin(t, env, dir, shas[0]+":refs/heads/feature", shas[2]+":refs/heads/main")
+	return fake, env, dir, shas
+}
+
+// lastTriggerBody decodes the body of the last request to a trigger endpoint
+// under /api/v2/project/<slug>/.
+func lastTriggerBody(t *testing.T, fake *fakes.CircleCI, endpoint string) map[string]any {
+	t.Helper()
+	reqs := fake.FindRequests(http.MethodPost, url.URL{Path: "/api/v2/project/" + bisectSlug + "/" + endpoint})
+	assert.Assert(t, cmp.Len(reqs, 1))
+	var body map[string]any
+	assert.NilError(t, json.Unmarshal([]byte(*reqs[0].Body), &body))
+	return body
+}
+
+func TestRunTrigger_SHA(t *testing.T) {
+	fake, env, dir, shas := setupTriggerRef(t)
+
+	result := runTriggerCLI(t, env, dir, "--sha", shas[0][:8])
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stdout, "Triggered run #44 ("+triggeredRunID+") on feature"))
+	assert.Check(t, cmp.DeepEqual(lastTriggerBody(t, fake, "pipeline"), map[string]any{"branch": "feature"}))
+}
+
+func TestRunTrigger_SHA_NotBranchTip(t *testing.T) {
+	_, env, dir, shas := setupTriggerRef(t)
+
+	result := runTriggerCLI(t, env, dir, "--sha", shas[1])
+
+	assert.Equal(t, result.ExitCode, 5, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "a run can only check out a branch or tag"))
+	assert.Check(t, cmp.Contains(result.Stderr, "git push origin "+shas[1]+":refs/heads/ci/"+shas[1][:7]))
+}
+
+// TestRunTrigger_SHA_StaleFetch refuses a commit the fetched origin/feature
+// still has at its tip once origin's feature has moved on, rather than
+// building whatever feature is now.
+func TestRunTrigger_SHA_StaleFetch(t *testing.T) {
+	fake, env, dir, shas := setupTriggerRef(t)
+	serveOrigin(t, env, dir, shas[1]+":refs/heads/feature", shas[2]+":refs/heads/main")
+
+	result := runTriggerCLI(t, env, dir, "--sha", shas[0])
+
+	assert.Equal(t, result.ExitCode, 5, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "No origin branch has "+shas[0][:7]+" at its tip"))
+	assert.Check(t, cmp.Len(fake.FindRequests(http.MethodPost, url.URL{Path: "/api/v2/project/" + bisectSlug + "/pipeline"}), 0))
+}
+
+func TestRunTrigger_SHA_Unknown(t *testing.T) {
+	_, env, dir, _ := setupTriggerRef(t)
+
+	result := runTriggerCLI(t, env, dir, "--sha", "deadbeef")
+
+	assert.Equal(t, result.ExitCode, 5, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, `Commit "deadbeef" is not in the local repository: resolving "deadbeef"`))
+}
+
+func TestRunTrigger_PR(t *testing.T) {
+	tests := []struct {
+		name       string
+		ref        string
+		commit     int
+		wantBranch string
+	}{
+		{name: "branch on origin", ref: "refs/remotes/origin/pr/7", commit: 0, wantBranch: "feature"},
+		{name: "fork", ref: "refs/remotes/origin/pr/7", commit: 1, wantBranch: "pull/7/head"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, env, dir, shas := setupTriggerRef(t)
+			bisectGit(t, dir, "update-ref", tt.ref, shas[tt.commit])
+
+			result := runTriggerCLI(t, env, dir, "--pr", "7", "--json")
+
+			assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+			var out struct {
+				Branch string `json:"branch"`
+			}
+			assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out))
+			assert.Check(t, cmp.Equal(out.Branch, tt.wantBranch))
+			assert.Check(t, cmp.DeepEqual(lastTriggerBody(t, fake, "pipeline"), map[string]any{"branch": tt.wantBranch}))
+		})
+	}
+}
+
+func TestRunTrigger_PR_NotFetched(t *testing.T) {
+	_, env, dir, _ := setupTriggerRef(t)
+
+	result := runTriggerCLI(t, env, dir, "--pr", "9")
+
+	assert.Equal(t, result.ExitCode, 5, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "git fetch origin pull/9/head:refs/remotes/origin/pr/9"))
+}
+
+func TestRunTrigger_Tag(t *testing.T) {
+	fake, env, dir, _ := setupTriggerRef(t)
+
+	result := runTriggerCLI(t, env, dir, "--tag", "v1.0")
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stdout, "on 🏷 v1.0"))
+	assert.Check(t, cmp.DeepEqual(lastTriggerBody(t, fake, "pipeline/run"), map[string]any{
+		"config":   map[string]any{"tag": "v1.0"},
+		"checkout": map[string]any{"tag": "v1.0"},
+	}))
+}
+
+func TestRunTrigger_ParametersFile(t *testing.T) {
+	fake, env, dir, _ := setupTriggerRef(t)
+	params := filepath.Join(t.TempDir(), "params.yml")
+	assert.NilError(t, os.WriteFile(params, []byte("deploy:\n  env: staging\n  regions: [us, eu]\nreplicas: 3\nrun_e2e: false\n"), 0o644))
+
+	result := runTriggerCLI(t, env, dir, "--branch", "main", "--parameters-file", params, "--parameter", "run_e2e=true")
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.DeepEqual(lastTriggerBody(t, fake, "pipeline"), map[string]any{
+		"branch": "main",
+		"parameters": map[string]any{
+			"deploy":   map[string]any{"env": "staging", "regions": []any{"us", "eu"}},
+			"replicas": float64(3),
+			"run_e2e":  true,
+		},
+	}))
+}
+
+func TestRunTrigger_ParametersFile_Invalid(t *testing.T) {
+	_, env, dir, _ := setupTriggerRef(t)
+	params := filepath.Join(t.TempDir(), "params.json")
+	assert.NilError(t, os.WriteFile(params, []byte(`["not", "a", "map"]`), 0o644))
+
+	result := runTriggerCLI(t, env, dir, "--parameters-file", params)
+
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "could not parse "+params))
+}
+
+func TestRunTrigger_Watch(t *testing.T) {
+	tests := []struct {
+		outcome  string
+		wantExit int
+	}{
+		{outcome: "succeeded", wantExit: 0},
+		{outcome: "failed", wantExit: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.outcome, func(t *testing.T) {
+			fake, env, dir, _ := setupTriggerRef(t)
+			wfID := "b0000000-0000-4000-8000-00000000e001"
+			fake.AddRunV3(triggeredRunID, runTestProjectID, fakeRunV3(triggeredRunID, runTestProjectID, "ended", tt.outcome, "main", ""))
+			fake.AddRunWorkflowsV3(triggeredRunID, fakeWorkflowV3(wfID, "build", triggeredRunID, runTestProjectID, "ended", tt.outcome))
+
+			result := runTriggerCLI(t, env, dir, "--branch", "main", "--watch")
+
+			assert.Equal(t, result.ExitCode, tt.wantExit, "stderr: %s", result.Stderr)
+			assert.Check(t, cmp.Contains(result.Stdout, "Triggered run #44"))
+			assert.Check(t, cmp.Contains(result.Stderr, "Run "+triggeredRunID+" "+tt.outcome))
+		})
+	}
+}
+
+func TestRunTrigger_ConflictingRefs(t *testing.T) {
+	_, env, dir, shas := setupTriggerRef(t)
+
+	result := runTriggerCLI(t, env, dir, "--branch", "main", "--sha", shas[0])
+	assert.Check(t, cmp.Equal(result.ExitCode, 2))
+	assert.Check(t, cmp.Contains(result.Stderr, "--branch and --sha cannot be used together"))
+
+	result = runTriggerCLI(t, env, dir, "--local-config", "--tag", "v1.0")
+	assert.Check(t, cmp.Equal(result.ExitCode, 2))
+	assert.Check(t, cmp.Contains(result.Stderr, "cannot be used with --tag"))
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/skip"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+)
+
+type watchEventLine struct {
+	Time           string `json:"time"`
+	Type           string `json:"type"`
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	RunID          string `json:"run_id"`
+	WorkflowID     string `json:"workflow_id"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previous_status"`
+	URL            string `json:"url"`
+}
+
+func parseWatchEvents(t *testing.T, out string) []watchEventLine {
+	t.Helper()
+	var events []watchEventLine
+	sc := bufio.NewScanner(strings.NewReader(out))
+	for sc.Scan() {
+		var ev watchEventLine
+		assert.NilError(t, json.Unmarshal(sc.Bytes(), &ev), "line: %s", sc.Text())
+		events = append(events, ev)
+	}
+	return events
+}
+
+func TestRunWatch_EventsJSONL(t *testing.T) {
+	runID := "f0000000-0000-4000-8000-0000000e0001"
+	wfID := "b0000000-0000-4000-8000-0000000e0001"
+	_, env := setupWatchFake(t, runID, wfID, "success")
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "watch", runID, "--events", "jsonl"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	events := parseWatchEvents(t, result.Stdout)
+	assert.Assert(t, cmp.Len(events, 4), "stdout: %s", result.Stdout)
+
+	run := events[0]
+	assert.Check(t, cmp.Equal(run.Type, "run"))
+	assert.Check(t, cmp.Equal(run.ID, runID))
+	assert.Check(t, cmp.Equal(run.Status, "succeeded"))
+	assert.Check(t, cmp.Equal(run.PreviousStatus, ""))
+	assert.Check(t, strings.HasSuffix(run.URL, "/pipeline/"+runID), "url: %s", run.URL)
+	assert.Check(t, run.Time != "")
+
+	assert.Check(t, cmp.Equal(events[1].Type, "workflow"))
+	assert.Check(t, cmp.Equal(events[1].Name, "build"))
+	assert.Check(t, cmp.Equal(events[1].ID, wfID))
+
+	for _, ev := range events[2:] {
+		assert.Check(t, cmp.Equal(ev.Type, "job"))
+		assert.Check(t, cmp.Equal(ev.RunID, runID))
+		assert.Check(t, cmp.Equal(ev.WorkflowID, wfID))
+		assert.Check(t, strings.Contains(ev.URL, "/workflow/"+wfID+"/job/"+ev.ID), "url: %s", ev.URL)
+	}
+	assert.Check(t, cmp.Equal(events[2].Name, "lint"))
+	assert.Check(t, cmp.Equal(events[3].Name, "test"))
+}
+
+func TestRunWatch_EventsInvalidFormat(t *testing.T) {
+	_, env := setupWatchFake(t, "f0000000-0000-4000-8000-0000000e0002", "b0000000-0000-4000-8000-0000000e0002", "success")
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "watch", "f0000000-0000-4000-8000-0000000e0002", "--events", "xml"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, `"xml"`))
+	assert.Check(t, cmp.Equal(result.Stdout, ""))
+}
+
+func TestRunWatch_ExecHook(t *testing.T) {
+	skip.If(t, runtime.GOOS == "windows", "hook command uses POSIX sh")
+
+	runID := "f0000000-0000-4000-8000-0000000e0003"
+	wfID := "b0000000-0000-4000-8000-0000000e0003"
+	_, env := setupWatchFake(t, runID, wfID, "success")
+
+	out := filepath.Join(t.TempDir(), "hook.log")
+	hook := `echo "$CIRCLE_WATCH_EVENT $CIRCLE_WATCH_RUN_ID $CIRCLE_WATCH_RUN_STATUS $CIRCLE_WATCH_BRANCH $CIRCLE_WATCH_RUN_URL" >> ` + out
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "watch", runID, "--exec", hook},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	got, err := os.ReadFile(out)
+	assert.NilError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(got)), "\n")
+	assert.Assert(t, cmp.Len(lines, 1), "hook log: %s", got)
+	fields := strings.Fields(lines[0])
+	assert.Assert(t, cmp.Len(fields, 5), "hook log: %s", got)
+	assert.Check(t, cmp.DeepEqual(fields[:4], []string{"completed", runID, "succeeded", "main"}))
+	assert.Check(t, strings.HasSuffix(fields[4], "/pipeline/"+runID), "url: %s", fields[4])
+}
+
+// TestRunWatch_ExecHook_Failure verifies a failed run fires the hook twice,
+// first_failure before completed, with the failing job names, and that the
+// watch still exits with the run's result.
+func TestRunWatch_ExecHook_Failure(t *testing.T) {
+	skip.If(t, runtime.GOOS == "windows", "hook command uses POSIX sh")
+
+	runID := "f0000000-0000-4000-8000-0000000e0004"
+	wfID := "b0000000-0000-4000-8000-0000000e0004"
+	fake, env := setupWatchFake(t, runID, wfID, "failed")
+	failed := fakeJobV3("d0000000-0000-4000-8000-00000000f001", "lint", wfID, watchProjectID)
+	failed.Outcome = "failed"
+	fake.AddWorkflowJobsV3(wfID, failed,
+		fakeJobV3("d0000000-0000-4000-8000-00000000f002", "test", wfID, watchProjectID))
+
+	out := filepath.Join(t.TempDir(), "hook.log")
+	hook := `echo "$CIRCLE_WATCH_EVENT $CIRCLE_WATCH_RUN_STATUS $CIRCLE_WATCH_FAILED_JOBS" >> ` + out
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "watch", runID, "--exec", hook},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 1, "stderr: %s", result.Stderr)
+	got, err := os.ReadFile(out)
+	assert.NilError(t, err)
+	assert.Check(t, cmp.Equal(string(got), "first_failure failed lint\ncompleted failed lint\n"))
+}
+
+func TestRunWatch_ExecHook_FailureIsWarning(t *testing.T) {
+	skip.If(t, runtime.GOOS == "windows", "hook command uses POSIX sh")
+
+	runID := "f0000000-0000-4000-8000-0000000e0005"
+	_, env := setupWatchFake(t, runID, "b0000000-0000-4000-8000-0000000e0005", "success")
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "watch", runID, "--exec", "exit 3"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "warning: --exec hook for completed failed"))
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const retryRunID = "f0000000-0000-4000-8000-0000000e7001"
+
+// retryAttempt describes one attempt of the "build" workflow: its id and
+// whether its "test" and "e2e" jobs failed. The "lint" job always passes.
+type retryAttempt struct {
+	wfID       string
+	testFailed bool
+	e2eFailed  bool
+}
+
+// setupWatchRetryFake registers a run whose "build" workflow goes through the
+// given attempts. The first is what the run lists; each later one is the
+// workflow the previous attempt's rerun creates.
+func setupWatchRetryFake(t *testing.T, attempts ...retryAttempt) (*fakes.CircleCI, *testenv.TestEnv) {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+	addProjectBySlug(fake, watchSlug, watchProjectID)
+	fake.AddRunV3(retryRunID, watchProjectID, fakeRunV3(retryRunID, watchProjectID, "ended", "failed", "main", "abc1234def5678"))
+
+	for i, a := range attempts {
+		outcome := "succeeded"
+		if a.testFailed || a.e2eFailed {
+			outcome = "failed"
+		}
+		wf := fakeWorkflowV3(a.wfID, "build", retryRunID, watchProjectID, "ended", outcome)
+		if i == 0 {
+			fake.AddRunWorkflowsV3(retryRunID, wf)
+		} else {
+			fake.AddWorkflowV3(a.wfID, wf)
+		}
+
+		test := fakeJobV3("d0000000-0000-4000-8000-0000000e7"+string(rune('a'+i))+"01", "test", a.wfID, watchProjectID)
+		if a.testFailed {
+			test.Outcome = "failed"
+		}
+		e2e := fakeJobV3("d0000000-0000-4000-8000-0000000e7"+string(rune('a'+i))+"03", "e2e", a.wfID, watchProjectID)
+		if a.e2eFailed {
+			e2e.Outcome = "failed"
+		}
+		fake.AddWorkflowJobsV3(a.wfID,
+			fakeJobV3("d0000000-0000-4000-8000-0000000e7"+string(rune('a'+i))+"02", "lint", a.wfID, watchProjectID),
+			test,
+			e2e,
+		)
+
+		if i+1 < len(attempts) {
+			fake.SetRerunResponse(a.wfID, http.StatusCreated)
+			fake.SetRerunNewWorkflowID(a.wfID, attempts[i+1].wfID)
+		}
+	}
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return fake, env
+}
+
+func TestRunWatch_RetryFailed_Flaky(t *testing.T) {
+	first := "b0000000-0000-4000-8000-0000000e7a01"
+	second := "b0000000-0000-4000-8000-0000000e7a02"
+	fake, env := setupWatchRetryFake(t,
+		retryAttempt{wfID: first, testFailed: true},
+		retryAttempt{wfID: second},
+	)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "watch", retryRunID, "--retry-failed", "2"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	// The second attempt passed, and the exit code follows the last attempt.
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, fake.RerunWasFromFailed(first), "the rerun must be from failed")
+	assert.Check(t, cmp.Contains(result.Stderr, "Retrying 1 failed workflow(s) from failed (attempt 2 of 3)"))
+	assert.Check(t, cmp.Contains(result.Stderr, "flaky  build/test  failed on attempt 1, passed on attempt 2"))
+	// lint passed first time round, so it is not flaky.
+	assert.Check(t, !strings.Contains(result.Stderr, "build/lint"), "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "succeeded"))
+}
+
+// TestRunWatch_RetryFailed_FlakyAcrossAttempts covers a job that passed on
+// the second attempt while another needed a third: each is reported with the
+// attempt it first passed on, not the last one.
+func TestRunWatch_RetryFailed_FlakyAcrossAttempts(t *testing.T) {
+	_, env := setupWatchRetryFake(t,
+		retryAttempt{wfID: "b0000000-0000-4000-8000-0000000e7e01", testFailed: true, e2eFailed: true},
+		retryAttempt{wfID: "b0000000-0000-4000-8000-0000000e7e02", e2eFailed: true},
+		retryAttempt{wfID: "b0000000-0000-4000-8000-0000000e7e03"},
+	)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "watch", retryRunID, "--retry-failed", "2"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "Retrying 1 failed workflow(s) from failed (attempt 3 of 3)"))
+	assert.Check(t, cmp.Contains(result.Stderr, "flaky  build/test  failed on attempt 1, passed on attempt 2"))
+	assert.Check(t, cmp.Contains(result.Stderr, "flaky  build/e2e  failed on attempt 1, 2, passed on attempt 3"))
+}
+
+func TestRunWatch_RetryFailed_Exhausted(t *testing.T) {
+	first := "b0000000-0000-4000-8000-0000000e7b01"
+	second := "b0000000-0000-4000-8000-0000000e7b02"
+	third := "b0000000-0000-4000-8000-0000000e7b03"
+	fake, env := setupWatchRetryFake(t,
+		retryAttempt{wfID: first, testFailed: true},
+		retryAttempt{wfID: second, testFailed: true},
+		retryAttempt{wfID: third, testFailed: true},
+	)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "watch", retryRunID, "--retry-failed", "2"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 1, "stderr: %s", result.Stderr)
+	assert.Check(t, fake.RerunWasFromFailed(first))
+	assert.Check(t, fake.RerunWasFromFailed(second))
+	assert.Check(t, cmp.Len(fake.FindRequests(http.MethodPost, url.URL{Path: "/api/v3/workflows/" + third + "/rerun"}), 0),
+		"retries stop after --retry-failed attempts")
+	assert.Check(t, !strings.Contains(result.Stderr, "flaky"), "stderr: %s", result.Stderr)
+	// The log suggestion points at the job from the last attempt.
+	assert.Check(t, cmp.Contains(result.Stderr, "circleci job get d0000000-0000-4000-8000-0000000e7c01"),
+		"stderr: %s", result.Stderr)
+}
+
+func TestRunWatch_RetryFailed_NotNeeded(t *testing.T) {
+	fake, env := setupWatchRetryFake(t, retryAttempt{wfID: "b0000000-0000-4000-8000-0000000e7d01"})
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"run", "watch", retryRunID, "--retry-failed", "3"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, !strings.Contains(result.Stderr, "Retrying"), "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Len(fake.FindRequests(http.MethodPost, url.URL{Path: "/api/v3/workflows/b0000000-0000-4000-8000-0000000e7d01/rerun"}), 0))
+}
+
+func TestRunWatch_RetryFailed_InvalidFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "negative", args: []string{"--retry-failed", "-1"}, want: "--retry-failed must be zero or more"},
+		{name: "failfast", args: []string{"--retry-failed", "1", "--failfast"}, want: "--retry-failed cannot be used with --failfast"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := testenv.New(t)
+			env.Token = testToken
+
+			result := binary.RunCLI(t, binary.RunOpts{
+				Binary:  binaryPath,
+				Args:    append([]string{"run", "watch", retryRunID}, tt.args...),
+				Env:     env.Environ(),
+				WorkDir: t.TempDir(),
+			})
+
+			assert.Equal(t, result.ExitCode, 2, "stderr: %s", result.Stderr)
+			assert.Check(t, cmp.Contains(result.Stderr, tt.want))
+		})
+	}
+}
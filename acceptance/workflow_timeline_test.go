@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package acceptance_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/golden"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/binary"
+	testenv "github.com/CircleCI-Public/circleci-cli/internal/testing/env"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/fakes"
+)
+
+const (
+	timelineWfID     = "b0000000-0000-4000-8000-0000000071e1"
+	timelineCheckout = "d0000000-0000-4000-8000-0000000071e1"
+	timelineLint     = "d0000000-0000-4000-8000-0000000071e2"
+	timelineTest     = "d0000000-0000-4000-8000-0000000071e3"
+	timelineDeploy   = "d0000000-0000-4000-8000-0000000071e4"
+)
+
+// timelineAt formats a time offset seconds after the workflow was created.
+func timelineAt(offset int) string {
+	return time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC).Add(time.Duration(offset) * time.Second).Format(v3TimeFormat)
+}
+
+// timelineJob registers a job that ran from start to end (offsets in seconds),
+// with one execution per entry in execs, each a [start, end] pair.
+func timelineJob(fake *fakes.CircleCI, id, name string, start, end int, requires []string, execs ...[2]int) fakes.JobV3 {
+	job := fakeJobV3(id, name, timelineWfID, runTestProjectID)
+	job.StartedAt = timelineAt(start)
+	job.EndedAt = timelineAt(end)
+	job.Requires = requires
+	for _, e := range execs {
+		job.Executions = append(job.Executions, []fakes.JobStep{{
+			Name: "Run", Type: "run", Num: 101, Phase: "ended", Outcome: "succeeded",
+			StartedAt: timelineAt(e[0]), EndedAt: timelineAt(e[1]),
+		}})
+	}
+	fake.AddJobV3(job)
+	return job
+}
+
+// setupWorkflowTimelineFake registers a workflow where lint and a two-way
+// parallel test both require checkout and deploy requires both. test is the
+// slower branch, so the critical path is checkout → test → deploy.
+func setupWorkflowTimelineFake(t *testing.T, withRequires bool) *testenv.TestEnv {
+	t.Helper()
+	fake := fakes.NewCircleCI(t)
+
+	wf := fakeWorkflowV3(timelineWfID, "build", "f0000000-0000-4000-8000-0000000071e1", runTestProjectID, "ended", "succeeded")
+	wf.EndedAt = timelineAt(245)
+	fake.AddWorkflowV3(timelineWfID, wf)
+
+	req := func(ids ...string) []string {
+		if !withRequires {
+			return nil
+		}
+		return ids
+	}
+	fake.AddWorkflowJobsV3(timelineWfID,
+		timelineJob(fake, timelineCheckout, "checkout", 10, 40, nil, [2]int{12, 40}),
+		timelineJob(fake, timelineLint, "lint", 50, 80, req(timelineCheckout), [2]int{52, 80}),
+		timelineJob(fake, timelineTest, "test", 60, 180, req(timelineCheckout), [2]int{65, 180}, [2]int{70, 160}),
+		timelineJob(fake, timelineDeploy, "deploy", 210, 240, req(timelineLint, timelineTest), [2]int{212, 240}),
+	)
+
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+	return env
+}
+
+func TestWorkflowTimeline(t *testing.T) {
+	env := setupWorkflowTimelineFake(t, true)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"workflow", "timeline", timelineWfID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	golden.Assert(t, result.Stdout, t.Name()+".txt")
+}
+
+func TestWorkflowTimeline_JSON(t *testing.T) {
+	env := setupWorkflowTimelineFake(t, true)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"workflow", "timeline", timelineWfID, "--json"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+
+	var out struct {
+		DurationSeconds  float64 `json:"duration_seconds"`
+		RequiresInferred bool    `json:"requires_inferred"`
+		WallClock        struct {
+			RunningSeconds float64 `json:"running_seconds"`
+			QueuedSeconds  float64 `json:"queued_seconds"`
+			OtherSeconds   float64 `json:"other_seconds"`
+		} `json:"wall_clock"`
+		CriticalPath struct {
+			Jobs           []string `json:"jobs"`
+			QueuedSeconds  float64  `json:"queued_seconds"`
+			RunningSeconds float64  `json:"running_seconds"`
+		} `json:"critical_path"`
+		Jobs []struct {
+			Name       string   `json:"name"`
+			Requires   []string `json:"requires"`
+			Critical   bool     `json:"critical"`
+			Executions []any    `json:"executions"`
+		} `json:"jobs"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(result.Stdout), &out))
+
+	assert.Check(t, cmp.Equal(out.DurationSeconds, 245.0))
+	assert.Check(t, !out.RequiresInferred)
+	assert.Check(t, cmp.DeepEqual(out.CriticalPath.Jobs, []string{"checkout", "test", "deploy"}))
+	// checkout 10s + test 20s + deploy 30s waiting; 30s + 120s + 30s running.
+	assert.Check(t, cmp.Equal(out.CriticalPath.QueuedSeconds, 60.0))
+	assert.Check(t, cmp.Equal(out.CriticalPath.RunningSeconds, 180.0))
+	// An executor is busy over 12-40s, 52-180s and 212-240s. The rest, bar
+	// the 5s after deploy, is jobs waiting, including the gap between a job
+	// starting and its first execution.
+	assert.Check(t, cmp.Equal(out.WallClock.RunningSeconds, 184.0))
+	assert.Check(t, cmp.Equal(out.WallClock.QueuedSeconds, 56.0))
+	assert.Check(t, cmp.Equal(out.WallClock.OtherSeconds, 5.0))
+
+	assert.Assert(t, cmp.Len(out.Jobs, 4))
+	assert.Check(t, cmp.DeepEqual(out.Jobs[3].Requires, []string{"lint", "test"}))
+	assert.Check(t, !out.Jobs[1].Critical)
+	assert.Check(t, cmp.Len(out.Jobs[2].Executions, 2))
+}
+
+func TestWorkflowTimeline_InferredRequires(t *testing.T) {
+	env := setupWorkflowTimelineFake(t, false)
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"workflow", "timeline", timelineWfID, "--json", "--jq", ".requires_inferred, (.critical_path.jobs | join(\",\"))"},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 0, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Equal(result.Stdout, "true\ncheckout,test,deploy\n"))
+}
+
+func TestWorkflowTimeline_NotFound(t *testing.T) {
+	fake := fakes.NewCircleCI(t)
+	env := testenv.New(t)
+	env.Token = testToken
+	env.CircleCIURL = fake.URL()
+
+	result := binary.RunCLI(t, binary.RunOpts{
+		Binary:  binaryPath,
+		Args:    []string{"workflow", "timeline", timelineWfID},
+		Env:     env.Environ(),
+		WorkDir: t.TempDir(),
+	})
+
+	assert.Equal(t, result.ExitCode, 5, "stderr: %s", result.Stderr)
+	assert.Check(t, cmp.Contains(result.Stderr, "No workflow found"))
+}
@@ -52,6 +52,11 @@ import (
 // and leaves it applied; esc at the prompt cancels it, restoring whatever filter
 // was committed before.
 //
+// Files can be marked for a host action that takes several at once (a batch
+// download, say): ToggleMark marks the cursor row — a directory marks or
+// unmarks everything beneath it — and Marked lists what is marked. The model
+// binds no key to it; the host picks one and calls ToggleMark.
+//
 // Keys the model consumes: ↑/↓ (and k/j), pgup/pgdn, g/G, enter, →/l, ←/h, "/"
 // and — while the prompt is open — everything else as pattern text. Everything
 // else is left for the host, which should gate its own key handling on
@@ -76,6 +81,10 @@ type FileTreeModel struct {
 	sel    SelectModel
 	filter fileTreeFilter
 
+	// marked holds the marked leaves. It is replaced rather than written to,
+	// so a copy of the model keeps the marks it was taken with.
+	marked map[*fileTreeNode]bool
+
 	keys      []key.Binding
 	note      string
 	emptyNote string
@@ -134,9 +143,10 @@ func (f fileTreeFilter) pattern() string {
 
 // Glyphs for the two row kinds. A directory gets a muted marker and a trailing
 // slash; a file gets no glyph, so the name column stays flush with the directory
-// names above it.
+// names above it — unless it is marked, when it gets an accented one.
 const (
-	fileTreeDirGlyph = "▸"
+	fileTreeDirGlyph  = "▸"
+	fileTreeMarkGlyph = "●"
 )
 
 // NewFileTree returns a tree of entries, opened at its root. title heads the
@@ -226,6 +236,51 @@ func (m FileTreeModel) ClearSelection() FileTreeModel {
 	return m
 }
 
+// ToggleMark returns a copy with the cursor row's marks flipped: a file is marked
+// or unmarked, and a directory marks every file beneath it unless they all are
+// already, in which case it unmarks them. It is a no-op on an empty listing.
+func (m FileTreeModel) ToggleMark() FileTreeModel {
+	row, ok := m.highlighted()
+	if !ok {
+		return m
+	}
+	leaves := fileTreeLeafNodes(row.node, nil)
+	all := true
+	for _, n := range leaves {
+		all = all && m.marked[n]
+	}
+	marked := make(map[*fileTreeNode]bool, len(m.marked)+len(leaves))
+	for n := range m.marked {
+		marked[n] = true
+	}
+	for _, n := range leaves {
+		if all {
+			delete(marked, n)
+		} else {
+			marked[n] = true
+		}
+	}
+	m.marked = marked
+	return m.rebuild(m.Cursor())
+}
+
+// Marked are the marked entries, in listing order.
+func (m FileTreeModel) Marked() []FileTreeEntry {
+	var out []FileTreeEntry
+	for _, n := range fileTreeLeafNodes(m.root, nil) {
+		if m.marked[n] {
+			out = append(out, n.entry)
+		}
+	}
+	return out
+}
+
+// ClearMarks returns a copy with nothing marked.
+func (m FileTreeModel) ClearMarks() FileTreeModel {
+	m.marked = nil
+	return m.rebuild(m.Cursor())
+}
+
 // HighlightedIsDir reports whether the cursor is on a directory row. It is false
 // when the listing is empty.
 func (m FileTreeModel) HighlightedIsDir() bool {
@@ -382,9 +437,16 @@ func (m FileTreeModel) rebuild(cursor int) FileTreeModel {
 	icons := make([]string, len(m.rows))
 	for i, row := range m.rows {
 		labels[i] = row.label
-		if row.node.dir {
+		switch {
+		case row.node.dir:
 			icons[i] = theme.HelperStyle.Render(fileTreeDirGlyph)
-			labels[i] = row.label + "/  (" + fileCount(row.node.leaves) + ")"
+			count := fileCount(row.node.leaves)
+			if n := m.markedBeneath(row.node); n > 0 {
+				count += fmt.Sprintf(", %d marked", n)
+			}
+			labels[i] = row.label + "/  (" + count + ")"
+		case m.marked[row.node]:
+			icons[i] = theme.AccentStyle.Render(fileTreeMarkGlyph)
 		}
 	}
 	m.sel = NewSelectModel(m.title+" "+m.Dir(), labels).
@@ -601,6 +663,32 @@ func fileTreeLeaves(n *fileTreeNode, out []FileTreeEntry) []FileTreeEntry {
 	return out
 }
 
+// fileTreeLeafNodes is fileTreeLeaves for the nodes themselves, which is what
+// the marks are keyed on.
+func fileTreeLeafNodes(n *fileTreeNode, out []*fileTreeNode) []*fileTreeNode {
+	if !n.dir {
+		return append(out, n)
+	}
+	for _, c := range n.children {
+		out = fileTreeLeafNodes(c, out)
+	}
+	return out
+}
+
+// markedBeneath counts the marked files under a directory.
+func (m FileTreeModel) markedBeneath(n *fileTreeNode) int {
+	if len(m.marked) == 0 {
+		return 0
+	}
+	count := 0
+	for _, leaf := range fileTreeLeafNodes(n, nil) {
+		if m.marked[leaf] {
+			count++
+		}
+	}
+	return count
+}
+
 // fileCount renders a directory's leaf count for its row, singular where it
 // matters.
 func fileCount(n int) string {
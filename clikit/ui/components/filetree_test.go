@@ -465,6 +465,40 @@ func TestFileTree_ResizeReflowsTheWindow(t *testing.T) {
 	})
 }
 
+func TestFileTree_Marks(t *testing.T) {
+	tm := startTree(t, artifactPaths...)
+
+	assert.Assert(t, t.Run("a directory marks every file beneath it", func(t *testing.T) {
+		m := probe(t, tm).ToggleMark()
+		assert.Check(t, cmp.DeepEqual(entryPaths(m.Marked()), []string{
+			"reports/junit/shards/0.xml",
+			"reports/junit/shards/1.xml",
+			"reports/junit/results.xml",
+			"reports/summary.txt",
+		}))
+		assert.Check(t, cmp.Contains(frame(t, m), "reports/  (4 files, 4 marked)"))
+	}))
+
+	assert.Assert(t, t.Run("toggling a fully marked directory unmarks it", func(t *testing.T) {
+		m := probe(t, tm).ToggleMark().ToggleMark()
+		assert.Check(t, cmp.Len(m.Marked(), 0))
+	}))
+
+	assert.Assert(t, t.Run("a file is marked on its own and shows the glyph", func(t *testing.T) {
+		send(tm, treeDown, treeDown)
+		m := probe(t, tm).ToggleMark()
+		assert.Check(t, cmp.DeepEqual(entryPaths(m.Marked()), []string{"coverage.out"}))
+		assert.Check(t, cmp.Contains(frame(t, m), "● coverage.out"))
+	}))
+
+	assert.Assert(t, t.Run("a copy keeps the marks it was taken with", func(t *testing.T) {
+		marked := probe(t, tm).ToggleMark()
+		cleared := marked.ClearMarks()
+		assert.Check(t, cmp.Len(marked.Marked(), 1))
+		assert.Check(t, cmp.Len(cleared.Marked(), 0))
+	}))
+}
+
 // numberedEntries builds n flat entries, for the windowing tests.
 func numberedEntries(n int) []components.FileTreeEntry {
 	entries := make([]components.FileTreeEntry, n)
@@ -87,6 +87,7 @@ var (
 	// Actions a host can offer on a file-tree row.
 	BindDownload    = key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "download"))
 	BindOpenBrowser = key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "browser"))
+	BindMark        = key.NewBinding(key.WithKeys(" ", "space"), key.WithHelp("space", "mark"))
 
 	// File-tree navigation. Open covers both the enter and right keystrokes since
 	// they do the same thing on a tree row — descend into a directory, or open a
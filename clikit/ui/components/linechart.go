@@ -61,6 +61,10 @@ const (
 	// cell, so it is drawn from the same ink as the line it stands for.
 	legendGlyph = "⣿"
 
+	// markerGlyph draws a marker's rule: dotted, so it reads as a guide behind the
+	// data rather than as another series.
+	markerGlyph = '┊'
+
 	// xAxisStep only has to be non-zero. It is what makes the underlying chart
 	// reserve the two bottom rows for the x axis and its labels; the labels
 	// themselves are suppressed and the row is laid out by drawXAxis.
@@ -82,6 +86,16 @@ type ChartSeries struct {
 	Values []float64
 }
 
+// ChartMarker is a vertical rule drawn across a [LineChart] at one point along
+// the x axis — where something began, or "now" — and named beneath the plot.
+type ChartMarker struct {
+	// At is where the marker sits along the x axis, as a fraction from 0 (the
+	// first sample) to 1 (the last). Values outside that range are clamped.
+	At float64
+	// Label names the marker in the row beneath the plot.
+	Label string
+}
+
 // LineChart is a multi-series line chart drawn with braille dots, for embedding in
 // output rather than driving interactively: it renders to a plain string.
 //
@@ -137,6 +151,10 @@ type LineChart struct {
 	// so a caller overlaying more than one series should consider rendering a chart
 	// per series instead.
 	Color bool
+
+	// Markers are drawn as dotted vertical rules behind the data, each named in a
+	// row beneath the plot.
+	Markers []ChartMarker
 }
 
 // Render draws the chart as a newline-separated block with no trailing newline:
@@ -201,11 +219,15 @@ func (c LineChart) Render() string {
 	chart.DrawBrailleAll()
 	c.drawXAxis(&chart)
 	drawYAxisTicks(&chart)
+	c.drawMarkers(&chart)
 
 	plot := TrimTrailingSpace(chart.View())
 	if legend := c.legend(chart.Origin().X + 1); legend != "" {
 		plot += "\n" + legend
 	}
+	if markers := c.markerLegend(chart.Origin().X + 1); markers != "" {
+		plot += "\n" + markers
+	}
 	return plot
 }
 
@@ -277,6 +299,41 @@ func (c LineChart) legend(indent int) string {
 	return strings.Repeat(" ", indent) + strings.Join(parts, "  ")
 }
 
+// drawMarkers rules each marker's column through the plot. The rule only fills
+// empty cells, so a data line crossing it stays whole, and it meets the x axis
+// in a cross so the column can be read off the labels beneath.
+func (c LineChart) drawMarkers(chart *timeserieslinechart.Model) {
+	axis, plotW := chart.Origin(), chart.GraphWidth()
+	if plotW < 1 {
+		return
+	}
+	for _, mk := range c.Markers {
+		x := axis.X + 1 + int(math.Round(min(max(mk.At, 0), 1)*float64(plotW-1)))
+		for y := max(axis.Y-chart.GraphHeight(), 0); y < axis.Y; y++ {
+			p := canvas.Point{X: x, Y: y}
+			if r := chart.Canvas.Cell(p).Rune; r == runes.Null || r == ' ' || r == '\u2800' {
+				chart.Canvas.SetRune(p, markerGlyph)
+			}
+		}
+		chart.Canvas.SetRune(canvas.Point{X: x, Y: axis.Y}, '┼')
+	}
+}
+
+// markerLegend names each marker beside the glyph its rule is drawn in, indented
+// to line up with the plot.
+func (c LineChart) markerLegend(indent int) string {
+	parts := make([]string, 0, len(c.Markers))
+	for _, mk := range c.Markers {
+		if mk.Label != "" {
+			parts = append(parts, string(markerGlyph)+" "+mk.Label)
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return strings.Repeat(" ", indent) + strings.Join(parts, "  ")
+}
+
 // drawXAxis draws tick marks and labels onto the chart's x axis. The underlying
 // library leaves the rule bare and steps its own labels by column, which puts them
 // on unround values and drops whichever would overrun the canvas — usually the
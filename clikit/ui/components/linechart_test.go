@@ -150,6 +150,43 @@ func TestLineChartLegend(t *testing.T) {
 // TestLineChartColor checks that the per-series colors are emitted only when asked
 // for. They have to survive being written to a pipe — the charts are embedded in
 // markdown and rendered later — so this must not depend on a terminal.
+func TestLineChartMarkers(t *testing.T) {
+	chart := rampChart()
+	chart.Markers = []components.ChartMarker{{At: 0.5, Label: "Run tests"}}
+	lines := strings.Split(chart.Render(), "\n")
+
+	t.Run("legend names the marker", func(t *testing.T) {
+		// The plot rows, the axis and its labels, then the marker's name.
+		assert.Check(t, cmp.Equal(len(lines), components.LineChartDefaultHeight+1))
+		assert.Check(t, cmp.Equal(strings.TrimSpace(lines[len(lines)-1]), "┊ Run tests"))
+	})
+
+	t.Run("rule meets the axis in its own column", func(t *testing.T) {
+		axis := []rune(lines[len(lines)-3])
+		col := -1
+		for i, r := range axis {
+			if r == '┼' {
+				col = i
+			}
+		}
+		assert.Assert(t, col > 0, "no marker on the axis: %q", string(axis))
+		ruled := 0
+		for _, line := range lines[:len(lines)-3] {
+			if r := []rune(line); col < len(r) && r[col] == '┊' {
+				ruled++
+			}
+		}
+		// The rule fills the rows the data line leaves empty — the ramp peaks
+		// in this column, so not every row.
+		assert.Check(t, ruled > 0, "no marker rule in column %d", col)
+	})
+
+	t.Run("out of range is clamped onto the plot", func(t *testing.T) {
+		chart.Markers = []components.ChartMarker{{At: 2}}
+		assert.Check(t, cmp.Contains(chart.Render(), "┼"))
+	})
+}
+
 func TestLineChartColor(t *testing.T) {
 	c := rampChart()
 	c.Series = []components.ChartSeries{
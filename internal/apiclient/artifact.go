@@ -24,8 +24,11 @@ package apiclient
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
 )
@@ -72,11 +75,77 @@ func (c *Client) GetJobArtifactsV3(ctx context.Context, jobID string) ([]Artifac
 	return artifacts, nil
 }
 
-// DownloadArtifact fetches an artifact URL (authenticated) and writes its
-// contents to dst. The URL is a full absolute URL, not a base-relative path.
-func (c *Client) DownloadArtifact(ctx context.Context, artifactURL string, dst io.Writer) error {
+// ArtifactInfo is what the artifact store says about an artifact's content
+// without sending it.
+type ArtifactInfo struct {
+	// Size is the content length in bytes, or -1 when the store doesn't say.
+	Size int64
+	// ETag identifies this version of the content, quotes included, or is
+	// empty when the store doesn't send one.
+	ETag string
+}
+
+// StatArtifact asks for an artifact's size and ETag. It fetches the first byte
+// rather than sending HEAD: artifact URLs redirect to storage URLs signed for
+// GET alone, and stores commonly refuse HEAD on them.
+func (c *Client) StatArtifact(ctx context.Context, artifactURL string) (ArtifactInfo, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var h http.Header
 	_, err := c.raw.Call(ctx, httpcl.NewRequest(http.MethodGet, artifactURL,
-		httpcl.CopyDecoder(dst),
+		httpcl.Header("Range", "bytes=0-0"),
+		httpcl.CaptureHeader(&h),
+		httpcl.ReaderDecoder(func(io.Reader) error {
+			// A store that ignores the range is sending the whole artifact;
+			// the headers are all that's wanted, so stop it there.
+			if h.Get("Content-Range") == "" {
+				cancel()
+			}
+			return nil
+		}),
 	))
+	if httpcl.HasStatusCode(err, http.StatusRequestedRangeNotSatisfiable) {
+		// Only an empty artifact has no first byte to send.
+		return ArtifactInfo{Size: 0}, nil
+	}
+	if err != nil {
+		return ArtifactInfo{}, err
+	}
+	info := ArtifactInfo{Size: -1, ETag: h.Get("ETag")}
+	size := h.Get("Content-Length")
+	if cr := h.Get("Content-Range"); cr != "" {
+		// "bytes 0-0/<size>", where the size may be "*" if unknown.
+		_, size, _ = strings.Cut(cr, "/")
+	}
+	if n, err := strconv.ParseInt(size, 10, 64); err == nil {
+		info.Size = n
+	}
+	return info, nil
+}
+
+// DownloadArtifactFrom fetches an artifact from byte offset onwards, for
+// resuming a partial download. A non-empty etag is sent as If-Range, so an
+// artifact that has changed since is served whole rather than spliced onto the
+// old bytes. open is called once the response arrives, with resumed reporting
+// whether the server honoured the range; when it did not, the body is the
+// whole artifact from byte 0 and open must return a writer positioned there.
+func (c *Client) DownloadArtifactFrom(ctx context.Context, artifactURL string, offset int64, etag string, open func(resumed bool) (io.Writer, error)) error {
+	var h http.Header
+	opts := []func(*httpcl.Request){httpcl.CaptureHeader(&h)}
+	if offset > 0 {
+		opts = append(opts, httpcl.Header("Range", fmt.Sprintf("bytes=%d-", offset)))
+		if etag != "" {
+			opts = append(opts, httpcl.Header("If-Range", etag))
+		}
+	}
+	opts = append(opts, httpcl.ReaderDecoder(func(r io.Reader) error {
+		w, err := open(offset > 0 && h.Get("Content-Range") != "")
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(w, r)
+		return err
+	}))
+	_, err := c.raw.Call(ctx, httpcl.NewRequest(http.MethodGet, artifactURL, opts...))
 	return err
 }
@@ -38,7 +38,11 @@ type Client struct {
 	// including the version prefix: /api/v1.1, /api/v2 or /api/v3.
 	main *httpcl.Client
 	// raw has no base URL, for absolute URLs the API hands us (e.g. artifacts).
-	raw   *httpcl.Client
+	raw *httpcl.Client
+	// anon is raw without credentials, for presigned URLs: they carry their
+	// own signature, and the storage behind them rejects a request that also
+	// sends an Authorization header.
+	anon  *httpcl.Client
 	token string
 }
 
@@ -79,10 +83,13 @@ func New(cfg Config) *Client {
 
 	mainCfg := baseCfg
 	mainCfg.BaseURL = cfg.BaseURL
+	anonCfg := baseCfg
+	anonCfg.AuthToken = ""
 
 	return &Client{
 		main:  httpcl.New(mainCfg),
 		raw:   httpcl.New(baseCfg),
+		anon:  httpcl.New(anonCfg),
 		token: cfg.Token,
 	}
 }
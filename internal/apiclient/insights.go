@@ -0,0 +1,331 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+)
+
+// InsightsWindow is a reporting window the Insights summary endpoints accept.
+type InsightsWindow string
+
+const (
+	InsightsLast24Hours InsightsWindow = "last-24-hours"
+	InsightsLast7Days   InsightsWindow = "last-7-days"
+	InsightsLast30Days  InsightsWindow = "last-30-days"
+	InsightsLast60Days  InsightsWindow = "last-60-days"
+	InsightsLast90Days  InsightsWindow = "last-90-days"
+)
+
+// InsightsParams scopes an Insights summary. With neither Branch nor
+// AllBranches set, the API reports on the project's default branch.
+type InsightsParams struct {
+	Branch      string
+	AllBranches bool
+	Window      InsightsWindow
+}
+
+func (p InsightsParams) query() []func(*httpcl.Request) {
+	q := []func(*httpcl.Request){
+		httpcl.OptionalQueryParam("branch", p.Branch),
+		httpcl.OptionalQueryParam("reporting-window", string(p.Window)),
+	}
+	if p.AllBranches {
+		q = append(q, httpcl.QueryParam("all-branches", "true"))
+	}
+	return q
+}
+
+// InsightsDurations are duration statistics, in seconds, over a window's runs.
+type InsightsDurations struct {
+	Min               int64   `json:"min"`
+	Mean              int64   `json:"mean"`
+	Median            int64   `json:"median"`
+	P95               int64   `json:"p95"`
+	Max               int64   `json:"max"`
+	StandardDeviation float64 `json:"standard_deviation"`
+}
+
+// InsightsMetrics are a workflow's or job's aggregate metrics over a window.
+// Throughput is the mean number of runs a day. MTTR — the mean time, in
+// seconds, from a failed run to the next successful one — is only reported
+// for workflows, and is zero for jobs.
+type InsightsMetrics struct {
+	SuccessRate      float64           `json:"success_rate"`
+	TotalRuns        int               `json:"total_runs"`
+	FailedRuns       int               `json:"failed_runs"`
+	SuccessfulRuns   int               `json:"successful_runs"`
+	Throughput       float64           `json:"throughput"`
+	MTTR             int64             `json:"mttr"`
+	TotalCreditsUsed int64             `json:"total_credits_used"`
+	DurationMetrics  InsightsDurations `json:"duration_metrics"`
+}
+
+// InsightsSummary is one workflow's or job's metrics over a reporting window.
+type InsightsSummary struct {
+	Name        string          `json:"name"`
+	Metrics     InsightsMetrics `json:"metrics"`
+	WindowStart time.Time       `json:"window_start"`
+	WindowEnd   time.Time       `json:"window_end"`
+}
+
+// ListWorkflowInsights returns summary metrics for each of a project's
+// workflows. Paginates automatically.
+func (c *Client) ListWorkflowInsights(ctx context.Context, projectSlug string, p InsightsParams) ([]InsightsSummary, error) {
+	return c.listInsights(ctx, "/api/v2/insights/%s/workflows", []any{projectSlug}, p)
+}
+
+// ListJobInsights returns summary metrics for each job in a project's
+// workflow. Paginates automatically.
+func (c *Client) ListJobInsights(ctx context.Context, projectSlug, workflow string, p InsightsParams) ([]InsightsSummary, error) {
+	return c.listInsights(ctx, "/api/v2/insights/%s/workflows/%s/jobs", []any{projectSlug, workflow}, p)
+}
+
+func (c *Client) listInsights(ctx context.Context, route string, params []any, p InsightsParams) ([]InsightsSummary, error) {
+	all := []InsightsSummary{}
+	pageToken := ""
+
+	for {
+		var resp struct {
+			Items         []InsightsSummary `json:"items"`
+			NextPageToken string            `json:"next_page_token"`
+		}
+		opts := []func(*httpcl.Request){
+			httpcl.RouteParams(params...),
+			httpcl.OptionalQueryParam("page-token", pageToken),
+			httpcl.JSONDecoder(&resp),
+		}
+		opts = append(opts, p.query()...)
+		_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodGet, route, opts...))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Items...)
+		if resp.NextPageToken == "" {
+			return all, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// InsightsRun is one run of a workflow as Insights records it. Duration is in
+// seconds.
+type InsightsRun struct {
+	ID          uuid.UUID `json:"id"`
+	Branch      string    `json:"branch"`
+	Status      string    `json:"status"`
+	Duration    int64     `json:"duration"`
+	CreditsUsed int64     `json:"credits_used"`
+	IsApproval  bool      `json:"is_approval"`
+	CreatedAt   time.Time `json:"created_at"`
+	StoppedAt   time.Time `json:"stopped_at"`
+}
+
+// InsightsRunParams scopes a workflow's run history to a branch and a date
+// range. With neither Branch nor AllBranches set, the API lists runs on the
+// project's default branch.
+type InsightsRunParams struct {
+	Branch      string
+	AllBranches bool
+	Start       time.Time
+	End         time.Time
+}
+
+// ListWorkflowRunInsights returns a workflow's runs, newest first. It stops
+// paging once it has limit runs; a limit of zero or less fetches them all.
+func (c *Client) ListWorkflowRunInsights(ctx context.Context, projectSlug, workflow string, p InsightsRunParams, limit int) ([]InsightsRun, error) {
+	all := []InsightsRun{}
+	pageToken := ""
+
+	for {
+		var resp struct {
+			Items         []InsightsRun `json:"items"`
+			NextPageToken string        `json:"next_page_token"`
+		}
+		opts := []func(*httpcl.Request){
+			httpcl.RouteParams(projectSlug, workflow),
+			httpcl.OptionalQueryParam("branch", p.Branch),
+			httpcl.OptionalQueryParam("start-date", insightsDate(p.Start)),
+			httpcl.OptionalQueryParam("end-date", insightsDate(p.End)),
+			httpcl.OptionalQueryParam("page-token", pageToken),
+			httpcl.JSONDecoder(&resp),
+		}
+		if p.AllBranches {
+			opts = append(opts, httpcl.QueryParam("all-branches", "true"))
+		}
+		_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodGet, "/api/v2/insights/%s/workflows/%s", opts...))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Items...)
+		if limit > 0 && len(all) >= limit {
+			return all[:limit], nil
+		}
+		if resp.NextPageToken == "" {
+			return all, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// InsightsGranularity is the bucket size of an Insights time series.
+type InsightsGranularity string
+
+const (
+	InsightsHourly InsightsGranularity = "hourly"
+	InsightsDaily  InsightsGranularity = "daily"
+)
+
+// InsightsJobBucket is one job's metrics over one bucket of a time series.
+// Durations are in seconds; Total is the summed duration of every run in the
+// bucket rather than a statistic of one.
+type InsightsJobBucket struct {
+	Name      string    `json:"name"`
+	Timestamp time.Time `json:"timestamp"`
+	Metrics   struct {
+		TotalRuns         int     `json:"total_runs"`
+		FailedRuns        int     `json:"failed_runs"`
+		SuccessfulRuns    int     `json:"successful_runs"`
+		Throughput        float64 `json:"throughput"`
+		MedianCreditsUsed int64   `json:"median_credits_used"`
+		TotalCreditsUsed  int64   `json:"total_credits_used"`
+		DurationMetrics   struct {
+			Min    int64 `json:"min"`
+			Median int64 `json:"median"`
+			Max    int64 `json:"max"`
+			P95    int64 `json:"p95"`
+			Total  int64 `json:"total"`
+		} `json:"duration_metrics"`
+	} `json:"metrics"`
+}
+
+// InsightsSeriesParams scopes a job time series. An empty Branch means the
+// project's default branch.
+type InsightsSeriesParams struct {
+	Branch      string
+	Granularity InsightsGranularity
+	Start       time.Time
+	End         time.Time
+}
+
+// ListJobInsightsSeries returns the time series of every job in a workflow,
+// one bucket per job per Granularity. Paginates automatically.
+func (c *Client) ListJobInsightsSeries(ctx context.Context, projectSlug, workflow string, p InsightsSeriesParams) ([]InsightsJobBucket, error) {
+	all := []InsightsJobBucket{}
+	pageToken := ""
+
+	for {
+		var resp struct {
+			Items         []InsightsJobBucket `json:"items"`
+			NextPageToken string              `json:"next_page_token"`
+		}
+		_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodGet, "/api/v2/insights/time-series/%s/workflows/%s/jobs",
+			httpcl.RouteParams(projectSlug, workflow),
+			httpcl.OptionalQueryParam("branch", p.Branch),
+			httpcl.OptionalQueryParam("granularity", string(p.Granularity)),
+			httpcl.OptionalQueryParam("start-date", insightsDate(p.Start)),
+			httpcl.OptionalQueryParam("end-date", insightsDate(p.End)),
+			httpcl.OptionalQueryParam("page-token", pageToken),
+			httpcl.JSONDecoder(&resp),
+		))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Items...)
+		if resp.NextPageToken == "" {
+			return all, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// ListInsightsBranches returns the branches a workflow has run on, or that any
+// of the project's workflows have when workflow is empty.
+func (c *Client) ListInsightsBranches(ctx context.Context, projectSlug, workflow string) ([]string, error) {
+	var resp struct {
+		Branches []string `json:"branches"`
+	}
+	_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodGet, "/api/v2/insights/%s/branches",
+		httpcl.RouteParams(projectSlug),
+		httpcl.OptionalQueryParam("workflow-name", workflow),
+		httpcl.JSONDecoder(&resp),
+	))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Branches, nil
+}
+
+// FlakyTest is a test Insights has seen both pass and fail on the same commit.
+// TimeWasted is in seconds, summed over the runs the test flaked in.
+type FlakyTest struct {
+	TestName       string    `json:"test_name"`
+	Classname      string    `json:"classname"`
+	File           string    `json:"file"`
+	Source         string    `json:"source"`
+	JobName        string    `json:"job_name"`
+	JobNumber      int64     `json:"job_number"`
+	WorkflowName   string    `json:"workflow_name"`
+	WorkflowID     uuid.UUID `json:"workflow_id"`
+	PipelineNumber int64     `json:"pipeline_number"`
+	TimesFlaked    int       `json:"times_flaked"`
+	TimeWasted     int64     `json:"time_wasted"`
+	// WorkflowCreatedAt is when the most recent workflow the test flaked in
+	// was created.
+	WorkflowCreatedAt time.Time `json:"workflow_created_at"`
+}
+
+// FlakyTests is a project's flaky tests, and how many there are in all; the
+// API may list fewer than the total.
+type FlakyTests struct {
+	Tests []FlakyTest `json:"flaky_tests"`
+	Total int         `json:"total_flaky_tests"`
+}
+
+// GetFlakyTests returns the tests Insights has flagged as flaky in a project.
+func (c *Client) GetFlakyTests(ctx context.Context, projectSlug string) (*FlakyTests, error) {
+	var resp FlakyTests
+	_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodGet, "/api/v2/insights/%s/flaky-tests",
+		httpcl.RouteParams(projectSlug),
+		httpcl.JSONDecoder(&resp),
+	))
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// insightsDate renders t as the RFC 3339 timestamp the Insights date
+// parameters take, or "" for the zero time so the parameter is left out.
+func insightsDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
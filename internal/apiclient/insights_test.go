@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package apiclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/httprecorder"
+	"github.com/CircleCI-Public/circleci-cli/internal/testing/httprecorder/chirecorder"
+)
+
+func TestClient_ListWorkflowRunInsights(t *testing.T) {
+	ctx := iostream.Testing(context.Background())
+
+	// Three pages of two runs each, the page token being the page number. The
+	// slug travels as one escaped path segment.
+	rec := httprecorder.New()
+	r := chi.NewMux()
+	r.Use(chirecorder.Middleware(rec))
+	r.Get("/api/v2/insights/gh%2Facme%2Fapi/workflows/{workflow}", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page-token"))
+		var next any
+		if page < 2 {
+			next = strconv.Itoa(page + 1)
+		}
+		render.JSON(w, r, map[string]any{
+			"items": []map[string]any{
+				{"duration": page*2 + 1, "branch": "main"},
+				{"duration": page*2 + 2, "branch": "main"},
+			},
+			"next_page_token": next,
+		})
+	})
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+
+	c := apiclient.New(apiclient.Config{BaseURL: srv.URL, Token: "the-token"})
+	params := apiclient.InsightsRunParams{Branch: "main"}
+
+	t.Run("stops paging at the limit", func(t *testing.T) {
+		runs, err := c.ListWorkflowRunInsights(ctx, "gh/acme/api", "build", params, 3)
+		assert.NilError(t, err)
+		assert.Check(t, cmp.Len(runs, 3))
+		assert.Check(t, cmp.Equal(runs[2].Duration, int64(3)))
+		assert.Check(t, cmp.Equal(rec.LastRequest().URL.Query().Get("page-token"), "1"))
+		assert.Check(t, cmp.Equal(rec.LastRequest().URL.Query().Get("branch"), "main"))
+		assert.Check(t, cmp.Equal(rec.LastRequest().URL.Path, "/api/v2/insights/gh/acme/api/workflows/build"))
+	})
+
+	t.Run("no limit reads every page", func(t *testing.T) {
+		runs, err := c.ListWorkflowRunInsights(ctx, "gh/acme/api", "build", params, 0)
+		assert.NilError(t, err)
+		assert.Check(t, cmp.Len(runs, 6))
+	})
+}
@@ -176,6 +176,25 @@ func (c *Client) GetJobV3(ctx context.Context, id uuid.UUID) (*JobV3, error) {
 	return env.Data.toJobV3(), nil
 }
 
+// ApproveJob approves a pending approval job, letting the jobs that require it
+// run. The V3 API has no approval endpoint, so this goes through the V2 one; for
+// an approval job the approval request ID is the job's own ID.
+func (c *Client) ApproveJob(ctx context.Context, workflowID, jobID uuid.UUID) error {
+	_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodPost, "/api/v2/workflow/%s/approve/%s",
+		httpcl.RouteParams(workflowID, jobID),
+	))
+	return err
+}
+
+// CancelJob requests cancellation of a single job, leaving the rest of its
+// workflow running. Like ApproveJob it uses the V2 endpoint.
+func (c *Client) CancelJob(ctx context.Context, jobID uuid.UUID) error {
+	_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodPost, "/api/v2/jobs/%s/cancel",
+		httpcl.RouteParams(jobID),
+	))
+	return err
+}
+
 func (w jobWire) toJobV3() *JobV3 {
 	a := w.Attributes
 	j := &JobV3{
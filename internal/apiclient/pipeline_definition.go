@@ -179,7 +179,10 @@ type TriggerPipelineRunInput struct {
 	ConfigTag      string
 	CheckoutBranch string
 	CheckoutTag    string
-	Parameters     map[string]any
+	// ConfigContent, when set, is compiled config YAML the run uses in place
+	// of the config stored at ConfigBranch or ConfigTag.
+	ConfigContent string
+	Parameters    map[string]any
 }
 
 // TriggerPipelineRunResult holds the response from triggering a pipeline run.
@@ -218,6 +221,9 @@ func (c *Client) TriggerPipelineRun(ctx context.Context, projectSlug string, inp
 	} else if input.ConfigTag != "" {
 		cfg["tag"] = input.ConfigTag
 	}
+	if input.ConfigContent != "" {
+		cfg["content"] = input.ConfigContent
+	}
 	if len(cfg) > 0 {
 		body["config"] = cfg
 	}
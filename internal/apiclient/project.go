@@ -27,6 +27,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -54,6 +55,24 @@ type Project struct {
 	RepoName string `json:"reponame"`
 }
 
+// FullSlug returns the project's vcs/org/repo slug, building it from its parts
+// when the v1.1 API omits the top-level slug field.
+func (p Project) FullSlug() string {
+	if p.Slug != "" {
+		return p.Slug
+	}
+	var vcs string
+	switch p.VCSType {
+	case "github":
+		vcs = "gh"
+	case "bitbucket":
+		vcs = "bb"
+	default:
+		vcs = strings.ToLower(p.VCSType)
+	}
+	return vcs + "/" + p.Username + "/" + p.RepoName
+}
+
 // EnvVar is a project environment variable.
 // The value is masked in list responses; it is only returned on set.
 type EnvVar struct {
@@ -78,6 +78,9 @@ type runReferencesWire struct {
 	User struct {
 		ID uuid.UUID `json:"id"`
 	} `json:"user"`
+	PipelineDefinition struct {
+		ID string `json:"id"`
+	} `json:"pipeline_definition"`
 }
 
 type runEventRefWire struct {
@@ -132,6 +135,11 @@ type RunV3 struct {
 	CreatedAt      time.Time  `json:"created_at"`
 	ProjectID      uuid.UUID  `json:"project_id"`
 	Errors         []RunError `json:"errors,omitempty"`
+	// UserID is the user who triggered the run.
+	UserID uuid.UUID `json:"user_id"`
+	// TriggerType is the trigger's event source, e.g. "webhook" or "schedule".
+	TriggerType          string `json:"trigger_type,omitempty"`
+	PipelineDefinitionID string `json:"pipeline_definition_id,omitempty"`
 }
 
 // Status derives a display status from phase and outcome.
@@ -148,6 +156,10 @@ func (w runWire) toRunV3() *RunV3 {
 		CurrentOutcome: a.CurrentOutcome,
 		CreatedAt:      a.CreatedAt,
 		ProjectID:      w.References.Project.ID,
+
+		UserID:               w.References.User.ID,
+		TriggerType:          w.References.Trigger.Attributes.EventSource.Type,
+		PipelineDefinitionID: w.References.PipelineDefinition.ID,
 	}
 	if v := w.References.Event.Attributes.VCS; v != nil {
 		r.Branch = v.Branch
@@ -255,28 +267,60 @@ func paginateRunsV3(
 // It paginates transparently when params.Limit exceeds maxRunsPageSize.
 func (c *Client) SearchRunsV3(ctx context.Context, params RunSearchParams) ([]RunV3, error) {
 	return paginateRunsV3(params.Limit, params.Cursor, func(pageSize int, cursor string) (v3List[runWire], error) {
-		body := runSearchRequest{
-			Scope: runSearchScope{
-				ProjectIDs: params.ProjectIDs,
-				From:       params.From.Format(time.RFC3339),
-				To:         params.To.Format(time.RFC3339),
-			},
-			Filter:  params.Filter,
-			OrderBy: params.OrderBy,
-			Page: runSearchPage{
-				Cursor: cursor,
-				Limit:  pageSize,
-			},
-		}
-		var resp v3List[runWire]
-		_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodPost, "/api/v3/runs/search",
-			httpcl.Body(body),
-			httpcl.JSONDecoder(&resp),
-		))
-		return resp, err
+		return c.searchRunsPage(ctx, params, pageSize, cursor)
 	})
 }
 
+// SearchRunsV3Pages walks the V3 search results one page at a time, calling fn
+// with each page until the cursor is exhausted or fn returns false. Only one
+// page is held at a time, so callers can stream an unbounded result set.
+// params.Limit is the page size, clamped to maxRunsPageSize; zero uses the
+// maximum.
+func (c *Client) SearchRunsV3Pages(ctx context.Context, params RunSearchParams, fn func([]RunV3) (bool, error)) error {
+	pageSize := params.Limit
+	if pageSize <= 0 || pageSize > maxRunsPageSize {
+		pageSize = maxRunsPageSize
+	}
+	cursor := params.Cursor
+	for {
+		resp, err := c.searchRunsPage(ctx, params, pageSize, cursor)
+		if err != nil {
+			return err
+		}
+		runs := make([]RunV3, len(resp.Data))
+		for i, w := range resp.Data {
+			runs[i] = *w.toRunV3()
+		}
+		more, err := fn(runs)
+		if err != nil || !more || resp.Page.Next == nil || len(resp.Data) == 0 {
+			return err
+		}
+		cursor = *resp.Page.Next
+	}
+}
+
+func (c *Client) searchRunsPage(ctx context.Context, params RunSearchParams, pageSize int, cursor string) (v3List[runWire], error) {
+	body := runSearchRequest{
+		Scope: runSearchScope{
+			ProjectIDs: params.ProjectIDs,
+			From:       params.From.Format(time.RFC3339),
+			To:         params.To.Format(time.RFC3339),
+		},
+		Filter:  params.Filter,
+		OrderBy: params.OrderBy,
+		Page: runSearchPage{
+			Cursor: cursor,
+			Limit:  pageSize,
+		},
+	}
+	var resp v3List[runWire]
+	_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodPost, "/api/v3/runs/search",
+		httpcl.Body(body),
+		httpcl.JSONDecoder(&resp),
+	))
+	return resp, err
+}
+
 // MyRunsParams configures a ListMyRunsV3 request. All fields are optional: the
 // zero value lists every recent run the endpoint defaults to.
 type MyRunsParams struct {
@@ -380,12 +424,29 @@ func rfc3339OrEmpty(t *time.Time) string {
 
 // BuildRunFilter constructs a filter expression for the V3 runs/search endpoint.
 func BuildRunFilter(branch, status string) string {
+	return RunFilter{Branch: branch, Status: status}.Expr()
+}
+
+// RunFilter is the set of fields the V3 runs/search filter expression can pin.
+// Empty fields are left unconstrained.
+type RunFilter struct {
+	Branch   string
+	Status   string
+	Revision string
+}
+
+// Expr renders f as a runs/search filter expression, its terms joined with
+// "and".
+func (f RunFilter) Expr() string {
 	var parts []string
-	if branch != "" {
-		parts = append(parts, fmt.Sprintf("pipeline.git.branch == %q", branch))
+	if f.Branch != "" {
+		parts = append(parts, fmt.Sprintf("pipeline.git.branch == %q", f.Branch))
+	}
+	if f.Status != "" {
+		parts = append(parts, fmt.Sprintf("pipeline.status == %q", f.Status))
 	}
-	if status != "" {
-		parts = append(parts, fmt.Sprintf("pipeline.status == %q", status))
+	if f.Revision != "" {
+		parts = append(parts, fmt.Sprintf("pipeline.git.revision == %q", f.Revision))
 	}
 	return strings.Join(parts, " and ")
 }
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package apiclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+)
+
+// Usage export job states.
+const (
+	UsageExportCreated    = "created"
+	UsageExportProcessing = "processing"
+	UsageExportCompleted  = "completed"
+	UsageExportFailed     = "failed"
+)
+
+// UsageExport is an organization's usage export job. DownloadURLs are set once
+// the job has completed: presigned links to gzipped CSV files, which expire a
+// few hours later.
+type UsageExport struct {
+	ID            uuid.UUID `json:"usage_export_job_id"`
+	State         string    `json:"state"`
+	FailureReason string    `json:"failure_reason,omitempty"`
+	ErrorReason   string    `json:"error_reason,omitempty"`
+	DownloadURLs  []string  `json:"download_urls"`
+}
+
+// Done reports whether the job has stopped, successfully or not.
+func (e UsageExport) Done() bool {
+	return e.State == UsageExportCompleted || e.State == UsageExportFailed
+}
+
+// CreateUsageExport starts a job exporting an organization's usage between
+// start and end. The API bounds the range: start no more than a year back,
+// and end at most 32 days after it.
+func (c *Client) CreateUsageExport(ctx context.Context, orgID uuid.UUID, start, end time.Time) (*UsageExport, error) {
+	body := map[string]any{
+		"start":          start.UTC().Format(time.RFC3339),
+		"end":            end.UTC().Format(time.RFC3339),
+		"shared_org_ids": []string{},
+	}
+	var job UsageExport
+	_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodPost, "/api/v2/organizations/%s/usage_export_job",
+		httpcl.RouteParams(orgID),
+		httpcl.Body(body),
+		httpcl.JSONDecoder(&job),
+	))
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// GetUsageExport fetches a usage export job's current state.
+func (c *Client) GetUsageExport(ctx context.Context, orgID, jobID uuid.UUID) (*UsageExport, error) {
+	var job UsageExport
+	_, err := c.main.Call(ctx, httpcl.NewRequest(http.MethodGet, "/api/v2/organizations/%s/usage_export_job/%s",
+		httpcl.RouteParams(orgID, jobID),
+		httpcl.JSONDecoder(&job),
+	))
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// DownloadUsageExport fetches one of a completed export's download URLs and
+// writes the (still gzipped) file to dst. The URL is presigned, so the request
+// carries no API token.
+func (c *Client) DownloadUsageExport(ctx context.Context, downloadURL string, dst io.Writer) error {
+	_, err := c.anon.Call(ctx, httpcl.NewRequest(http.MethodGet, downloadURL,
+		httpcl.CopyDecoder(dst),
+	))
+	return err
+}
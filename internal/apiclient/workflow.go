@@ -171,13 +171,14 @@ func (c *Client) CancelWorkflow(ctx context.Context, id uuid.UUID) error {
 // --- V3 workflow jobs ---
 
 type workflowJobAttributesWire struct {
-	Name           string     `json:"name"`
-	Phase          string     `json:"phase"`
-	Type           string     `json:"type,omitempty"`
-	Outcome        string     `json:"outcome,omitempty"`
-	CurrentOutcome string     `json:"current_outcome,omitempty"`
-	StartedAt      *time.Time `json:"started_at,omitempty"`
-	EndedAt        *time.Time `json:"ended_at,omitempty"`
+	Name           string      `json:"name"`
+	Phase          string      `json:"phase"`
+	Type           string      `json:"type,omitempty"`
+	Outcome        string      `json:"outcome,omitempty"`
+	CurrentOutcome string      `json:"current_outcome,omitempty"`
+	StartedAt      *time.Time  `json:"started_at,omitempty"`
+	EndedAt        *time.Time  `json:"ended_at,omitempty"`
+	Dependencies   []uuid.UUID `json:"dependencies,omitempty"`
 }
 
 type workflowJobReferencesWire struct {
@@ -206,6 +207,9 @@ type WorkflowJobV3 struct {
 	ProjectID      uuid.UUID  `json:"project_id"`
 	StartedAt      *time.Time `json:"started_at,omitempty"`
 	EndedAt        *time.Time `json:"ended_at,omitempty"`
+	// Dependencies are the IDs of the jobs this job requires. Older API
+	// responses omit them, so an empty list does not mean the job is a root.
+	Dependencies []uuid.UUID `json:"dependencies,omitempty"`
 }
 
 // Status derives a display status from phase and outcome.
@@ -213,6 +217,15 @@ func (w WorkflowJobV3) Status() string {
 	return PhaseOutcomeStatus(w.Phase, w.Outcome, w.CurrentOutcome)
 }
 
+// JobTypeApproval is the type of a workflow's manual approval (hold) job.
+const JobTypeApproval = "approval"
+
+// AwaitingApproval reports whether w is an approval job that has not yet been
+// approved or cancelled.
+func (w WorkflowJobV3) AwaitingApproval() bool {
+	return w.Type == JobTypeApproval && w.Phase != PhaseEnded
+}
+
 func (w workflowJobWire) toDomain() WorkflowJobV3 {
 	a := w.Attributes
 	return WorkflowJobV3{
@@ -225,6 +238,7 @@ func (w workflowJobWire) toDomain() WorkflowJobV3 {
 		ProjectID:      w.References.Project.ID,
 		StartedAt:      a.StartedAt,
 		EndedAt:        a.EndedAt,
+		Dependencies:   a.Dependencies,
 	}
 }
 
@@ -28,8 +28,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"os"
-	"path/filepath"
 	"sort"
 	"strings"
 
@@ -46,7 +44,8 @@ type Entry struct {
 // Client is the subset of apiclient.Client methods we need.
 type Client interface {
 	GetJobArtifactsV3(ctx context.Context, jobID string) ([]apiclient.Artifact, error)
-	DownloadArtifact(ctx context.Context, artifactURL string, dst io.Writer) error
+	StatArtifact(ctx context.Context, artifactURL string) (apiclient.ArtifactInfo, error)
+	DownloadArtifactFrom(ctx context.Context, artifactURL string, offset int64, etag string, open func(resumed bool) (io.Writer, error)) error
 }
 
 // ForJob fetches artifacts for a job identified by UUID.
@@ -119,63 +118,3 @@ func FormatMarkdown(entries []Entry) string {
 	}
 	return md.String()
 }
-
-// Download fetches each entry's artifact and writes it under dir, preserving
-// the artifact's Path as the relative file path. When entries span multiple
-// execution indices, each execution's artifacts are placed under a
-// subdirectory named by the index (e.g. dir/exec-0000/path, dir/exec-0003/path).
-func Download(ctx context.Context, client Client, entries []Entry, dir string) error {
-	if !hasMultipleExecutions(entries) {
-		return DownloadPaths(ctx, client, entries, dir)
-	}
-	laid := make([]Entry, len(entries))
-	for i, e := range entries {
-		laid[i] = e
-		laid[i].Path = ExecDir(e.Execution) + "/" + strings.TrimPrefix(e.Path, "/")
-	}
-	return DownloadPaths(ctx, client, laid, dir)
-}
-
-// DownloadPaths fetches each entry's artifact and writes it at dir/<Path>,
-// applying no layout of its own — the caller has already put whatever structure
-// it wants in each Path. Use it when the destination layout is decided elsewhere
-// (the run-get artifact browser downloads exactly the paths it displayed, for any
-// subset of a job's artifacts); use Download to have the per-execution grouping
-// derived from the entries themselves.
-func DownloadPaths(ctx context.Context, client Client, entries []Entry, dir string) error {
-	// cleanDir is the canonical form of the download root with a trailing
-	// separator.  We recompute it once outside the loop rather than on every
-	// iteration.
-	cleanDir := filepath.Clean(dir) + string(os.PathSeparator)
-
-	for _, e := range entries {
-		dest := filepath.Join(dir, filepath.FromSlash(e.Path))
-
-		// Path-traversal guard: artifact paths come from the CircleCI API
-		// response and are not under our control.  filepath.Join resolves
-		// all ".." components (via filepath.Clean), so a server-supplied
-		// path such as "../../.ssh/authorized_keys" would silently escape
-		// dir and produce an arbitrary write destination.  We therefore
-		// require that the resolved destination still sits inside dir.
-		if !strings.HasPrefix(dest, cleanDir) {
-			return fmt.Errorf("artifact path %q escapes download directory", e.Path)
-		}
-
-		if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil { //#nosec:G301 // 0o755 is appropriate for artifact download directories
-			return fmt.Errorf("creating directory for %q: %w", e.Path, err)
-		}
-		f, err := os.Create(dest) //#nosec:G304 // dest is validated above via HasPrefix check against the clean download dir
-		if err != nil {
-			return fmt.Errorf("creating file %q: %w", dest, err)
-		}
-		dlErr := client.DownloadArtifact(ctx, e.URL, f)
-		closeErr := f.Close()
-		if dlErr != nil {
-			return fmt.Errorf("downloading %q: %w", e.Path, dlErr)
-		}
-		if closeErr != nil {
-			return fmt.Errorf("writing %q: %w", e.Path, closeErr)
-		}
-	}
-	return nil
-}
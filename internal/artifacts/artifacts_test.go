@@ -34,14 +34,21 @@ import (
 	"github.com/CircleCI-Public/circleci-cli/internal/artifacts"
 )
 
-// noopClient satisfies artifacts.Client; DownloadArtifact writes fixed content.
+// noopClient satisfies artifacts.Client; DownloadArtifactFrom writes fixed content.
 type noopClient struct{}
 
 func (noopClient) GetJobArtifactsV3(_ context.Context, _ string) ([]apiclient.Artifact, error) {
 	return nil, nil
 }
-func (noopClient) DownloadArtifact(_ context.Context, _ string, dst io.Writer) error {
-	_, err := io.WriteString(dst, "content")
+func (noopClient) StatArtifact(_ context.Context, _ string) (apiclient.ArtifactInfo, error) {
+	return apiclient.ArtifactInfo{Size: -1}, nil
+}
+func (noopClient) DownloadArtifactFrom(_ context.Context, _ string, _ int64, _ string, open func(bool) (io.Writer, error)) error {
+	dst, err := open(false)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(dst, "content")
 	return err
 }
 
@@ -80,7 +87,7 @@ func TestDownload_PathTraversal(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			dir := t.TempDir()
 			entries := []artifacts.Entry{{Path: tc.path, URL: "http://example.com/artifact"}}
-			err := artifacts.Download(context.Background(), noopClient{}, entries, dir)
+			_, err := artifacts.Download(context.Background(), noopClient{}, entries, dir, artifacts.DownloadOptions{})
 			if tc.wantErr != "" {
 				assert.Check(t, cmp.ErrorContains(err, tc.wantErr))
 			} else {
@@ -96,6 +103,6 @@ func TestDownload_LegitimateNestedPath(t *testing.T) {
 		{Path: "coverage/index.html", URL: "http://example.com/1"},
 		{Path: "results.xml", URL: "http://example.com/2"},
 	}
-	err := artifacts.Download(context.Background(), noopClient{}, entries, dir)
+	_, err := artifacts.Download(context.Background(), noopClient{}, entries, dir, artifacts.DownloadOptions{})
 	assert.NilError(t, err)
 }
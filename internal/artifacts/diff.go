@@ -0,0 +1,271 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+)
+
+// The statuses a Change can have.
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// Change is one artifact that differs between two jobs.
+type Change struct {
+	// Path is where the artifact lands on download, so a parallel job's
+	// artifacts are compared execution by execution.
+	Path   string `json:"path"`
+	Status string `json:"status"`
+	// FromSize and ToSize are the artifact's size in each job, -1 where it is
+	// absent or the store did not say.
+	FromSize int64 `json:"from_size"`
+	ToSize   int64 `json:"to_size"`
+	// Diff is a unified diff of a changed text artifact. Note says why a
+	// changed artifact has none: it is binary, or too large to fetch.
+	Diff string `json:"diff,omitempty"`
+	Note string `json:"note,omitempty"`
+}
+
+// DiffResult is what Diff found: every artifact that differs, ordered by path,
+// and how many are the same in both jobs.
+type DiffResult struct {
+	Changes   []Change `json:"changes"`
+	Unchanged int      `json:"unchanged"`
+}
+
+// DiffOptions configures Diff.
+type DiffOptions struct {
+	// Parallel is how many artifacts are compared at once.
+	Parallel int
+}
+
+// diffPair is one path and its artifact in each job; either may be nil.
+type diffPair struct {
+	path     string
+	from, to *Entry
+}
+
+// Diff compares the artifacts of two jobs. Artifacts are matched by path and
+// compared by their size and ETag; when those cannot settle it, or they differ,
+// the content of both is fetched, so a changed text artifact comes back with a
+// unified diff and one that was only re-uploaded is not reported at all.
+func Diff(ctx context.Context, client Client, from, to []Entry, opts DiffOptions) (DiffResult, error) {
+	pairs := pairEntries(laidOut(from), laidOut(to))
+	changes := make([]*Change, len(pairs))
+	err := bulkhead.Do(ctx, max(opts.Parallel, 1), pairs, func(p diffPair, i int) error {
+		c, err := comparePair(ctx, client, p)
+		if err != nil {
+			return fmt.Errorf("comparing %q: %w", p.path, err)
+		}
+		changes[i] = c
+		return nil
+	})
+	if err != nil {
+		return DiffResult{}, err
+	}
+	res := DiffResult{Changes: []Change{}}
+	for _, c := range changes {
+		if c == nil {
+			res.Unchanged++
+			continue
+		}
+		res.Changes = append(res.Changes, *c)
+	}
+	return res, nil
+}
+
+// pairEntries matches the two jobs' artifacts by path, in path order.
+func pairEntries(from, to []Entry) []diffPair {
+	byPath := map[string]*diffPair{}
+	for _, e := range from {
+		byPath[e.Path] = &diffPair{path: e.Path, from: &e}
+	}
+	for _, e := range to {
+		p, ok := byPath[e.Path]
+		if !ok {
+			p = &diffPair{path: e.Path}
+			byPath[e.Path] = p
+		}
+		p.to = &e
+	}
+	pairs := make([]diffPair, 0, len(byPath))
+	for _, p := range byPath {
+		pairs = append(pairs, *p)
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].path < pairs[j].path })
+	return pairs
+}
+
+// comparePair returns the change p represents, or nil when both jobs have the
+// same artifact.
+func comparePair(ctx context.Context, client Client, p diffPair) (*Change, error) {
+	c := &Change{Path: p.path, FromSize: -1, ToSize: -1}
+	var fromInfo, toInfo apiclient.ArtifactInfo
+	if p.from != nil {
+		fromInfo = stat(ctx, client, *p.from)
+		c.FromSize = fromInfo.Size
+	}
+	if p.to != nil {
+		toInfo = stat(ctx, client, *p.to)
+		c.ToSize = toInfo.Size
+	}
+	switch {
+	case p.from == nil:
+		c.Status = ChangeAdded
+		return c, nil
+	case p.to == nil:
+		c.Status = ChangeRemoved
+		return c, nil
+	}
+
+	c.Status = ChangeChanged
+	// Equal sizes alone prove nothing: a same-size edit is still an edit, so
+	// only matching ETags settle it without fetching.
+	known := fromInfo.Size >= 0 && toInfo.Size >= 0
+	if known && fromInfo.Size == toInfo.Size &&
+		fromInfo.ETag != "" && fromInfo.ETag == toInfo.ETag {
+		return nil, nil
+	}
+	if fromInfo.Size > MaxPreview || toInfo.Size > MaxPreview {
+		c.Note = "too large to diff"
+		return c, nil
+	}
+
+	fromData, fromText, err := Preview(ctx, client, p.from.URL)
+	if err == nil {
+		var toData []byte
+		var toText bool
+		toData, toText, err = Preview(ctx, client, p.to.URL)
+		if err == nil {
+			return contentChange(c, fromData, toData, fromText && toText), nil
+		}
+	}
+	if errors.Is(err, ErrTooLarge) {
+		c.Note = "too large to diff"
+		return c, nil
+	}
+	return nil, err
+}
+
+// contentChange settles a change by content: nil when the bytes are the same
+// after all, else the change with a unified diff for text or a note for binary.
+func contentChange(c *Change, from, to []byte, text bool) *Change {
+	if bytes.Equal(from, to) {
+		return nil
+	}
+	if !text {
+		c.Note = "binary"
+		return c
+	}
+	edits := myers.ComputeEdits(span.URIFromPath("a/"+c.Path), string(from), string(to))
+	c.Diff = fmt.Sprint(gotextdiff.ToUnified("a/"+c.Path, "b/"+c.Path, string(from), edits))
+	return c
+}
+
+// stat asks the store for an artifact's size and ETag. A failure is treated as
+// not knowing them, which only means the content is fetched to compare.
+func stat(ctx context.Context, client Client, e Entry) apiclient.ArtifactInfo {
+	info, err := client.StatArtifact(ctx, e.URL)
+	if err != nil {
+		return apiclient.ArtifactInfo{Size: -1}
+	}
+	return info
+}
+
+// FormatDiffMarkdown returns a markdown summary of a diff: the counts, then the
+// added, removed and changed artifacts with their sizes. The diffs themselves
+// are left to the caller, which prints them verbatim.
+func FormatDiffMarkdown(res DiffResult) string {
+	var md strings.Builder
+	md.WriteString("# Artifact changes\n\n")
+
+	groups := []struct {
+		status, title string
+		line          func(Change) string
+	}{
+		{ChangeAdded, "Added", func(c Change) string { return fmt.Sprintf("`%s` (%s)", c.Path, formatSize(c.ToSize)) }},
+		{ChangeRemoved, "Removed", func(c Change) string { return fmt.Sprintf("`%s` (%s)", c.Path, formatSize(c.FromSize)) }},
+		{ChangeChanged, "Changed", func(c Change) string {
+			line := fmt.Sprintf("`%s`: %s → %s", c.Path, formatSize(c.FromSize), formatSize(c.ToSize))
+			if c.Note != "" {
+				line += " (" + c.Note + ")"
+			}
+			return line
+		}},
+	}
+	var counts []string
+	var body strings.Builder
+	for _, g := range groups {
+		n := 0
+		for _, c := range res.Changes {
+			if c.Status != g.status {
+				continue
+			}
+			if n == 0 {
+				fmt.Fprintf(&body, "\n## %s\n\n", g.title)
+			}
+			fmt.Fprintf(&body, "- %s\n", g.line(c))
+			n++
+		}
+		counts = append(counts, fmt.Sprintf("%d %s", n, g.status))
+	}
+	counts = append(counts, fmt.Sprintf("%d unchanged", res.Unchanged))
+	md.WriteString(strings.Join(counts, ", ") + "\n")
+	md.WriteString(body.String())
+	return md.String()
+}
+
+// formatSize renders an artifact size in binary units, or "size unknown" when
+// the store did not say.
+func formatSize(n int64) string {
+	if n < 0 {
+		return "size unknown"
+	}
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	v := float64(n)
+	units := [...]string{"KiB", "MiB", "GiB", "TiB"}
+	i := -1
+	for v >= unit && i < len(units)-1 {
+		v /= unit
+		i++
+	}
+	return fmt.Sprintf("%.1f %s", v, units[i])
+}
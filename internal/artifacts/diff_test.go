@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package artifacts_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/artifacts"
+)
+
+// unstattedClient is a store whose size probes fail, so nothing is known
+// about an artifact without fetching it.
+type unstattedClient struct{ *storeClient }
+
+func (unstattedClient) StatArtifact(context.Context, string) (apiclient.ArtifactInfo, error) {
+	return apiclient.ArtifactInfo{}, errors.New("403 Forbidden")
+}
+
+// untaggedClient is a store that reports sizes but no ETags.
+type untaggedClient struct{ *storeClient }
+
+func (c untaggedClient) StatArtifact(ctx context.Context, url string) (apiclient.ArtifactInfo, error) {
+	info, err := c.storeClient.StatArtifact(ctx, url)
+	info.ETag = ""
+	return info, err
+}
+
+func changePaths(changes []artifacts.Change) map[string]string {
+	out := map[string]string{}
+	for _, c := range changes {
+		out[c.Path] = c.Status
+	}
+	return out
+}
+
+func TestDiff(t *testing.T) {
+	store := &storeClient{files: map[string]string{
+		"https://a/same.txt":  "unchanged\n",
+		"https://b/same.txt":  "unchanged\n",
+		"https://a/lock.txt":  "left-pad 1.0.0\nreact 18.2.0\n",
+		"https://b/lock.txt":  "left-pad 1.0.0\nreact 18.3.1\n",
+		"https://a/app.bin":   "\x7fELF\x00one",
+		"https://b/app.bin":   "\x7fELF\x00two",
+		"https://a/gone.txt":  "removed\n",
+		"https://b/added.txt": "new\n",
+	}}
+	from := []artifacts.Entry{
+		{Path: "same.txt", URL: "https://a/same.txt"},
+		{Path: "lock.txt", URL: "https://a/lock.txt"},
+		{Path: "app.bin", URL: "https://a/app.bin"},
+		{Path: "gone.txt", URL: "https://a/gone.txt"},
+	}
+	to := []artifacts.Entry{
+		{Path: "same.txt", URL: "https://b/same.txt"},
+		{Path: "lock.txt", URL: "https://b/lock.txt"},
+		{Path: "app.bin", URL: "https://b/app.bin"},
+		{Path: "added.txt", URL: "https://b/added.txt"},
+	}
+
+	res, err := artifacts.Diff(context.Background(), store, from, to, artifacts.DiffOptions{Parallel: 2})
+	assert.NilError(t, err)
+
+	t.Run("changes are listed by path, with the identical artifact counted", func(t *testing.T) {
+		assert.Check(t, cmp.DeepEqual(changePaths(res.Changes), map[string]string{
+			"added.txt": artifacts.ChangeAdded,
+			"app.bin":   artifacts.ChangeChanged,
+			"gone.txt":  artifacts.ChangeRemoved,
+			"lock.txt":  artifacts.ChangeChanged,
+		}))
+		assert.Check(t, cmp.Equal(res.Changes[0].Path, "added.txt"))
+		assert.Check(t, cmp.Equal(res.Unchanged, 1))
+	})
+
+	t.Run("sizes come from each job, -1 where the artifact is absent", func(t *testing.T) {
+		added := res.Changes[0]
+		assert.Check(t, cmp.Equal(added.FromSize, int64(-1)))
+		assert.Check(t, cmp.Equal(added.ToSize, int64(4)))
+	})
+
+	t.Run("a text artifact carries a unified diff", func(t *testing.T) {
+		lock := res.Changes[3]
+		assert.Check(t, cmp.Contains(lock.Diff, "--- a/lock.txt\n+++ b/lock.txt\n"))
+		assert.Check(t, cmp.Contains(lock.Diff, "-react 18.2.0\n+react 18.3.1\n"))
+	})
+
+	t.Run("a binary artifact is noted rather than diffed", func(t *testing.T) {
+		bin := res.Changes[1]
+		assert.Check(t, cmp.Equal(bin.Diff, ""))
+		assert.Check(t, cmp.Equal(bin.Note, "binary"))
+	})
+}
+
+func TestDiff_UnknownMetadataComparesContent(t *testing.T) {
+	store := unstattedClient{&storeClient{files: map[string]string{
+		"https://a/report.xml": "<ok/>",
+		"https://b/report.xml": "<ok/>",
+	}}}
+	res, err := artifacts.Diff(context.Background(), store,
+		[]artifacts.Entry{{Path: "report.xml", URL: "https://a/report.xml"}},
+		[]artifacts.Entry{{Path: "report.xml", URL: "https://b/report.xml"}},
+		artifacts.DiffOptions{})
+	assert.NilError(t, err)
+	assert.Check(t, cmp.Len(res.Changes, 0))
+	assert.Check(t, cmp.Equal(res.Unchanged, 1))
+}
+
+func TestDiff_SameSizeWithoutETagComparesContent(t *testing.T) {
+	store := untaggedClient{&storeClient{files: map[string]string{
+		"https://a/coverage.txt": "lines: 81.2%\n",
+		"https://b/coverage.txt": "lines: 79.8%\n",
+	}}}
+	res, err := artifacts.Diff(context.Background(), store,
+		[]artifacts.Entry{{Path: "coverage.txt", URL: "https://a/coverage.txt"}},
+		[]artifacts.Entry{{Path: "coverage.txt", URL: "https://b/coverage.txt"}},
+		artifacts.DiffOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(res.Changes, 1))
+	assert.Check(t, cmp.Equal(res.Changes[0].Status, artifacts.ChangeChanged))
+	assert.Check(t, cmp.Contains(res.Changes[0].Diff, "-lines: 81.2%\n+lines: 79.8%\n"))
+	assert.Check(t, cmp.Equal(res.Unchanged, 0))
+}
+
+func TestDiff_ParallelJobsMatchByExecution(t *testing.T) {
+	store := &storeClient{files: map[string]string{
+		"https://a/0": "shard 0\n",
+		"https://a/1": "shard 1\n",
+		"https://b/0": "shard 0\n",
+	}}
+	res, err := artifacts.Diff(context.Background(), store,
+		[]artifacts.Entry{
+			{Path: "out.txt", URL: "https://a/0", Execution: 0},
+			{Path: "out.txt", URL: "https://a/1", Execution: 1},
+		},
+		[]artifacts.Entry{{Path: "out.txt", URL: "https://b/0", Execution: 0}},
+		artifacts.DiffOptions{})
+	assert.NilError(t, err)
+	assert.Check(t, cmp.DeepEqual(changePaths(res.Changes), map[string]string{
+		"exec-0000/out.txt": artifacts.ChangeRemoved,
+		"exec-0001/out.txt": artifacts.ChangeRemoved,
+		"out.txt":           artifacts.ChangeAdded,
+	}))
+}
+
+func TestFormatDiffMarkdown(t *testing.T) {
+	md := artifacts.FormatDiffMarkdown(artifacts.DiffResult{
+		Changes: []artifacts.Change{
+			{Path: "new.xml", Status: artifacts.ChangeAdded, FromSize: -1, ToSize: 2048},
+			{Path: "bundle.js", Status: artifacts.ChangeChanged, FromSize: 900, ToSize: 1536, Note: "binary"},
+		},
+		Unchanged: 3,
+	})
+	assert.Check(t, cmp.Equal(md, "# Artifact changes\n\n"+
+		"1 added, 0 removed, 1 changed, 3 unchanged\n"+
+		"\n## Added\n\n- `new.xml` (2.0 KiB)\n"+
+		"\n## Changed\n\n- `bundle.js`: 900 B → 1.5 KiB (binary)\n"))
+}
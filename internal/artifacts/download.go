@@ -0,0 +1,307 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package artifacts
+
+import (
+	"context"
+	"crypto/md5" //#nosec:G501 // MD5 is what the artifact store's ETags are; it checks a copy, it doesn't secure one
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+)
+
+// DefaultParallel is how many artifacts download at once unless the caller
+// says otherwise.
+const DefaultParallel = 4
+
+// DownloadOptions tune Download and DownloadPaths.
+type DownloadOptions struct {
+	// Parallel is how many artifacts download at once. Below 1 means one.
+	Parallel int
+}
+
+// DownloadResult counts what a download did with each entry.
+type DownloadResult struct {
+	// Downloaded artifacts were fetched in full.
+	Downloaded int `json:"downloaded"`
+	// Resumed artifacts were finished from a partial download an earlier,
+	// interrupted attempt left behind.
+	Resumed int `json:"resumed"`
+	// Skipped artifacts were already present and identical.
+	Skipped int `json:"skipped"`
+	// Unchecked artifacts are the downloaded ones whose store would not say
+	// their size, so they could neither be skipped nor resumed.
+	Unchecked int `json:"unchecked"`
+}
+
+// partSuffix marks an artifact still being downloaded. Its ETag, if the store
+// sent one, is kept beside it in a file with etagSuffix added, so a later
+// attempt only resumes onto bytes of the same version.
+const (
+	partSuffix = ".part"
+	etagSuffix = ".etag"
+)
+
+// Download fetches each entry's artifact and writes it under dir, preserving
+// the artifact's Path as the relative file path. When entries span multiple
+// execution indices, each execution's artifacts are placed under a
+// subdirectory named by the index (e.g. dir/exec-0000/path, dir/exec-0003/path).
+func Download(ctx context.Context, client Client, entries []Entry, dir string, opts DownloadOptions) (DownloadResult, error) {
+	return DownloadPaths(ctx, client, laidOut(entries), dir, opts)
+}
+
+// laidOut returns entries with each Path where Download writes it: unchanged
+// for a single execution, or under its execution's directory when there are
+// several.
+func laidOut(entries []Entry) []Entry {
+	if !hasMultipleExecutions(entries) {
+		return entries
+	}
+	laid := make([]Entry, len(entries))
+	for i, e := range entries {
+		laid[i] = e
+		laid[i].Path = ExecDir(e.Execution) + "/" + strings.TrimPrefix(e.Path, "/")
+	}
+	return laid
+}
+
+// DownloadPaths fetches each entry's artifact and writes it at dir/<Path>,
+// applying no layout of its own — the caller has already put whatever structure
+// it wants in each Path. Use it when the destination layout is decided elsewhere
+// (the run-get artifact browser downloads exactly the paths it displayed, for any
+// subset of a job's artifacts); use Download to have the per-execution grouping
+// derived from the entries themselves.
+//
+// A file already at its destination with the artifact's size (and, where the
+// ETag tells, its content) is left alone. A download that fails part way keeps
+// what it got, and the next attempt carries on from there.
+func DownloadPaths(ctx context.Context, client Client, entries []Entry, dir string, opts DownloadOptions) (DownloadResult, error) {
+	// cleanDir is the canonical form of the download root with a trailing
+	// separator.  We recompute it once outside the loop rather than on every
+	// iteration.
+	cleanDir := filepath.Clean(dir) + string(os.PathSeparator)
+
+	dests := make([]string, len(entries))
+	for i, e := range entries {
+		dest := filepath.Join(dir, filepath.FromSlash(e.Path))
+
+		// Path-traversal guard: artifact paths come from the CircleCI API
+		// response and are not under our control.  filepath.Join resolves
+		// all ".." components (via filepath.Clean), so a server-supplied
+		// path such as "../../.ssh/authorized_keys" would silently escape
+		// dir and produce an arbitrary write destination.  We therefore
+		// require that the resolved destination still sits inside dir.
+		// Every path is checked before anything is written.
+		if !strings.HasPrefix(dest, cleanDir) {
+			return DownloadResult{}, fmt.Errorf("artifact path %q escapes download directory", e.Path)
+		}
+		dests[i] = dest
+	}
+
+	var (
+		mu     sync.Mutex
+		result DownloadResult
+	)
+	err := bulkhead.Do(ctx, max(opts.Parallel, 1), entries, func(e Entry, i int) error {
+		outcome, err := downloadOne(ctx, client, e, dests[i])
+		if err != nil {
+			return fmt.Errorf("downloading %q: %w", e.Path, err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch outcome {
+		case outcomeSkipped:
+			result.Skipped++
+		case outcomeResumed:
+			result.Resumed++
+		case outcomeUnchecked:
+			result.Downloaded++
+			result.Unchecked++
+		default:
+			result.Downloaded++
+		}
+		return nil
+	})
+	return result, err
+}
+
+type downloadOutcome int
+
+const (
+	outcomeDownloaded downloadOutcome = iota
+	outcomeResumed
+	outcomeSkipped
+	// outcomeUnchecked is a download in full of an artifact whose size the
+	// store would not say.
+	outcomeUnchecked
+)
+
+// downloadOne brings dest up to date with one artifact. The store is asked
+// for the artifact's size and ETag first; a store that won't say still gets
+// the artifact downloaded, just without the skip or the resume.
+func downloadOne(ctx context.Context, client Client, e Entry, dest string) (downloadOutcome, error) {
+	info, err := client.StatArtifact(ctx, e.URL)
+	if err != nil {
+		info = apiclient.ArtifactInfo{Size: -1}
+	}
+
+	if info.Size >= 0 {
+		same, err := alreadyPresent(dest, info)
+		if err != nil {
+			return 0, err
+		}
+		if same {
+			return outcomeSkipped, nil
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil { //#nosec:G301 // 0o755 is appropriate for artifact download directories
+		return 0, fmt.Errorf("creating directory: %w", err)
+	}
+
+	part, etagPath := dest+partSuffix, dest+partSuffix+etagSuffix
+	offset := resumeOffset(part, etagPath, info)
+	flags := os.O_WRONLY | os.O_CREATE
+	if offset == 0 {
+		flags |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(part, flags, 0o644) //#nosec:G302,G304 // part sits beside dest, validated by the caller against the clean download dir
+	if err != nil {
+		return 0, fmt.Errorf("creating file %q: %w", part, err)
+	}
+	if offset == 0 {
+		// Record the version being fetched before fetching it, so whatever
+		// arrives before an interruption can be trusted to resume onto.
+		if err := os.WriteFile(etagPath, []byte(info.ETag), 0o600); err != nil {
+			_ = f.Close()
+			return 0, fmt.Errorf("creating file %q: %w", etagPath, err)
+		}
+	}
+	resumed := false
+	dlErr := client.DownloadArtifactFrom(ctx, e.URL, offset, info.ETag, func(r bool) (io.Writer, error) {
+		resumed = r
+		start := offset
+		if !r {
+			start = 0
+		}
+		if err := f.Truncate(start); err != nil {
+			return nil, err
+		}
+		_, err := f.Seek(start, io.SeekStart)
+		return f, err
+	})
+	closeErr := f.Close()
+	if dlErr != nil {
+		return 0, fmt.Errorf("%w (run the download again to resume)", dlErr)
+	}
+	if closeErr != nil {
+		return 0, fmt.Errorf("writing %q: %w", part, closeErr)
+	}
+
+	if info.Size >= 0 {
+		st, err := os.Stat(part)
+		if err != nil {
+			return 0, err
+		}
+		if st.Size() != info.Size {
+			// Start over next time: these bytes can't be trusted to resume onto.
+			_ = os.Remove(part)
+			_ = os.Remove(etagPath)
+			return 0, fmt.Errorf("got %d bytes, expected %d", st.Size(), info.Size)
+		}
+	}
+	if err := os.Rename(part, dest); err != nil {
+		return 0, err
+	}
+	_ = os.Remove(etagPath)
+	switch {
+	case resumed:
+		return outcomeResumed, nil
+	case info.Size < 0:
+		return outcomeUnchecked, nil
+	}
+	return outcomeDownloaded, nil
+}
+
+// resumeOffset is how many bytes of a partial download can be kept: all of
+// them when it is shorter than the artifact and was fetched from the version
+// the store now has, otherwise none. Without an ETag there is no telling
+// whether the artifact changed in between, so nothing is kept.
+func resumeOffset(part, etagPath string, info apiclient.ArtifactInfo) int64 {
+	if info.ETag == "" || info.Size < 0 {
+		return 0
+	}
+	st, err := os.Stat(part)
+	if err != nil || st.Size() >= info.Size {
+		return 0
+	}
+	etag, err := os.ReadFile(etagPath) //#nosec:G304 // etagPath sits beside dest, validated by the caller against the clean download dir
+	if err != nil || string(etag) != info.ETag {
+		return 0
+	}
+	return st.Size()
+}
+
+// md5ETag matches an ETag that is the content's MD5, as an artifact store
+// gives for anything uploaded in one part. Multipart ETags carry a "-N" suffix
+// and say nothing checkable about the content.
+var md5ETag = regexp.MustCompile(`^"([0-9a-f]{32})"$`)
+
+// alreadyPresent reports whether dest already holds the artifact: a regular
+// file of the same size whose MD5 matches the ETag. Without an MD5 ETag a
+// same-size file could still be stale, so it is fetched again.
+func alreadyPresent(dest string, info apiclient.ArtifactInfo) (bool, error) {
+	st, err := os.Stat(dest)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return false, nil
+	case err != nil:
+		return false, err
+	case !st.Mode().IsRegular() || st.Size() != info.Size:
+		return false, nil
+	}
+
+	m := md5ETag.FindStringSubmatch(strings.ToLower(info.ETag))
+	if m == nil {
+		return false, nil
+	}
+	f, err := os.Open(dest) //#nosec:G304 // dest is validated by the caller against the clean download dir
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = f.Close() }()
+	h := md5.New() //#nosec:G401 // see the import
+	if _, err := io.Copy(h, f); err != nil {
+		return false, err
+	}
+	return hex.EncodeToString(h.Sum(nil)) == m[1], nil
+}
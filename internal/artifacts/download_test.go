@@ -0,0 +1,281 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package artifacts_test
+
+import (
+	"context"
+	"crypto/md5" //#nosec:G501 // the store's ETags are MD5s
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/artifacts"
+)
+
+// storeClient serves artifacts the way an artifact store does: a probe gives
+// the size and an MD5 ETag, and a ranged GET is honoured when its If-Range
+// still matches.
+type storeClient struct {
+	noopClient
+	files map[string]string // URL → content
+
+	mu      sync.Mutex
+	offsets []int64 // offset of each GET, in order
+	failAt  int     // when positive, a GET fails after this many bytes
+}
+
+func md5ETag(content string) string {
+	sum := md5.Sum([]byte(content)) //#nosec:G401 // see the import
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func (c *storeClient) StatArtifact(_ context.Context, url string) (apiclient.ArtifactInfo, error) {
+	content := c.files[url]
+	return apiclient.ArtifactInfo{Size: int64(len(content)), ETag: md5ETag(content)}, nil
+}
+
+func (c *storeClient) DownloadArtifactFrom(_ context.Context, url string, offset int64, etag string, open func(bool) (io.Writer, error)) error {
+	c.mu.Lock()
+	c.offsets = append(c.offsets, offset)
+	c.mu.Unlock()
+
+	content := c.files[url]
+	resumed := offset > 0 && etag == md5ETag(content)
+	if resumed {
+		content = content[offset:]
+	}
+	w, err := open(resumed)
+	if err != nil {
+		return err
+	}
+	if c.failAt > 0 {
+		_, _ = io.WriteString(w, content[:c.failAt])
+		return errors.New("connection reset")
+	}
+	_, err = io.WriteString(w, content)
+	return err
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	assert.NilError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path) //#nosec:G304 // test temp dir
+	assert.NilError(t, err)
+	return string(b)
+}
+
+func TestDownload_SkipAndResume(t *testing.T) {
+	const report = "<testsuite>all passed</testsuite>"
+	newStore := func() *storeClient {
+		return &storeClient{files: map[string]string{"https://store/report.xml": report}}
+	}
+	entries := []artifacts.Entry{{Path: "report.xml", URL: "https://store/report.xml"}}
+	download := func(t *testing.T, c *storeClient, dir string) (artifacts.DownloadResult, error) {
+		t.Helper()
+		return artifacts.Download(context.Background(), c, entries, dir, artifacts.DownloadOptions{})
+	}
+
+	t.Run("identical file is skipped", func(t *testing.T) {
+		dir, c := t.TempDir(), newStore()
+		writeFile(t, filepath.Join(dir, "report.xml"), report)
+
+		res, err := download(t, c, dir)
+		assert.NilError(t, err)
+		assert.Check(t, cmp.DeepEqual(res, artifacts.DownloadResult{Skipped: 1}))
+		assert.Check(t, cmp.Len(c.offsets, 0))
+	})
+
+	t.Run("same size but different content is fetched again", func(t *testing.T) {
+		dir, c := t.TempDir(), newStore()
+		stale := "<testsuite>one test failed</testsuite>"[:len(report)]
+		writeFile(t, filepath.Join(dir, "report.xml"), stale)
+
+		res, err := download(t, c, dir)
+		assert.NilError(t, err)
+		assert.Check(t, cmp.DeepEqual(res, artifacts.DownloadResult{Downloaded: 1}))
+		assert.Check(t, cmp.Equal(readFile(t, filepath.Join(dir, "report.xml")), report))
+	})
+
+	t.Run("same size without an MD5 ETag is fetched again", func(t *testing.T) {
+		dir, c := t.TempDir(), newStore()
+		stale := "<testsuite>one test failed</testsuite>"[:len(report)]
+		writeFile(t, filepath.Join(dir, "report.xml"), stale)
+
+		res, err := artifacts.Download(context.Background(), untaggedClient{c}, entries, dir, artifacts.DownloadOptions{})
+		assert.NilError(t, err)
+		assert.Check(t, cmp.DeepEqual(res, artifacts.DownloadResult{Downloaded: 1}))
+		assert.Check(t, cmp.DeepEqual(c.offsets, []int64{0}))
+		assert.Check(t, cmp.Equal(readFile(t, filepath.Join(dir, "report.xml")), report))
+	})
+
+	t.Run("interrupted download resumes where it stopped", func(t *testing.T) {
+		dir, c := t.TempDir(), newStore()
+		c.failAt = 10
+		_, err := download(t, c, dir)
+		assert.Check(t, cmp.ErrorContains(err, "run the download again to resume"))
+		_, err = os.Stat(filepath.Join(dir, "report.xml"))
+		assert.Check(t, errors.Is(err, os.ErrNotExist), "no partial file at the destination")
+
+		c.failAt = 0
+		res, err := download(t, c, dir)
+		assert.NilError(t, err)
+		assert.Check(t, cmp.DeepEqual(res, artifacts.DownloadResult{Resumed: 1}))
+		assert.Check(t, cmp.DeepEqual(c.offsets, []int64{0, 10}))
+		assert.Check(t, cmp.Equal(readFile(t, filepath.Join(dir, "report.xml")), report))
+		entries, err := os.ReadDir(dir)
+		assert.NilError(t, err)
+		assert.Check(t, cmp.Len(entries, 1), "partial-download files are cleaned up")
+	})
+
+	t.Run("partial download of another version starts over", func(t *testing.T) {
+		dir, c := t.TempDir(), newStore()
+		writeFile(t, filepath.Join(dir, "report.xml.part"), "<testsuite>one")
+		writeFile(t, filepath.Join(dir, "report.xml.part.etag"), md5ETag("older"))
+
+		res, err := download(t, c, dir)
+		assert.NilError(t, err)
+		assert.Check(t, cmp.DeepEqual(res, artifacts.DownloadResult{Downloaded: 1}))
+		assert.Check(t, cmp.DeepEqual(c.offsets, []int64{0}))
+		assert.Check(t, cmp.Equal(readFile(t, filepath.Join(dir, "report.xml")), report))
+	})
+
+	t.Run("failed probe downloads in full and says so", func(t *testing.T) {
+		dir, c := t.TempDir(), newStore()
+		writeFile(t, filepath.Join(dir, "report.xml"), report)
+
+		res, err := artifacts.Download(context.Background(), unstattedClient{c}, entries, dir, artifacts.DownloadOptions{})
+		assert.NilError(t, err)
+		assert.Check(t, cmp.DeepEqual(res, artifacts.DownloadResult{Downloaded: 1, Unchecked: 1}))
+		assert.Check(t, cmp.DeepEqual(c.offsets, []int64{0}))
+		assert.Check(t, cmp.Equal(readFile(t, filepath.Join(dir, "report.xml")), report))
+	})
+}
+
+func TestDownload_Parallel(t *testing.T) {
+	c := &storeClient{files: map[string]string{}}
+	var entries []artifacts.Entry
+	for _, name := range []string{"a.txt", "b/c.txt", "b/d.txt", "e.txt", "f.txt"} {
+		url := "https://store/" + name
+		c.files[url] = "contents of " + name
+		entries = append(entries, artifacts.Entry{Path: name, URL: url})
+	}
+	dir := t.TempDir()
+
+	res, err := artifacts.Download(context.Background(), c, entries, dir, artifacts.DownloadOptions{Parallel: 3})
+	assert.NilError(t, err)
+	assert.Check(t, cmp.DeepEqual(res, artifacts.DownloadResult{Downloaded: 5}))
+	for _, e := range entries {
+		assert.Check(t, cmp.Equal(readFile(t, filepath.Join(dir, e.Path)), "contents of "+e.Path))
+	}
+}
+
+func TestFilter(t *testing.T) {
+	entries := []artifacts.Entry{
+		{Path: "test-results/unit.xml"},
+		{Path: "test-results/integration/api.xml"},
+		{Path: "coverage/index.html"},
+		{Path: "coverage/lcov.info"},
+		{Path: "build.log"},
+	}
+	paths := func(es []artifacts.Entry) []string {
+		out := []string{}
+		for _, e := range es {
+			out = append(out, e.Path)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "no patterns keeps everything",
+			want: paths(entries),
+		},
+		{
+			name:    "bare name matches at any depth",
+			include: []string{"*.xml"},
+			want:    []string{"test-results/unit.xml", "test-results/integration/api.xml"},
+		},
+		{
+			name:    "single star stays in one directory",
+			include: []string{"test-results/*.xml"},
+			want:    []string{"test-results/unit.xml"},
+		},
+		{
+			name:    "double star crosses directories",
+			include: []string{"test-results/**.xml"},
+			want:    []string{"test-results/unit.xml", "test-results/integration/api.xml"},
+		},
+		{
+			name:    "patterns are alternatives",
+			include: []string{"coverage/*", "*.log"},
+			want:    []string{"coverage/index.html", "coverage/lcov.info", "build.log"},
+		},
+		{
+			name:    "exclude wins over include",
+			include: []string{"coverage/*"},
+			exclude: []string{"*.info"},
+			want:    []string{"coverage/index.html"},
+		},
+		{
+			name:    "exclude alone drops matches",
+			exclude: []string{"{coverage,test-results}/**"},
+			want:    []string{"build.log"},
+		},
+		{
+			name:    "malformed pattern",
+			include: []string{"[unclosed"},
+			wantErr: `invalid pattern "[unclosed"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := artifacts.Filter(entries, tc.include, tc.exclude)
+			if tc.wantErr != "" {
+				assert.Check(t, cmp.ErrorContains(err, tc.wantErr))
+				return
+			}
+			assert.NilError(t, err)
+			assert.Check(t, cmp.DeepEqual(paths(got), tc.want))
+		})
+	}
+}
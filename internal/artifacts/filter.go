@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package artifacts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gobwas/glob"
+)
+
+// Filter keeps the entries whose path matches at least one include pattern
+// (every entry, when there are none) and no exclude pattern. Patterns are
+// globs over the artifact path: "*" and "?" stay within one directory, "**"
+// crosses any number, and "{a,b}" matches either. A pattern without a "/"
+// matches the file name wherever it sits, so "*.xml" finds test results at
+// any depth.
+func Filter(entries []Entry, include, exclude []string) ([]Entry, error) {
+	inc, err := compileGlobs(include)
+	if err != nil {
+		return nil, err
+	}
+	exc, err := compileGlobs(exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Entry, 0, len(entries))
+	for _, e := range entries {
+		p := strings.TrimPrefix(e.Path, "/")
+		if (len(inc) == 0 || matchesAny(inc, p)) && !matchesAny(exc, p) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+type pathGlob struct {
+	g        glob.Glob
+	baseOnly bool
+}
+
+func compileGlobs(patterns []string) ([]pathGlob, error) {
+	globs := make([]pathGlob, len(patterns))
+	for i, p := range patterns {
+		p = strings.TrimPrefix(p, "/")
+		g, err := glob.Compile(p, '/')
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+		globs[i] = pathGlob{g: g, baseOnly: !strings.Contains(p, "/")}
+	}
+	return globs, nil
+}
+
+func matchesAny(globs []pathGlob, p string) bool {
+	base := p[strings.LastIndex(p, "/")+1:]
+	for _, g := range globs {
+		if g.baseOnly && g.g.Match(base) || g.g.Match(p) {
+			return true
+		}
+	}
+	return false
+}
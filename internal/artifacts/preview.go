@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// MaxPreview caps how much of an artifact is pulled in to be viewed. An artifact
+// can be a multi-gigabyte build output, and the whole thing is held in memory —
+// re-wrapped on every resize for text, decoded and scaled for an image — so a
+// browse action must not be able to pull one down in full. 8 MiB clears any
+// screenshot while keeping a stray log file from filling memory.
+const MaxPreview = 8 << 20 // 8 MiB
+
+// ErrTooLarge is returned by Preview for an artifact over MaxPreview. It travels
+// back through the transport's io.Copy, so the transfer stops rather than
+// buffering a file no one is going to look at.
+var ErrTooLarge = errors.New("artifact is larger than the preview limit")
+
+// Preview reads one artifact for viewing, up to MaxPreview bytes, reporting
+// whether the bytes are displayable text. Images are left for the caller to
+// recognise, so this only has to say whether the bytes are safe to show
+// verbatim.
+func Preview(ctx context.Context, client Client, url string) ([]byte, bool, error) {
+	w := &cappedBuffer{limit: MaxPreview}
+	err := client.DownloadArtifactFrom(ctx, url, 0, "", func(bool) (io.Writer, error) { return w, nil })
+	if err != nil {
+		return nil, false, err
+	}
+	data := w.buf.Bytes()
+	return data, displayableText(data), nil
+}
+
+// cappedBuffer accumulates up to limit bytes and then fails the write.
+type cappedBuffer struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (w *cappedBuffer) Write(p []byte) (int, error) {
+	if w.buf.Len()+len(p) > w.limit {
+		return 0, ErrTooLarge
+	}
+	return w.buf.Write(p)
+}
+
+// displayableText reports whether data can be shown as text: valid UTF-8 with no
+// NUL byte. Empty content counts as text, so an empty artifact opens to an empty
+// page rather than looking like a binary.
+func displayableText(data []byte) bool {
+	return utf8.Valid(data) && !bytes.ContainsRune(data, 0)
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package chartimg draws line charts as SVG and PNG images, for when a chart
+// has to leave the terminal — attached to an incident ticket, or embedded in a
+// pull request.
+//
+// A Figure is laid out once, as a list of drawing operations in image
+// coordinates, and each format renders that same list; an SVG and a PNG of one
+// figure differ only in how they are encoded.
+package chartimg
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+)
+
+// Series is one line on a panel: Values[i] is plotted at x = i*Step.
+type Series struct {
+	Name   string
+	Values []float64
+	Step   float64
+}
+
+// Panel is one chart in a figure, with its own axes and legend.
+type Panel struct {
+	Title  string
+	Series []Series
+
+	// Ceiling is the top of the y axis; data above it raises the axis to fit.
+	// When CeilingLabel is set the ceiling is also ruled across the plot, so a
+	// limit the data is measured against is visible even when nothing reaches
+	// it.
+	Ceiling      float64
+	CeilingLabel string
+
+	// XMax is the end of the x axis. Zero fits the longest series.
+	XMax float64
+
+	// FormatX and FormatY label the axis ticks. Either may be nil, for plain
+	// numbers.
+	FormatX func(float64) string
+	FormatY func(float64) string
+}
+
+// Figure is a titled stack of panels, rendered as one image.
+type Figure struct {
+	Title    string
+	Subtitle string
+	Panels   []Panel
+}
+
+const (
+	figureWidth  = 960
+	figureMargin = 24
+	plotLeft     = 96
+	plotHeight   = 220
+	lineWidth    = 2
+	// xTicks and yTicks are how many gaps the axes are divided into.
+	xTicks = 4
+	yTicks = 4
+)
+
+var (
+	colorBackground = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	colorText       = color.RGBA{0x1f, 0x23, 0x28, 0xff}
+	colorMuted      = color.RGBA{0x65, 0x6d, 0x76, 0xff}
+	colorGrid       = color.RGBA{0xe6, 0xe8, 0xeb, 0xff}
+	colorAxis       = color.RGBA{0x8c, 0x95, 0x9f, 0xff}
+)
+
+// seriesColors follows theme.SeriesStyles — blue, green, pink, gold, red — so
+// an execution is the same color exported as it is in the terminal.
+var seriesColors = []color.RGBA{
+	{0x00, 0xaf, 0xff, 0xff},
+	{0x00, 0xd7, 0x87, 0xff},
+	{0xff, 0x5f, 0xaf, 0xff},
+	{0xd7, 0xaf, 0x5f, 0xff},
+	{0xff, 0x00, 0x00, 0xff},
+}
+
+func seriesColor(i int) color.RGBA {
+	return seriesColors[i%len(seriesColors)]
+}
+
+// --- display list ---
+
+type point struct{ x, y float64 }
+
+type anchor int
+
+const (
+	anchorStart anchor = iota
+	anchorMiddle
+	anchorEnd
+)
+
+type rectOp struct {
+	x, y, w, h float64
+	fill       color.RGBA
+}
+
+type lineOp struct {
+	pts    []point
+	stroke color.RGBA
+	width  float64
+	dashed bool
+}
+
+type textOp struct {
+	x, y   float64 // y is the baseline
+	s      string
+	size   float64
+	fill   color.RGBA
+	anchor anchor
+	bold   bool
+}
+
+// canvas is a laid-out figure: its size and what to draw, in order.
+type canvas struct {
+	width, height float64
+	ops           []any
+}
+
+func (c *canvas) rect(x, y, w, h float64, fill color.RGBA) {
+	c.ops = append(c.ops, rectOp{x, y, w, h, fill})
+}
+
+func (c *canvas) line(stroke color.RGBA, width float64, dashed bool, pts ...point) {
+	c.ops = append(c.ops, lineOp{pts, stroke, width, dashed})
+}
+
+func (c *canvas) text(x, y float64, s string, size float64, fill color.RGBA, a anchor, bold bool) {
+	c.ops = append(c.ops, textOp{x, y, s, size, fill, a, bold})
+}
+
+// --- layout ---
+
+// layout places every element of f.
+func (f Figure) layout() *canvas {
+	c := &canvas{width: figureWidth}
+	y := float64(figureMargin)
+	if f.Title != "" {
+		c.text(figureMargin, y+18, f.Title, 20, colorText, anchorStart, true)
+		y += 28
+	}
+	if f.Subtitle != "" {
+		c.text(figureMargin, y+14, f.Subtitle, 13, colorMuted, anchorStart, false)
+		y += 22
+	}
+	for _, p := range f.Panels {
+		y = p.layout(c, y+12)
+	}
+	c.height = math.Ceil(y + figureMargin)
+
+	// The background goes under everything, so it is drawn first.
+	c.ops = append([]any{rectOp{0, 0, c.width, c.height, colorBackground}}, c.ops...)
+	return c
+}
+
+// layout draws p with its top at y, and returns where it ends.
+func (p Panel) layout(c *canvas, y float64) float64 {
+	formatX, formatY := p.FormatX, p.FormatY
+	if formatX == nil {
+		formatX = formatNumber
+	}
+	if formatY == nil {
+		formatY = formatNumber
+	}
+
+	c.text(figureMargin, y+16, p.Title, 15, colorText, anchorStart, true)
+	top := y + 32
+	left, right := float64(plotLeft), float64(figureWidth-figureMargin)
+	bottom := top + plotHeight
+
+	yMax := p.Ceiling
+	xMax := p.XMax
+	for _, s := range p.Series {
+		for _, v := range s.Values {
+			yMax = max(yMax, v)
+		}
+		if p.XMax == 0 && len(s.Values) > 1 {
+			xMax = max(xMax, float64(len(s.Values)-1)*s.Step)
+		}
+	}
+	if yMax <= 0 {
+		yMax = 1
+	}
+	if xMax <= 0 {
+		xMax = 1
+	}
+	px := func(x float64) float64 { return left + x/xMax*(right-left) }
+	py := func(v float64) float64 { return bottom - v/yMax*plotHeight }
+
+	for i := 0; i <= yTicks; i++ {
+		v := yMax * float64(i) / yTicks
+		c.line(colorGrid, 1, false, point{left, py(v)}, point{right, py(v)})
+		c.text(left-8, py(v)+4, formatY(v), 12, colorMuted, anchorEnd, false)
+	}
+	for i := 0; i <= xTicks; i++ {
+		x := xMax * float64(i) / xTicks
+		c.line(colorAxis, 1, false, point{px(x), bottom}, point{px(x), bottom + 4})
+		a := anchorMiddle
+		switch i {
+		case 0:
+			a = anchorStart
+		case xTicks:
+			a = anchorEnd
+		}
+		c.text(px(x), bottom+18, formatX(x), 12, colorMuted, a, false)
+	}
+	c.line(colorAxis, 1, false, point{left, top}, point{left, bottom}, point{right, bottom})
+
+	if p.CeilingLabel != "" && p.Ceiling > 0 {
+		c.line(colorMuted, 1, true, point{left, py(p.Ceiling)}, point{right, py(p.Ceiling)})
+		c.text(right, py(p.Ceiling)-5, p.CeilingLabel, 12, colorMuted, anchorEnd, false)
+	}
+
+	for i, s := range p.Series {
+		pts := make([]point, 0, len(s.Values))
+		for j, v := range s.Values {
+			pts = append(pts, point{px(float64(j) * s.Step), py(v)})
+		}
+		if len(pts) == 1 {
+			// A lone sample has no line to draw, so it gets a short one.
+			pts = append(pts, point{pts[0].x + 4, pts[0].y})
+		}
+		if len(pts) > 0 {
+			c.line(seriesColor(i), lineWidth, false, pts...)
+		}
+	}
+
+	return p.layoutLegend(c, bottom+40)
+}
+
+// layoutLegend draws a swatch and name for each series, wrapping onto a new
+// row when one would run past the plot, and returns where the legend ends.
+func (p Panel) layoutLegend(c *canvas, y float64) float64 {
+	if len(p.Series) == 0 {
+		return y
+	}
+	x := float64(plotLeft)
+	for i, s := range p.Series {
+		w := 24 + textWidth(s.Name, 12, false)
+		if x > plotLeft && x+w > figureWidth-figureMargin {
+			x = plotLeft
+			y += 20
+		}
+		c.line(seriesColor(i), 3, false, point{x, y - 4}, point{x + 16, y - 4})
+		c.text(x+22, y, s.Name, 12, colorText, anchorStart, false)
+		x += w + 16
+	}
+	return y + 8
+}
+
+// formatNumber is the default tick label: up to two decimals, without the
+// ones a round number does not need.
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+// coord renders a coordinate to a tenth of a pixel, which is finer than either
+// format can show.
+func coord(v float64) string {
+	v = math.Round(v*10) / 10
+	if v == 0 {
+		return "0" // not "-0"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chartimg
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func testFigure() Figure {
+	return Figure{
+		Title:    "Job Resource Usage",
+		Subtitle: "large · sampled every 15s",
+		Panels: []Panel{{
+			Title:        "CPU (cores)",
+			Ceiling:      4,
+			CeilingLabel: "limit 4 cores",
+			Series: []Series{
+				{Name: "exec 0", Step: 15, Values: []float64{0.5, 2.25, 4, 1.75}},
+				{Name: "exec <1>", Step: 15, Values: []float64{0.25, 1.5}},
+			},
+		}},
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NilError(t, testFigure().WriteSVG(&buf))
+	svg := buf.String()
+
+	t.Run("is a standalone document", func(t *testing.T) {
+		assert.Check(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="960"`))
+		assert.Check(t, strings.HasSuffix(svg, "</svg>\n"))
+	})
+
+	t.Run("titles, ticks and legend are text", func(t *testing.T) {
+		assert.Check(t, cmp.Contains(svg, ">Job Resource Usage</text>"))
+		assert.Check(t, cmp.Contains(svg, ">CPU (cores)</text>"))
+		assert.Check(t, cmp.Contains(svg, ">45</text>"), "x axis ends at the longest series")
+		assert.Check(t, cmp.Contains(svg, ">exec 0</text>"))
+		assert.Check(t, cmp.Contains(svg, ">exec &lt;1&gt;</text>"), "names are escaped")
+	})
+
+	t.Run("ceiling is ruled and labelled", func(t *testing.T) {
+		assert.Check(t, cmp.Contains(svg, `stroke-dasharray="6 4"`))
+		assert.Check(t, cmp.Contains(svg, ">limit 4 cores</text>"))
+	})
+
+	t.Run("one line per series in palette order", func(t *testing.T) {
+		assert.Check(t, cmp.Contains(svg, `stroke="#00afff" stroke-width="2"`))
+		assert.Check(t, cmp.Contains(svg, `stroke="#00d787" stroke-width="2"`))
+	})
+}
+
+func TestWritePNG(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NilError(t, testFigure().WritePNG(&buf))
+	img, err := png.Decode(&buf)
+	assert.NilError(t, err)
+
+	b := img.Bounds()
+	assert.Check(t, cmp.Equal(b.Dx(), figureWidth*pngScale))
+
+	t.Run("series are drawn in their colors", func(t *testing.T) {
+		assert.Check(t, hasColor(img, 0x00, 0xaf, 0xff), "no blue line for exec 0")
+		assert.Check(t, hasColor(img, 0x00, 0xd7, 0x87), "no green line for exec 1")
+	})
+}
+
+func TestLayoutDegenerate(t *testing.T) {
+	t.Run("no data still has axes", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NilError(t, Figure{Panels: []Panel{{Title: "Empty"}}}.WriteSVG(&buf))
+		assert.Check(t, cmp.Contains(buf.String(), ">Empty</text>"))
+		assert.Check(t, !strings.Contains(buf.String(), "NaN"))
+	})
+
+	t.Run("one sample is still visible", func(t *testing.T) {
+		c := Figure{Panels: []Panel{{Series: []Series{{Name: "a", Step: 15, Values: []float64{1}}}}}}.layout()
+		var lines int
+		for _, op := range c.ops {
+			if l, ok := op.(lineOp); ok && l.stroke == seriesColor(0) && l.width == lineWidth {
+				lines++
+				assert.Check(t, cmp.Len(l.pts, 2))
+			}
+		}
+		assert.Check(t, cmp.Equal(lines, 1))
+	})
+}
+
+func hasColor(img image.Image, r, g, b uint8) bool {
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			cr, cg, cb, ca := img.At(x, y).RGBA()
+			if ca == 0xffff && uint8(cr>>8) == r && uint8(cg>>8) == g && uint8(cb>>8) == b {
+				return true
+			}
+		}
+	}
+	return false
+}
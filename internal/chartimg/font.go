@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chartimg
+
+import (
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+)
+
+// fontFamily is what an SVG asks for. The Go fonts come first because they are
+// what the PNG is drawn with, and what the layout measured text in.
+const fontFamily = "Go, Helvetica, Arial, sans-serif"
+
+var (
+	parseFonts = sync.OnceValues(func() ([2]*opentype.Font, error) {
+		regular, err := opentype.Parse(goregular.TTF)
+		if err != nil {
+			return [2]*opentype.Font{}, err
+		}
+		bold, err := opentype.Parse(gobold.TTF)
+		return [2]*opentype.Font{regular, bold}, err
+	})
+
+	facesMu sync.Mutex
+	faces   = map[faceKey]font.Face{}
+)
+
+type faceKey struct {
+	size float64
+	bold bool
+}
+
+// face returns the Go font at size pixels, bold or not. The fonts are compiled
+// in, so failing to parse them is a build defect rather than something a
+// caller can recover from.
+func face(size float64, bold bool) font.Face {
+	facesMu.Lock()
+	defer facesMu.Unlock()
+	k := faceKey{size, bold}
+	if f, ok := faces[k]; ok {
+		return f
+	}
+	fonts, err := parseFonts()
+	if err != nil {
+		panic("chartimg: parsing built-in font: " + err.Error())
+	}
+	src := fonts[0]
+	if bold {
+		src = fonts[1]
+	}
+	f, err := opentype.NewFace(src, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingNone})
+	if err != nil {
+		panic("chartimg: loading built-in font: " + err.Error())
+	}
+	faces[k] = f
+	return f
+}
+
+// textWidth is how wide s is drawn at size pixels, in pixels.
+func textWidth(s string, size float64, bold bool) float64 {
+	return float64(font.MeasureString(face(size, bold), s)) / 64
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chartimg
+
+import (
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+)
+
+// pngScale is how many pixels a PNG uses per layout unit. Drawn at double
+// size, the text stays legible on a high-density screen, where a ticket's
+// attachments are mostly looked at.
+const pngScale = 2
+
+// Dash pattern for dashed lines, in layout units — the same as the SVG's
+// stroke-dasharray.
+const (
+	dashOn  = 6
+	dashOff = 4
+)
+
+// WritePNG writes f to w as a PNG image.
+func (f Figure) WritePNG(w io.Writer) error {
+	c := f.layout()
+	img := image.NewRGBA(image.Rect(0, 0, int(c.width*pngScale), int(c.height*pngScale)))
+	for _, op := range c.ops {
+		switch op := op.(type) {
+		case rectOp:
+			r := image.Rect(
+				int(op.x*pngScale), int(op.y*pngScale),
+				int((op.x+op.w)*pngScale), int((op.y+op.h)*pngScale))
+			draw.Draw(img, r, image.NewUniform(op.fill), image.Point{}, draw.Over)
+		case lineOp:
+			strokePNG(img, op)
+		case textOp:
+			textPNG(img, op)
+		}
+	}
+	return png.Encode(w, img)
+}
+
+// strokePNG draws a polyline as a filled quad per segment and a disc at each
+// vertex for a round join. The rasterizer saturates where shapes of the same
+// winding overlap, so the joins do not double the ink.
+func strokePNG(img *image.RGBA, op lineOp) {
+	b := img.Bounds()
+	z := vector.NewRasterizer(b.Dx(), b.Dy())
+	hw := op.width * pngScale / 2
+
+	segments := [][2]point{}
+	for i := 1; i < len(op.pts); i++ {
+		a := point{op.pts[i-1].x * pngScale, op.pts[i-1].y * pngScale}
+		b := point{op.pts[i].x * pngScale, op.pts[i].y * pngScale}
+		if op.dashed {
+			segments = append(segments, dashes(a, b, dashOn*pngScale, dashOff*pngScale)...)
+		} else {
+			segments = append(segments, [2]point{a, b})
+		}
+	}
+	for _, s := range segments {
+		quad(z, s[0], s[1], hw)
+		if !op.dashed {
+			disc(z, s[0], hw)
+			disc(z, s[1], hw)
+		}
+	}
+	z.Draw(img, b, image.NewUniform(op.stroke), image.Point{})
+}
+
+// quad adds the rectangle hw either side of the segment a→b.
+func quad(z *vector.Rasterizer, a, b point, hw float64) {
+	dx, dy := b.x-a.x, b.y-a.y
+	l := math.Hypot(dx, dy)
+	if l == 0 {
+		return
+	}
+	nx, ny := -dy/l*hw, dx/l*hw
+	z.MoveTo(float32(a.x+nx), float32(a.y+ny))
+	z.LineTo(float32(b.x+nx), float32(b.y+ny))
+	z.LineTo(float32(b.x-nx), float32(b.y-ny))
+	z.LineTo(float32(a.x-nx), float32(a.y-ny))
+	z.ClosePath()
+}
+
+// disc adds a 16-sided disc of radius r around p, wound the same way as quad
+// so the two saturate rather than cancel where they overlap.
+func disc(z *vector.Rasterizer, p point, r float64) {
+	const sides = 16
+	for i := range sides + 1 {
+		a := -2 * math.Pi * float64(i) / sides
+		x, y := float32(p.x+r*math.Cos(a)), float32(p.y+r*math.Sin(a))
+		if i == 0 {
+			z.MoveTo(x, y)
+		} else {
+			z.LineTo(x, y)
+		}
+	}
+	z.ClosePath()
+}
+
+// dashes cuts the segment a→b into on-lengths separated by off-lengths.
+func dashes(a, b point, on, off float64) [][2]point {
+	l := math.Hypot(b.x-a.x, b.y-a.y)
+	if l == 0 {
+		return nil
+	}
+	ux, uy := (b.x-a.x)/l, (b.y-a.y)/l
+	var out [][2]point
+	for d := 0.0; d < l; d += on + off {
+		e := min(d+on, l)
+		out = append(out, [2]point{{a.x + ux*d, a.y + uy*d}, {a.x + ux*e, a.y + uy*e}})
+	}
+	return out
+}
+
+func textPNG(img *image.RGBA, op textOp) {
+	x := op.x
+	switch op.anchor {
+	case anchorMiddle:
+		x -= textWidth(op.s, op.size, op.bold) / 2
+	case anchorEnd:
+		x -= textWidth(op.s, op.size, op.bold)
+	}
+	d := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(op.fill),
+		Face: face(op.size*pngScale, op.bold),
+		Dot:  fixed.Point26_6{X: fixed.Int26_6(x * pngScale * 64), Y: fixed.Int26_6(op.y * pngScale * 64)},
+	}
+	d.DrawString(op.s)
+}
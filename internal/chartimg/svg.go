@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chartimg
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"image/color"
+	"io"
+	"strings"
+)
+
+// WriteSVG writes f to w as an SVG document.
+func (f Figure) WriteSVG(w io.Writer) error {
+	c := f.layout()
+	bw := bufio.NewWriter(w)
+	_, _ = fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %[1]s %[2]s" font-family="%s">`+"\n",
+		coord(c.width), coord(c.height), fontFamily)
+	for _, op := range c.ops {
+		switch op := op.(type) {
+		case rectOp:
+			_, _ = fmt.Fprintf(bw, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"/>`+"\n",
+				coord(op.x), coord(op.y), coord(op.w), coord(op.h), hexColor(op.fill))
+		case lineOp:
+			pts := make([]string, len(op.pts))
+			for i, p := range op.pts {
+				pts[i] = coord(p.x) + "," + coord(p.y)
+			}
+			dash := ""
+			if op.dashed {
+				dash = ` stroke-dasharray="6 4"`
+			}
+			_, _ = fmt.Fprintf(bw, `<polyline points="%s" fill="none" stroke="%s" stroke-width="%s" stroke-linejoin="round"%s/>`+"\n",
+				strings.Join(pts, " "), hexColor(op.stroke), coord(op.width), dash)
+		case textOp:
+			attrs := ""
+			switch op.anchor {
+			case anchorMiddle:
+				attrs += ` text-anchor="middle"`
+			case anchorEnd:
+				attrs += ` text-anchor="end"`
+			}
+			if op.bold {
+				attrs += ` font-weight="bold"`
+			}
+			_, _ = fmt.Fprintf(bw, `<text x="%s" y="%s" font-size="%s" fill="%s"%s>%s</text>`+"\n",
+				coord(op.x), coord(op.y), coord(op.size), hexColor(op.fill), attrs, html.EscapeString(op.s))
+		}
+	}
+	_, _ = bw.WriteString("</svg>\n")
+	return bw.Flush()
+}
+
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
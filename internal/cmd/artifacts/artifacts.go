@@ -24,40 +24,53 @@
 package artifacts
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/cobra"
 
 	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
 	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
 	"github.com/CircleCI-Public/circleci-cli/internal/artifacts"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
 )
 
+// artifactOptions holds the flags of "circleci artifact".
+type artifactOptions struct {
+	OutputDir string
+	JSON      bool
+	Patterns  []string
+	Excludes  []string
+	Parallel  int
+	Browse    bool
+
+	// Latest finds the job by name instead of by ID: the most recent one to
+	// succeed on Branch in Project.
+	Latest  bool
+	Project string
+	Branch  string
+	Job     string
+}
+
 // NewArtifactCmd returns the top-level "circleci artifact" command.
 func NewArtifactCmd() *cobra.Command {
-	var (
-		outputDir string
-		jsonOut   bool
-	)
+	var opts artifactOptions
 
 	cmd := &cobra.Command{
-		Use:     "artifact <job-id>",
+		Use:     "artifact [<job-id>]",
 		GroupID: "ci",
 		Short:   "List and download a job's artifact files",
 		Annotations: map[string]string{
 			"help:arguments": heredoc.Docf(`
-				%[1]s<job-id>%[1]s is the UUID of the job whose artifacts you want to
-				list or download,
-				for example. %[1]s5034460f-c7c4-4c43-9457-de07e2029e7b%[1]s.
+				%[1]s<job-id>%[1]s is the job's UUID, e.g. %[1]s5034460f-c7c4-4c43-9457-de07e2029e7b%[1]s; omit it with --latest.
 			`, "`"),
 		},
 		Long: heredoc.Doc(`
-			List or download artifacts produced by a CircleCI job.
-
-			Pass the job UUID to list its artifacts. Use --output to save
-			them to a local directory.
+			List or download artifacts produced by a CircleCI job. Use --output to save
+			them to a local directory; --pattern and --exclude filter them by path.
 
 			JSON fields: path, url, execution
 		`),
@@ -68,6 +81,15 @@ func NewArtifactCmd() *cobra.Command {
 			# Download all artifacts into ./artifacts
 			$ circleci artifact 5034460f-c7c4-4c43-9457-de07e2029e7b --output ./artifacts
 
+			# Download only the JUnit reports
+			$ circleci artifact 5034460f-c7c4-4c43-9457-de07e2029e7b --output ./results --pattern '**/*.xml'
+
+			# Artifacts of the most recent successful "build" job on main
+			$ circleci artifact --latest --job build --branch main
+
+			# Browse, preview and pick artifacts to download
+			$ circleci artifact 5034460f-c7c4-4c43-9457-de07e2029e7b --browse
+
 			# Output as JSON for scripting
 			$ circleci artifact 5034460f-c7c4-4c43-9457-de07e2029e7b --json
 		`),
@@ -75,7 +97,7 @@ func NewArtifactCmd() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			err := cmdutil.RequireArgs(args, "job-id")
+			err := validateArtifactOptions(cmd, args, opts)
 			if err != nil {
 				return err
 			}
@@ -85,43 +107,147 @@ func NewArtifactCmd() *cobra.Command {
 				return err
 			}
 
-			jobID := args[0]
-			entries, err := artifacts.ForJob(ctx, client, jobID)
-			if err != nil {
-				return cmdutil.APIErr(err, jobID, "artifacts.not_found", "No resource found for %q.")
-			}
-
-			if len(entries) == 0 {
-				if !jsonOut {
-					iostream.ErrPrintln(ctx, "No artifacts found.")
-					return nil
+			var jobID string
+			if opts.Latest {
+				jobID, err = latestSuccessfulJob(ctx, client, opts)
+				if err != nil {
+					return err
 				}
-				entries = []artifacts.Entry{}
+			} else {
+				jobID = args[0]
 			}
+			return runArtifact(ctx, client, jobID, opts)
+		},
+	}
 
-			if outputDir != "" {
-				sp := iostream.Spinner(ctx, !jsonOut, fmt.Sprintf("Downloading %d artifact(s) to %q", len(entries), outputDir))
-				dlErr := artifacts.Download(ctx, client, entries, outputDir)
-				sp.Stop()
-				if dlErr != nil {
-					return clierrors.New("artifacts.download_failed", "Download failed", dlErr.Error()).
-						WithExitCode(clierrors.ExitGeneralError)
-				}
-				iostream.ErrPrintf(ctx, "%s Downloaded %d artifact(s)\n", iostream.SymbolOK(ctx), len(entries))
-			}
+	cmd.Flags().StringVarP(&opts.OutputDir, "output", "o", "", "Download artifacts into this directory, skipping identical files already there and resuming partial ones")
+	cmd.Flags().StringArrayVar(&opts.Patterns, "pattern", nil, "Only artifacts whose path matches this glob, e.g. '**/*.xml' (repeatable)")
+	cmd.Flags().StringArrayVar(&opts.Excludes, "exclude", nil, "Leave out artifacts whose path matches this glob (repeatable)")
+	cmd.Flags().IntVar(&opts.Parallel, "parallel", artifacts.DefaultParallel, "How many artifacts to download at once")
+	cmd.Flags().BoolVar(&opts.Browse, "browse", false, "Browse the artifacts interactively, previewing files and picking which to download")
+	cmd.Flags().BoolVar(&opts.Latest, "latest", false, "Use the most recent successful run of --job instead of a job ID")
+	cmd.Flags().StringVar(&opts.Job, "job", "", "Job name to find with --latest")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Branch to search with --latest (defaults to current branch)")
+	cmd.Flags().StringVar(&opts.Project, "project", "", "Project slug for --latest (e.g. gh/org/repo); defaults to git remote")
+	cmdutil.AddJSONFlag(cmd, &opts.JSON)
+	cmdutil.AddJQFlag(cmd)
+
+	cmd.AddCommand(newDiffCmd())
 
-			if jsonOut {
-				return iostream.PrintJSON(ctx, entries)
+	return cmd
+}
+
+// validateArtifactOptions checks that the job is identified one way only, and
+// that the download settings make sense, before anything is fetched.
+func validateArtifactOptions(cmd *cobra.Command, args []string, opts artifactOptions) error {
+	if opts.Latest {
+		if len(args) > 0 {
+			return clierrors.New("args.conflicting_args", "Conflicting arguments",
+				"Pass either a job ID or --latest, not both.").
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+		if opts.Job == "" {
+			return clierrors.New("args.missing_flag", "Missing required flag", "--latest requires --job").
+				WithSuggestions("Pass --job <name>, e.g. --latest --job build").
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+	} else {
+		for _, name := range []string{"job", "branch", "project"} {
+			if cmd.Flags().Changed(name) {
+				return clierrors.New("args.conflicting_flags", "Conflicting flags",
+					fmt.Sprintf("--%s only applies with --latest.", name)).
+					WithSuggestions("Add --latest to fetch from the most recent successful run of --job").
+					WithExitCode(clierrors.ExitBadArguments)
 			}
+		}
+		if err := cmdutil.RequireArgs(args, "job-id"); err != nil {
+			return err
+		}
+	}
+	if opts.Parallel < 1 {
+		return clierrors.New("args.invalid_parallel", "Invalid --parallel value",
+			"--parallel must be at least 1.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if opts.Browse {
+		if opts.JSON {
+			return clierrors.New("args.conflicting_flags", "Conflicting flags",
+				"--browse and --json cannot be used together.").
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+		if !iostream.IsInteractive(cmd.Context()) {
+			return clierrors.New("artifacts.browse_requires_terminal", "Interactive terminal required",
+				"--browse needs an interactive terminal.").
+				WithSuggestions("Drop --browse to list the artifacts, or add --output <dir> to download them").
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+	}
+	_, err := artifacts.Filter(nil, opts.Patterns, opts.Excludes)
+	if err != nil {
+		return clierrors.New("args.invalid_pattern", "Invalid glob pattern", err.Error()).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	return nil
+}
+
+func runArtifact(ctx context.Context, client *apiclient.Client, jobID string, opts artifactOptions) error {
+	entries, err := artifacts.ForJob(ctx, client, jobID)
+	if err != nil {
+		return cmdutil.APIErr(err, jobID, "artifacts.not_found", "No resource found for %q.")
+	}
+	total := len(entries)
+	entries, err = artifacts.Filter(entries, opts.Patterns, opts.Excludes)
+	if err != nil {
+		return err
+	}
 
-			iostream.PrintMarkdown(ctx, artifacts.FormatMarkdown(entries))
+	if len(entries) == 0 {
+		if !opts.JSON {
+			if total > 0 {
+				iostream.ErrPrintf(ctx, "None of the %d artifact(s) match the given patterns.\n", total)
+			} else {
+				iostream.ErrPrintln(ctx, "No artifacts found.")
+			}
 			return nil
-		},
+		}
+		entries = []artifacts.Entry{}
 	}
 
-	cmd.Flags().StringVarP(&outputDir, "output", "o", "", "Download artifacts into this directory")
-	cmdutil.AddJSONFlag(cmd, &jsonOut)
-	cmdutil.AddJQFlag(cmd)
+	if opts.Browse {
+		return browseArtifacts(ctx, client, entries, opts)
+	}
 
-	return cmd
+	if opts.OutputDir != "" {
+		sp := iostream.Spinner(ctx, !opts.JSON, fmt.Sprintf("Downloading %d artifact(s) to %q", len(entries), opts.OutputDir))
+		res, dlErr := artifacts.Download(ctx, client, entries, opts.OutputDir, artifacts.DownloadOptions{Parallel: opts.Parallel})
+		sp.Stop()
+		if dlErr != nil {
+			return clierrors.New("artifacts.download_failed", "Download failed", dlErr.Error()).
+				WithExitCode(clierrors.ExitGeneralError)
+		}
+		iostream.ErrPrintf(ctx, "%s %s\n", iostream.SymbolOK(ctx), downloadSummary(res))
+	}
+
+	if opts.JSON {
+		return iostream.PrintJSON(ctx, entries)
+	}
+
+	iostream.PrintMarkdown(ctx, artifacts.FormatMarkdown(entries))
+	return nil
+}
+
+// downloadSummary describes a download's result in one line, mentioning the
+// files resumed or skipped only when there were some.
+func downloadSummary(res artifacts.DownloadResult) string {
+	parts := []string{fmt.Sprintf("Downloaded %d artifact(s)", res.Downloaded+res.Resumed)}
+	if res.Resumed > 0 {
+		parts = append(parts, fmt.Sprintf("%d resumed", res.Resumed))
+	}
+	if res.Skipped > 0 {
+		parts = append(parts, fmt.Sprintf("%d already present", res.Skipped))
+	}
+	if res.Unchecked > 0 {
+		parts = append(parts, fmt.Sprintf("%d in full as the store would not report their size", res.Unchecked))
+	}
+	return strings.Join(parts, ", ")
 }
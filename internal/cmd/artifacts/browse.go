@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/artifacts"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/ui"
+)
+
+// defaultBrowseDir is where --browse downloads without --output: the same place
+// the run-get artifact browser uses, so the two agree.
+const defaultBrowseDir = "./artifacts"
+
+// browseArtifacts runs the interactive browser over entries, then reports how
+// many files were downloaded while browsing.
+func browseArtifacts(ctx context.Context, client *apiclient.Client, entries []artifacts.Entry, opts artifactOptions) error {
+	dir := opts.OutputDir
+	if dir == "" {
+		dir = defaultBrowseDir
+	}
+	items := make([]ui.RunGetArtifactItem, len(entries))
+	for i, e := range entries {
+		items[i] = ui.RunGetArtifactItem{Path: e.Path, URL: e.URL, Execution: e.Execution}
+	}
+	model := ui.NewArtifactBrowser(ctx, ui.ArtifactBrowserOptions{
+		Items:        items,
+		Dir:          dir,
+		FetchContent: previewContent(client),
+		Download:     downloadItems(client, opts.Parallel),
+	})
+
+	final, err := tea.NewProgram(model,
+		tea.WithContext(ctx),
+		tea.WithInput(iostream.In(ctx)),
+		tea.WithOutput(iostream.Err(ctx)),
+	).Run()
+	if err != nil && !errors.Is(err, tea.ErrInterrupted) && !errors.Is(err, tea.ErrProgramKilled) &&
+		!errors.Is(err, context.Canceled) {
+		return clierrors.New("artifacts.browse_failed", "Failed to display artifacts", err.Error()).
+			WithExitCode(clierrors.ExitGeneralError)
+	}
+	if m, ok := final.(ui.ArtifactBrowserModel); ok {
+		if n := m.Result().Downloaded; n > 0 {
+			iostream.ErrPrintf(ctx, "%s Downloaded %d artifact(s) to %s\n", iostream.SymbolOK(ctx), n, dir)
+		}
+	}
+	return nil
+}
+
+// downloadItems returns the closure the browser downloads with. Each item's path
+// is the one the browser displayed, so DownloadPaths writes it verbatim.
+func downloadItems(client *apiclient.Client, parallel int) func(context.Context, []ui.RunGetArtifactItem, string) error {
+	return func(ctx context.Context, items []ui.RunGetArtifactItem, dir string) error {
+		entries := make([]artifacts.Entry, len(items))
+		for i, item := range items {
+			entries[i] = artifacts.Entry{Path: item.Path, URL: item.URL, Execution: item.Execution}
+		}
+		_, err := artifacts.DownloadPaths(ctx, client, entries, dir, artifacts.DownloadOptions{Parallel: parallel})
+		return err
+	}
+}
+
+// previewContent returns the closure the browser uses to preview one artifact.
+// Past the preview cap it says so and points at the download key.
+func previewContent(client *apiclient.Client) func(context.Context, ui.RunGetArtifactItem) ([]byte, bool, error) {
+	return func(ctx context.Context, item ui.RunGetArtifactItem) ([]byte, bool, error) {
+		data, text, err := artifacts.Preview(ctx, client, item.URL)
+		if err != nil {
+			if errors.Is(err, artifacts.ErrTooLarge) {
+				return nil, false, fmt.Errorf("%s is over %d MiB — too large to preview, press enter to download it",
+					item.Path, artifacts.MaxPreview>>20)
+			}
+			return nil, false, cmdutil.APIErr(err, item.Path, "artifacts.not_found", "No artifact found at %q.")
+		}
+		return data, text, nil
+	}
+}
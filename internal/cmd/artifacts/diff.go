@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package artifacts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/artifacts"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+)
+
+// diffOptions holds the flags of "circleci artifact diff".
+type diffOptions struct {
+	JSON     bool
+	Patterns []string
+	Excludes []string
+}
+
+func newDiffCmd() *cobra.Command {
+	var opts diffOptions
+
+	cmd := &cobra.Command{
+		Use:   "diff <job-a> <job-b>",
+		Short: "Compare the artifacts of two jobs",
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Docf(`
+				%[1]s<job-a>%[1]s and %[1]s<job-b>%[1]s are job UUIDs; changes are shown going from the first to the second.
+			`, "`"),
+		},
+		Long: heredoc.Doc(`
+			List the artifacts added, removed and changed between two jobs, with a
+			unified diff for each changed text artifact. Artifacts are matched by
+			path; those over 8 MiB are compared but not diffed.
+
+			Exit code is 0 regardless of whether the jobs differ.
+
+			JSON fields: changes (path, status, from_size, to_size, diff, note), unchanged
+		`),
+		Example: heredoc.Doc(`
+			# Compare a PR build's artifacts with main's
+			$ circleci artifact diff 5034460f-c7c4-4c43-9457-de07e2029e7b 9f2e1c3a-4b5d-4e6f-8a7b-0c1d2e3f4a5b
+
+			# Only coverage summaries
+			$ circleci artifact diff 5034460f-c7c4-4c43-9457-de07e2029e7b 9f2e1c3a-4b5d-4e6f-8a7b-0c1d2e3f4a5b --pattern 'coverage/*.txt'
+		`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if err := cmdutil.RequireArgs(args, "job-a", "job-b"); err != nil {
+				return err
+			}
+			if _, err := artifacts.Filter(nil, opts.Patterns, opts.Excludes); err != nil {
+				return clierrors.New("args.invalid_pattern", "Invalid glob pattern", err.Error()).
+					WithExitCode(clierrors.ExitBadArguments)
+			}
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runDiff(ctx, client, args[0], args[1], opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.Patterns, "pattern", nil, "Only compare artifacts whose path matches this glob (repeatable)")
+	cmd.Flags().StringArrayVar(&opts.Excludes, "exclude", nil, "Leave out artifacts whose path matches this glob (repeatable)")
+	cmdutil.AddJSONFlag(cmd, &opts.JSON)
+	cmdutil.AddJQFlag(cmd)
+
+	return cmd
+}
+
+func runDiff(ctx context.Context, client *apiclient.Client, jobA, jobB string, opts diffOptions) error {
+	from, err := jobArtifacts(ctx, client, jobA, opts)
+	if err != nil {
+		return err
+	}
+	to, err := jobArtifacts(ctx, client, jobB, opts)
+	if err != nil {
+		return err
+	}
+
+	sp := iostream.Spinner(ctx, !opts.JSON, fmt.Sprintf("Comparing %d and %d artifact(s)", len(from), len(to)))
+	res, err := artifacts.Diff(ctx, client, from, to, artifacts.DiffOptions{Parallel: artifacts.DefaultParallel})
+	sp.Stop()
+	if err != nil {
+		return clierrors.New("artifacts.download_failed", "Download failed", err.Error()).
+			WithExitCode(clierrors.ExitGeneralError)
+	}
+
+	if opts.JSON {
+		return iostream.PrintJSON(ctx, res)
+	}
+	if len(res.Changes) == 0 {
+		iostream.ErrPrintf(ctx, "No differences in %d artifact(s).\n", res.Unchanged)
+		return nil
+	}
+	iostream.PrintMarkdown(ctx, artifacts.FormatDiffMarkdown(res))
+	color := iostream.ColorEnabled(ctx)
+	for _, c := range res.Changes {
+		if c.Diff != "" {
+			iostream.Print(ctx, "\n"+cmdutil.ColorizeDiff(c.Diff, color))
+		}
+	}
+	return nil
+}
+
+// jobArtifacts lists one job's artifacts, narrowed by the patterns.
+func jobArtifacts(ctx context.Context, client *apiclient.Client, jobID string, opts diffOptions) ([]artifacts.Entry, error) {
+	entries, err := artifacts.ForJob(ctx, client, jobID)
+	if err != nil {
+		return nil, cmdutil.APIErr(err, jobID, "artifacts.not_found", "No resource found for %q.")
+	}
+	return artifacts.Filter(entries, opts.Patterns, opts.Excludes)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package artifacts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/gitremote"
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+)
+
+const (
+	// latestSearchDays is how far back --latest looks for a run.
+	latestSearchDays = 90
+	// latestMaxRuns bounds how many of the branch's runs --latest opens
+	// looking for the job: each costs a request per workflow.
+	latestMaxRuns = 50
+	// defaultBranchGuess is the branch --latest searches when --project names a
+	// project other than the checked-out one and --branch isn't given.
+	defaultBranchGuess = "main"
+)
+
+// latestSuccessfulJob finds the ID of the most recent job named opts.Job that
+// succeeded on the branch, walking the branch's runs newest first. The project
+// and branch default to the current git repository's, as for "circleci run
+// get".
+func latestSuccessfulJob(ctx context.Context, client *apiclient.Client, opts artifactOptions) (string, error) {
+	slug, branch := opts.Project, opts.Branch
+	if slug == "" {
+		info, err := gitremote.Detect()
+		if err != nil {
+			return "", cmdutil.GitDetectErr(err, "Or specify the project with --project gh/org/repo")
+		}
+		slug = info.Slug
+		if branch == "" {
+			branch = info.Branch
+		}
+	} else if branch == "" {
+		branch = defaultBranchGuess
+	}
+	projectID, err := cmdutil.ResolveProjectID(ctx, client, slug, "")
+	if err != nil {
+		return "", err
+	}
+
+	sp := iostream.Spinner(ctx, !opts.JSON, fmt.Sprintf("Finding the latest successful %s job on %s", opts.Job, branch))
+	var (
+		found   uuid.UUID
+		scanned int
+	)
+	now := time.Now().UTC()
+	params := apiclient.RunSearchParams{
+		ProjectIDs: []string{projectID},
+		From:       now.AddDate(0, 0, -latestSearchDays),
+		To:         now,
+		Filter:     apiclient.BuildRunFilter(branch, ""),
+	}
+	err = client.SearchRunsV3Pages(ctx, params, func(runs []apiclient.RunV3) (bool, error) {
+		for _, r := range runs {
+			id, err := succeededJobInRun(ctx, client, r.ID, opts.Job)
+			if err != nil {
+				return false, err
+			}
+			scanned++
+			if id != uuid.Nil {
+				found = id
+				return false, nil
+			}
+			if scanned >= latestMaxRuns {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	sp.Stop()
+	if err != nil {
+		return "", cmdutil.APIErr(err, fmt.Sprintf("%s@%s", slug, branch), "run.not_found", "No runs found for %q.")
+	}
+	if found == uuid.Nil {
+		return "", clierrors.New("job.not_found", "Job not found",
+			fmt.Sprintf("No %q job succeeded in the last %d runs on %s of %s.", opts.Job, scanned, branch, slug)).
+			WithSuggestions(
+				"Check the job name against: circleci run get --project "+slug+" --branch "+branch,
+				"Pass --branch to search another branch",
+			).
+			WithExitCode(clierrors.ExitNotFound)
+	}
+	return found.String(), nil
+}
+
+// succeededJobInRun returns the ID of a job named name that succeeded in the
+// run, or uuid.Nil if none did. A run whose workflows 404 has none yet.
+func succeededJobInRun(ctx context.Context, client *apiclient.Client, runID uuid.UUID, name string) (uuid.UUID, error) {
+	workflows, err := client.GetRunWorkflowsV3(ctx, runID)
+	if err != nil {
+		if httpcl.HasStatusCode(err, http.StatusNotFound) {
+			return uuid.Nil, nil
+		}
+		return uuid.Nil, err
+	}
+	for _, wf := range workflows {
+		jobs, err := client.GetWorkflowJobsV3(ctx, wf.ID)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		for _, j := range jobs {
+			if j.Name == name && j.Phase == apiclient.PhaseEnded && j.Outcome == "succeeded" {
+				return j.ID, nil
+			}
+		}
+	}
+	return uuid.Nil, nil
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package insights
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+)
+
+// defaultBranchLimit is how many branches "insights branches" shows unless told
+// otherwise. Each costs a request for its trend, and a long-lived project can
+// have hundreds.
+const defaultBranchLimit = 20
+
+func newBranchesCmd() *cobra.Command {
+	var (
+		opts  scopeOptions
+		limit int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "branches <workflow>",
+		Short: "Compare a workflow's metrics across branches",
+		Long: heredoc.Doc(`
+			Show a workflow's success rate, throughput (runs a day), p50 and p95
+			duration, mean time to recovery and credits used on each branch it ran
+			on in the reporting window, busiest branch first, with a trend of the
+			branch's last 30 runs' durations. Only the --limit busiest branches are
+			shown, and only theirs have a trend fetched.
+
+			JSON fields: project, workflow, window, items (name, success_rate, total_runs, failed_runs, throughput, duration_p50, duration_p95, mttr, credits_used, trend); durations in seconds
+		`),
+		Example: heredoc.Doc(`
+			# The build workflow on every branch over the last 30 days
+			$ circleci insights branches build
+
+			# The five busiest branches only
+			$ circleci insights branches build --limit 5
+
+			# Branches where deploy fails most, over the last week
+			$ circleci insights branches deploy --window 7d --json \
+			    --jq '.items | sort_by(.success_rate) | .[] | .name'
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.RequireArgs(args, "workflow"); err != nil {
+				return err
+			}
+			w, err := opts.validate()
+			if err != nil {
+				return err
+			}
+			if limit < 0 {
+				return clierrors.New("args.invalid_limit", "Invalid limit", "--limit must be zero or greater").
+					WithExitCode(clierrors.ExitBadArguments)
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runBranches(ctx, client, args[0], opts, w, limit)
+		},
+	}
+
+	addScopeFlags(cmd, &opts, false)
+	cmd.Flags().IntVar(&limit, "limit", defaultBranchLimit, "Show only the N busiest branches (0 = every branch)")
+
+	return cmd
+}
+
+func runBranches(ctx context.Context, client *apiclient.Client, workflow string, opts scopeOptions, w window, limit int) error {
+	slug, err := cmdutil.ResolveProjectSlug(opts.Project)
+	if err != nil {
+		return err
+	}
+
+	sp := iostream.Spinner(ctx, !opts.JSON, fmt.Sprintf("Fetching branch insights for %s", workflow))
+	rows, err := branchRows(ctx, client, slug, workflow, w, limit)
+	sp.Stop()
+	if err != nil {
+		return workflowErr(err, workflow)
+	}
+
+	out := insightsOutput{Project: slug, Workflow: workflow, Window: w.flag, Items: rows}
+	if opts.JSON {
+		return iostream.PrintJSON(ctx, out)
+	}
+	if len(rows) == 0 {
+		iostream.ErrPrintf(ctx, "No runs of %s in %s in the %s.\n", workflow, slug, w.text)
+		return nil
+	}
+	iostream.PrintMarkdown(ctx, summaryMarkdown("Branch Insights", "Branch", out, w,
+		"Trend is the workflow's duration over the branch's last 30 runs, oldest first."))
+	return nil
+}
+
+// branchRows fetches the workflow's metrics on each branch it has run on, then
+// the recent runs of the limit busiest for their trends (every branch when
+// limit is zero). Branches with no runs in the window are left out; the rest
+// are ordered by run count, busiest first.
+func branchRows(ctx context.Context, client *apiclient.Client, slug, workflow string, w window, limit int) ([]row, error) {
+	branches, err := client.ListInsightsBranches(ctx, slug, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]*row, len(branches))
+	err = bulkhead.Do(ctx, maxFetches, branches, func(branch string, i int) error {
+		summaries, err := client.ListWorkflowInsights(ctx, slug, apiclient.InsightsParams{Branch: branch, Window: w.api})
+		if err != nil {
+			return err
+		}
+		idx := slices.IndexFunc(summaries, func(s apiclient.InsightsSummary) bool { return s.Name == workflow })
+		if idx < 0 || summaries[idx].Metrics.TotalRuns == 0 {
+			return nil
+		}
+		r := newRow(branch, summaries[idx].Metrics, true)
+		found[i] = &r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []row{}
+	for _, r := range found {
+		if r != nil {
+			rows = append(rows, *r)
+		}
+	}
+	slices.SortStableFunc(rows, func(a, b row) int {
+		return cmp.Or(cmp.Compare(b.TotalRuns, a.TotalRuns), cmp.Compare(a.Name, b.Name))
+	})
+	if limit > 0 && len(rows) > limit {
+		rows = rows[:limit]
+	}
+
+	end := time.Now().UTC()
+	err = bulkhead.Do(ctx, maxFetches, rows, func(r row, i int) error {
+		runs, err := client.ListWorkflowRunInsights(ctx, slug, workflow,
+			apiclient.InsightsRunParams{Branch: r.Name, Start: end.Add(-w.span), End: end}, trendRuns)
+		if err != nil {
+			return err
+		}
+		rows[i].Trend = runTrend(runs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
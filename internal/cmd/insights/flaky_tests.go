@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package insights
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/clikit/mdtable"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+)
+
+type flakyTestsOptions struct {
+	Project string
+	JSON    bool
+}
+
+// flakyTestsOutput is the typed output of "circleci insights flaky-tests".
+type flakyTestsOutput struct {
+	Project string                `json:"project"`
+	Total   int                   `json:"total"`
+	Tests   []apiclient.FlakyTest `json:"tests"`
+}
+
+func newFlakyTestsCmd() *cobra.Command {
+	var opts flakyTestsOptions
+
+	cmd := &cobra.Command{
+		Use:   "flaky-tests",
+		Short: "List tests that both passed and failed on the same commit",
+		Long: heredoc.Doc(`
+			List the tests Insights has flagged as flaky in a project — those that
+			passed and failed on the same commit — most often flaked first, with the
+			job time their failures cost. The API looks back over a fixed 14 days,
+			so --window does not apply.
+
+			JSON fields: project, total, tests (test_name, classname, file, source, job_name, job_number, workflow_name, workflow_id, pipeline_number, times_flaked, time_wasted, workflow_created_at); time_wasted in seconds
+		`),
+		Example: heredoc.Doc(`
+			# Flaky tests in the current project
+			$ circleci insights flaky-tests
+
+			# Names of tests that flaked more than 5 times
+			$ circleci insights flaky-tests --json --jq '.tests[] | select(.times_flaked > 5) | .test_name'
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runFlakyTests(ctx, client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Project, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
+	cmdutil.AddJSONFlag(cmd, &opts.JSON)
+	cmdutil.AddJQFlag(cmd)
+
+	return cmd
+}
+
+func runFlakyTests(ctx context.Context, client *apiclient.Client, opts flakyTestsOptions) error {
+	slug, err := cmdutil.ResolveProjectSlug(opts.Project)
+	if err != nil {
+		return err
+	}
+
+	sp := iostream.Spinner(ctx, !opts.JSON, fmt.Sprintf("Fetching flaky tests for %s", slug))
+	flaky, err := client.GetFlakyTests(ctx, slug)
+	sp.Stop()
+	if err != nil {
+		return projectErr(err, slug)
+	}
+
+	tests := slices.Clone(flaky.Tests)
+	if tests == nil {
+		tests = []apiclient.FlakyTest{}
+	}
+	slices.SortStableFunc(tests, func(a, b apiclient.FlakyTest) int {
+		return cmp.Or(cmp.Compare(b.TimesFlaked, a.TimesFlaked), cmp.Compare(b.TimeWasted, a.TimeWasted))
+	})
+
+	out := flakyTestsOutput{Project: slug, Total: flaky.Total, Tests: tests}
+	if opts.JSON {
+		return iostream.PrintJSON(ctx, out)
+	}
+	if len(tests) == 0 {
+		iostream.ErrPrintf(ctx, "No flaky tests in %s.\n", slug)
+		return nil
+	}
+	iostream.PrintMarkdown(ctx, flakyTestsMarkdown(out))
+	return nil
+}
+
+func flakyTestsMarkdown(out flakyTestsOutput) string {
+	var md strings.Builder
+	md.WriteString("# Flaky Tests\n")
+	_, _ = fmt.Fprintf(&md, "- Project: %s\n", out.Project)
+	_, _ = fmt.Fprintf(&md, "- Flaky tests: %d\n\n", out.Total)
+
+	t := mdtable.New("Test", "Job", "Workflow", "Flaked", "Time wasted")
+	for _, ft := range out.Tests {
+		name := ft.TestName
+		if ft.Classname != "" {
+			name = ft.Classname + " › " + name
+		}
+		t.Row(name, ft.JobName, ft.WorkflowName, strconv.Itoa(ft.TimesFlaked), formatSeconds(ft.TimeWasted))
+	}
+	md.WriteString(t.Render())
+	if len(out.Tests) < out.Total {
+		_, _ = fmt.Fprintf(&md, "\nShowing %d of %d; the rest are in the Insights dashboard.\n", len(out.Tests), out.Total)
+	}
+	return md.String()
+}
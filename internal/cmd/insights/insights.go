@@ -0,0 +1,277 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package insights implements the "circleci insights" command group.
+package insights
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/mdtable"
+	"github.com/CircleCI-Public/circleci-cli/clikit/ui/components"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+)
+
+const (
+	defaultWindow = "30d"
+	// trendRuns is how many of a workflow's most recent runs its trend covers.
+	trendRuns = 30
+	// maxFetches bounds the per-workflow and per-branch requests in flight.
+	maxFetches = 4
+)
+
+// NewInsightsCmd returns the "circleci insights" command group.
+func NewInsightsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "insights <command>",
+		GroupID: "ci",
+		Short:   "Show success rate, duration and credit metrics for a project",
+		Long: heredoc.Doc(`
+			Summarize how a project's workflows and jobs have performed over a
+			reporting window: success rate, throughput, p50/p95 duration, mean time
+			to recovery and credits used, with a trend of recent durations.
+
+			The same metrics back the Insights dashboard in the web app.
+		`),
+		RunE:               cmdutil.GroupRunE,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+	}
+
+	cmdutil.AddGroup(cmd, "General commands",
+		newWorkflowsCmd(),
+		newFlakyTestsCmd(),
+	)
+	cmdutil.AddGroup(cmd, "Targeted commands",
+		newJobsCmd(),
+		newBranchesCmd(),
+	)
+
+	return cmd
+}
+
+// window is a reporting window as --window names it.
+type window struct {
+	flag string
+	api  apiclient.InsightsWindow
+	span time.Duration
+	text string
+}
+
+var windows = []window{
+	{"24h", apiclient.InsightsLast24Hours, 24 * time.Hour, "last 24 hours"},
+	{"7d", apiclient.InsightsLast7Days, 7 * 24 * time.Hour, "last 7 days"},
+	{"30d", apiclient.InsightsLast30Days, 30 * 24 * time.Hour, "last 30 days"},
+	{"60d", apiclient.InsightsLast60Days, 60 * 24 * time.Hour, "last 60 days"},
+	{"90d", apiclient.InsightsLast90Days, 90 * 24 * time.Hour, "last 90 days"},
+}
+
+func parseWindow(s string) (window, error) {
+	names := make([]string, len(windows))
+	for i, w := range windows {
+		if w.flag == s {
+			return w, nil
+		}
+		names[i] = w.flag
+	}
+	return window{}, clierrors.New("args.invalid_window", "Invalid --window value",
+		fmt.Sprintf("--window must be one of %s, not %q.", strings.Join(names, ", "), s)).
+		WithExitCode(clierrors.ExitBadArguments)
+}
+
+// scopeOptions are the flags every summary subcommand shares.
+type scopeOptions struct {
+	Project     string
+	Branch      string
+	AllBranches bool
+	Window      string
+	JSON        bool
+}
+
+func addScopeFlags(cmd *cobra.Command, opts *scopeOptions, branch bool) {
+	cmd.Flags().StringVar(&opts.Project, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
+	if branch {
+		cmd.Flags().StringVar(&opts.Branch, "branch", "", "Report on this branch instead of the default branch")
+		cmd.Flags().BoolVar(&opts.AllBranches, "all-branches", false, "Report across every branch")
+	}
+	cmd.Flags().StringVar(&opts.Window, "window", defaultWindow, "Reporting window: 24h, 7d, 30d, 60d or 90d")
+	cmdutil.AddJSONFlag(cmd, &opts.JSON)
+	cmdutil.AddJQFlag(cmd)
+}
+
+// validate checks the scope flags, returning the window they name.
+func (o scopeOptions) validate() (window, error) {
+	if o.Branch != "" && o.AllBranches {
+		return window{}, clierrors.New("args.conflicting_flags", "Conflicting flags",
+			"--branch and --all-branches cannot be used together.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	return parseWindow(o.Window)
+}
+
+func (o scopeOptions) params(w window) apiclient.InsightsParams {
+	return apiclient.InsightsParams{Branch: o.Branch, AllBranches: o.AllBranches, Window: w.api}
+}
+
+// insightsOutput is the typed output of the summary subcommands.
+type insightsOutput struct {
+	Project     string `json:"project"`
+	Workflow    string `json:"workflow,omitempty"`
+	Branch      string `json:"branch,omitempty"`
+	AllBranches bool   `json:"all_branches,omitempty"`
+	Window      string `json:"window"`
+	Items       []row  `json:"items"`
+}
+
+// row is one workflow's, job's or branch's metrics. Durations are in seconds;
+// MTTR is reported for workflows only. Trend is a series of durations, oldest
+// first.
+type row struct {
+	Name        string  `json:"name"`
+	SuccessRate float64 `json:"success_rate"`
+	TotalRuns   int     `json:"total_runs"`
+	FailedRuns  int     `json:"failed_runs"`
+	Throughput  float64 `json:"throughput"`
+	DurationP50 int64   `json:"duration_p50"`
+	DurationP95 int64   `json:"duration_p95"`
+	MTTR        *int64  `json:"mttr,omitempty"`
+	CreditsUsed int64   `json:"credits_used"`
+	Trend       []int64 `json:"trend"`
+}
+
+func newRow(name string, m apiclient.InsightsMetrics, mttr bool) row {
+	r := row{
+		Name:        name,
+		SuccessRate: m.SuccessRate,
+		TotalRuns:   m.TotalRuns,
+		FailedRuns:  m.FailedRuns,
+		Throughput:  m.Throughput,
+		DurationP50: m.DurationMetrics.Median,
+		DurationP95: m.DurationMetrics.P95,
+		CreditsUsed: m.TotalCreditsUsed,
+		Trend:       []int64{},
+	}
+	if mttr {
+		r.MTTR = &m.MTTR
+	}
+	return r
+}
+
+// runTrend is the durations of runs listed newest first, oldest first.
+func runTrend(runs []apiclient.InsightsRun) []int64 {
+	trend := make([]int64, len(runs))
+	for i, r := range runs {
+		trend[len(runs)-1-i] = r.Duration
+	}
+	return trend
+}
+
+func (o insightsOutput) branchText() string {
+	switch {
+	case o.AllBranches:
+		return "all branches"
+	case o.Branch != "":
+		return o.Branch
+	default:
+		return "default branch"
+	}
+}
+
+// summaryMarkdown renders o as a header and a table with one row per item,
+// under the column heading name. trendNote explains what the Trend column
+// plots.
+func summaryMarkdown(title, name string, o insightsOutput, w window, trendNote string) string {
+	var md strings.Builder
+	_, _ = fmt.Fprintf(&md, "# %s\n", title)
+	_, _ = fmt.Fprintf(&md, "- Project: %s\n", o.Project)
+	if o.Workflow != "" {
+		_, _ = fmt.Fprintf(&md, "- Workflow: %s\n", o.Workflow)
+	}
+	if name != "Branch" {
+		_, _ = fmt.Fprintf(&md, "- Branch: %s\n", o.branchText())
+	}
+	_, _ = fmt.Fprintf(&md, "- Window: %s\n\n", w.text)
+
+	headers := []string{name, "Runs", "Success", "Throughput", "p50", "p95"}
+	withMTTR := len(o.Items) > 0 && o.Items[0].MTTR != nil
+	if withMTTR {
+		headers = append(headers, "MTTR")
+	}
+	headers = append(headers, "Credits", "Trend")
+	t := mdtable.New(headers...)
+	for _, r := range o.Items {
+		cells := []string{
+			r.Name,
+			strconv.Itoa(r.TotalRuns),
+			fmt.Sprintf("%.1f%%", r.SuccessRate*100),
+			fmt.Sprintf("%.1f/day", r.Throughput),
+			formatSeconds(r.DurationP50),
+			formatSeconds(r.DurationP95),
+		}
+		if withMTTR {
+			cells = append(cells, formatSeconds(*r.MTTR))
+		}
+		cells = append(cells, strconv.FormatInt(r.CreditsUsed, 10), sparkline(r.Trend))
+		t.Row(cells...)
+	}
+	md.WriteString(t.Render())
+	md.WriteString("\n" + trendNote + "\n")
+	return md.String()
+}
+
+// sparkline is a Trend cell, scaled to the series' own peak so the shape
+// shows even when every value is close to the others.
+func sparkline(trend []int64) string {
+	if len(trend) < 2 {
+		return "-"
+	}
+	values := make([]float64, len(trend))
+	for i, v := range trend {
+		values[i] = float64(v)
+	}
+	return "`" + components.Sparkline(components.DownsamplePeaks(values, trendRuns), 0) + "`"
+}
+
+// formatSeconds renders a duration Insights reports in whole seconds. Zero is
+// shown as "-": a workflow with no failures has no time to recovery.
+func formatSeconds(s int64) string {
+	if s <= 0 {
+		return "-"
+	}
+	d := time.Duration(s) * time.Second
+	h, m, sec := int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60
+	switch {
+	case h > 0:
+		return fmt.Sprintf("%dh%dm", h, m)
+	case m > 0:
+		return fmt.Sprintf("%dm%ds", m, sec)
+	default:
+		return fmt.Sprintf("%ds", sec)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package insights
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+)
+
+func TestFormatSeconds(t *testing.T) {
+	tests := []struct {
+		seconds int64
+		want    string
+	}{
+		{0, "-"},
+		{42, "42s"},
+		{252, "4m12s"},
+		{3720, "1h2m"},
+	}
+	for _, tc := range tests {
+		assert.Check(t, cmp.Equal(formatSeconds(tc.seconds), tc.want))
+	}
+}
+
+func TestParseWindow(t *testing.T) {
+	w, err := parseWindow("7d")
+	assert.NilError(t, err)
+	assert.Check(t, cmp.Equal(w.api, apiclient.InsightsLast7Days))
+	assert.Check(t, cmp.Equal(granularity(w), apiclient.InsightsDaily))
+
+	w, err = parseWindow("24h")
+	assert.NilError(t, err)
+	assert.Check(t, cmp.Equal(granularity(w), apiclient.InsightsHourly))
+
+	_, err = parseWindow("14d")
+	assert.Check(t, cmp.ErrorContains(err, "--window must be one of 24h, 7d, 30d, 60d, 90d"))
+}
+
+func TestJobTrends(t *testing.T) {
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	bucket := func(name string, days, runs int, median int64) apiclient.InsightsJobBucket {
+		var b apiclient.InsightsJobBucket
+		b.Name = name
+		b.Timestamp = day.AddDate(0, 0, days)
+		b.Metrics.TotalRuns = runs
+		b.Metrics.DurationMetrics.Median = median
+		return b
+	}
+
+	trends := jobTrends([]apiclient.InsightsJobBucket{
+		bucket("test", 2, 4, 300),
+		bucket("lint", 0, 1, 20),
+		bucket("test", 0, 3, 100),
+		bucket("test", 1, 0, 0),
+	})
+	assert.Check(t, cmp.DeepEqual(trends, map[string][]int64{
+		"test": {100, 300},
+		"lint": {20},
+	}))
+}
+
+func TestRunTrend(t *testing.T) {
+	newestFirst := []apiclient.InsightsRun{{Duration: 30}, {Duration: 20}, {Duration: 10}}
+	assert.Check(t, cmp.DeepEqual(runTrend(newestFirst), []int64{10, 20, 30}))
+}
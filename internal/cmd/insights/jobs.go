@@ -0,0 +1,162 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package insights
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+)
+
+func newJobsCmd() *cobra.Command {
+	var opts scopeOptions
+
+	cmd := &cobra.Command{
+		Use:   "jobs <workflow>",
+		Short: "Show metrics for each job in a workflow",
+		Long: heredoc.Doc(`
+			Show each job's success rate, throughput (runs a day), p50 and p95
+			duration and credits used over the reporting window, with a trend of its
+			daily median duration (hourly for --window 24h). The API keeps no job
+			trend across branches, so --all-branches leaves it out.
+
+			JSON fields: project, workflow, branch, all_branches, window, items (name, success_rate, total_runs, failed_runs, throughput, duration_p50, duration_p95, credits_used, trend); durations in seconds
+		`),
+		Example: heredoc.Doc(`
+			# Jobs in the build workflow on the default branch
+			$ circleci insights jobs build
+
+			# The slowest jobs on a release branch
+			$ circleci insights jobs build --branch release --json \
+			    --jq '.items | sort_by(-.duration_p95) | .[:3][] | .name'
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.RequireArgs(args, "workflow"); err != nil {
+				return err
+			}
+			w, err := opts.validate()
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runJobs(ctx, client, args[0], opts, w)
+		},
+	}
+
+	addScopeFlags(cmd, &opts, true)
+
+	return cmd
+}
+
+func runJobs(ctx context.Context, client *apiclient.Client, workflow string, opts scopeOptions, w window) error {
+	slug, err := cmdutil.ResolveProjectSlug(opts.Project)
+	if err != nil {
+		return err
+	}
+
+	sp := iostream.Spinner(ctx, !opts.JSON, fmt.Sprintf("Fetching job insights for %s", workflow))
+	summaries, err := client.ListJobInsights(ctx, slug, workflow, opts.params(w))
+	var series []apiclient.InsightsJobBucket
+	if err == nil && !opts.AllBranches {
+		end := time.Now().UTC()
+		series, err = client.ListJobInsightsSeries(ctx, slug, workflow, apiclient.InsightsSeriesParams{
+			Branch:      opts.Branch,
+			Granularity: granularity(w),
+			Start:       end.Add(-w.span),
+			End:         end,
+		})
+	}
+	sp.Stop()
+	if err != nil {
+		return workflowErr(err, workflow)
+	}
+
+	trends := jobTrends(series)
+	rows := make([]row, len(summaries))
+	for i, s := range summaries {
+		rows[i] = newRow(s.Name, s.Metrics, false)
+		if t, ok := trends[s.Name]; ok {
+			rows[i].Trend = t
+		}
+	}
+
+	out := insightsOutput{Project: slug, Workflow: workflow, Branch: opts.Branch, AllBranches: opts.AllBranches, Window: w.flag, Items: rows}
+	if opts.JSON {
+		return iostream.PrintJSON(ctx, out)
+	}
+	if len(rows) == 0 {
+		iostream.ErrPrintf(ctx, "No job runs for %s in %s (%s) in the %s.\n", workflow, slug, out.branchText(), w.text)
+		return nil
+	}
+	note := fmt.Sprintf("Trend is each job's %s median duration, oldest first.", granularity(w))
+	if opts.AllBranches {
+		note = "Trends are not available across all branches."
+	}
+	iostream.PrintMarkdown(ctx, summaryMarkdown("Job Insights", "Job", out, w, note))
+	return nil
+}
+
+// granularity is the time-series bucket a window's trend is plotted in: a day
+// of hourly buckets, or daily buckets for anything longer.
+func granularity(w window) apiclient.InsightsGranularity {
+	if w.span <= 24*time.Hour {
+		return apiclient.InsightsHourly
+	}
+	return apiclient.InsightsDaily
+}
+
+// jobTrends groups a time series by job into each job's median durations,
+// oldest first. Buckets a job did not run in are left out rather than
+// plotted as zero.
+func jobTrends(series []apiclient.InsightsJobBucket) map[string][]int64 {
+	sorted := slices.Clone(series)
+	slices.SortStableFunc(sorted, func(a, b apiclient.InsightsJobBucket) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	trends := map[string][]int64{}
+	for _, b := range sorted {
+		if b.Metrics.TotalRuns == 0 {
+			continue
+		}
+		trends[b.Name] = append(trends[b.Name], b.Metrics.DurationMetrics.Median)
+	}
+	return trends
+}
+
+func workflowErr(err error, workflow string) error {
+	return cmdutil.APIErr(err, workflow, "workflow.not_found", "No insights found for workflow %q.",
+		"List the project's workflows with: circleci insights workflows")
+}
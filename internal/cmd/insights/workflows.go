@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package insights
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+)
+
+func newWorkflowsCmd() *cobra.Command {
+	var opts scopeOptions
+
+	cmd := &cobra.Command{
+		Use:   "workflows",
+		Short: "Show metrics for each of a project's workflows",
+		Long: heredoc.Doc(`
+			Show each workflow's success rate, throughput (runs a day), p50 and p95
+			duration, mean time to recovery and credits used over the reporting
+			window, with a trend of its last 30 runs' durations.
+
+			Metrics cover the project's default branch unless --branch or
+			--all-branches says otherwise.
+
+			JSON fields: project, branch, all_branches, window, items (name, success_rate, total_runs, failed_runs, throughput, duration_p50, duration_p95, mttr, credits_used, trend); durations in seconds
+		`),
+		Example: heredoc.Doc(`
+			# Workflows on the default branch over the last 30 days
+			$ circleci insights workflows
+
+			# Every branch over the last week
+			$ circleci insights workflows --project gh/acme/api --all-branches --window 7d
+
+			# Workflows succeeding less than 90% of the time
+			$ circleci insights workflows --json --jq '.items[] | select(.success_rate < 0.9) | .name'
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			w, err := opts.validate()
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runWorkflows(ctx, client, opts, w)
+		},
+	}
+
+	addScopeFlags(cmd, &opts, true)
+
+	return cmd
+}
+
+func runWorkflows(ctx context.Context, client *apiclient.Client, opts scopeOptions, w window) error {
+	slug, err := cmdutil.ResolveProjectSlug(opts.Project)
+	if err != nil {
+		return err
+	}
+
+	sp := iostream.Spinner(ctx, !opts.JSON, fmt.Sprintf("Fetching workflow insights for %s", slug))
+	summaries, err := client.ListWorkflowInsights(ctx, slug, opts.params(w))
+	if err != nil {
+		sp.Stop()
+		return projectErr(err, slug)
+	}
+	rows := make([]row, len(summaries))
+	for i, s := range summaries {
+		rows[i] = newRow(s.Name, s.Metrics, true)
+	}
+	end := time.Now().UTC()
+	runs := apiclient.InsightsRunParams{Branch: opts.Branch, AllBranches: opts.AllBranches, Start: end.Add(-w.span), End: end}
+	err = bulkhead.Do(ctx, maxFetches, rows, func(r row, i int) error {
+		recent, err := client.ListWorkflowRunInsights(ctx, slug, r.Name, runs, trendRuns)
+		if err != nil {
+			return err
+		}
+		rows[i].Trend = runTrend(recent)
+		return nil
+	})
+	sp.Stop()
+	if err != nil {
+		return projectErr(err, slug)
+	}
+
+	out := insightsOutput{Project: slug, Branch: opts.Branch, AllBranches: opts.AllBranches, Window: w.flag, Items: rows}
+	if opts.JSON {
+		return iostream.PrintJSON(ctx, out)
+	}
+	if len(rows) == 0 {
+		iostream.ErrPrintf(ctx, "No workflow runs for %s (%s) in the %s.\n", slug, out.branchText(), w.text)
+		return nil
+	}
+	iostream.PrintMarkdown(ctx, summaryMarkdown("Workflow Insights", "Workflow", out, w,
+		"Trend is each workflow's duration over its last 30 runs, oldest first."))
+	return nil
+}
+
+func projectErr(err error, slug string) error {
+	return cmdutil.APIErr(err, slug, "project.not_found", "No insights found for project %q.",
+		"Check the project slug and try again",
+		"Use 'circleci project list' to see followed projects")
+}
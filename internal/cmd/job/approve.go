@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+)
+
+func newApproveCmd() *cobra.Command {
+	var (
+		workflow string
+		force    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "approve <job-id|name>",
+		Short: "Approve a job that is on hold",
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Docf(`
+				%[1]s<job-id|name>%[1]s is the UUID of the approval job, or its name in the
+				workflow given by %[1]s--workflow%[1]s.
+			`, "`"),
+			"destructiveHint": "true",
+		},
+		Long: heredoc.Doc(`
+			Approve a pending approval job, letting the jobs that require it run.
+
+			Pass the job's UUID, or its name together with --workflow. Pending
+			approvals across the projects you follow are listed by
+			'circleci my approvals'.
+		`),
+		Example: heredoc.Doc(`
+			# Approve a job by UUID (with confirmation)
+			$ circleci job approve 8e50c384-0083-43d0-bc8f-93f0db589d6b
+
+			# Approve the "hold" job of a workflow without confirmation
+			$ circleci job approve hold --workflow 5034460f-c7c4-4c43-9457-de07e2029e7b --force
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cliErr := cmdutil.RequireArgs(args, "job-id|name"); cliErr != nil {
+				return cliErr
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runApprove(ctx, client, args[0], workflow, force)
+		},
+	}
+
+	cmd.Flags().StringVar(&workflow, "workflow", "", "UUID of the workflow the job belongs to (required for a job name)")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "skip confirmation prompt")
+	return cmd
+}
+
+func runApprove(ctx context.Context, client *apiclient.Client, ref, workflowRef string, force bool) error {
+	var workflowID uuid.UUID
+	if workflowRef != "" {
+		id, err := uuid.Parse(workflowRef)
+		if err != nil {
+			return clierrors.New("job.invalid_workflow", "Invalid workflow ID",
+				fmt.Sprintf("%q is not a workflow UUID.", workflowRef)).
+				WithSuggestions("Workflow IDs are shown in the output of: circleci run get").
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+		workflowID = id
+	} else {
+		jobID, err := uuid.Parse(ref)
+		if err != nil {
+			return clierrors.New("job.approve_requires_workflow", "Workflow required",
+				fmt.Sprintf("%q is not a job UUID; approving a job by name needs its workflow.", ref)).
+				WithSuggestions(
+					fmt.Sprintf("Run: circleci job approve %s --workflow <workflow-id>", ref),
+					"List pending approvals with: circleci my approvals",
+				).
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+		job, err := client.GetJobV3(ctx, jobID)
+		if err != nil {
+			return cmdutil.APIErr(err, ref, "job.not_found", "No job found for %q.")
+		}
+		workflowID = job.WorkflowID
+	}
+
+	jobs, err := client.GetWorkflowJobsV3(ctx, workflowID)
+	if err != nil {
+		return cmdutil.APIErr(err, workflowID.String(), "workflow.not_found", "No workflow found for %q.")
+	}
+	job, cliErr := findApprovalJob(jobs, ref, workflowID)
+	if cliErr != nil {
+		return cliErr
+	}
+
+	if err := cmdutil.ConfirmOrForce(ctx, iostream.Get(ctx), force,
+		fmt.Sprintf("Approve job %q? The jobs that require it will start.", job.Name),
+		clierrors.New("job.approve_aborted", "Approval aborted",
+			"Job approval was not confirmed.").
+			WithExitCode(clierrors.ExitCancelled),
+		clierrors.New("job.approve_requires_force", "Approval requires --force",
+			fmt.Sprintf("Approving job %q will start the jobs that require it.", job.Name)).
+			WithExitCode(clierrors.ExitCancelled),
+	); err != nil {
+		return err
+	}
+
+	if err := client.ApproveJob(ctx, workflowID, job.ID); err != nil {
+		return cmdutil.APIErr(err, job.ID.String(), "job.not_found", "No approval request found for job %q.")
+	}
+
+	iostream.Printf(ctx, "%s Approved job %s (%s)\n", iostream.SymbolOK(ctx), job.Name, job.ID)
+	return nil
+}
+
+// findApprovalJob picks the job ref names — by UUID or by name — out of a
+// workflow's jobs, and checks it is an approval job still awaiting a decision.
+func findApprovalJob(jobs []apiclient.WorkflowJobV3, ref string, workflowID uuid.UUID) (*apiclient.WorkflowJobV3, *clierrors.CLIError) {
+	for i := range jobs {
+		j := &jobs[i]
+		if j.ID.String() != ref && j.Name != ref {
+			continue
+		}
+		if j.Type != apiclient.JobTypeApproval {
+			return nil, clierrors.New("job.not_approval", "Not an approval job",
+				fmt.Sprintf("Job %q is a %s job; only approval jobs can be approved.", j.Name, j.Type)).
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+		if !j.AwaitingApproval() {
+			return nil, clierrors.New("job.approval_not_pending", "Approval not pending",
+				fmt.Sprintf("Job %q has already been approved or cancelled.", j.Name))
+		}
+		return j, nil
+	}
+	return nil, clierrors.New("job.not_found", "Not found",
+		fmt.Sprintf("No job %q found in workflow %s.", ref, workflowID)).
+		WithSuggestions(fmt.Sprintf("List the workflow's jobs with: circleci workflow get %s", workflowID)).
+		WithExitCode(clierrors.ExitNotFound)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package job
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+)
+
+func Test_findApprovalJob(t *testing.T) {
+	holdID := uuid.MustParse("d0000000-0000-4000-8000-000000000001")
+	jobs := []apiclient.WorkflowJobV3{
+		{ID: uuid.MustParse("d0000000-0000-4000-8000-000000000002"), Name: "build", Type: "build", Phase: "ended"},
+		{ID: holdID, Name: "hold", Type: "approval", Phase: "created"},
+		{ID: uuid.MustParse("d0000000-0000-4000-8000-000000000003"), Name: "approved", Type: "approval", Phase: "ended"},
+	}
+	tests := []struct {
+		name     string
+		ref      string
+		wantID   uuid.UUID
+		wantCode string
+	}{
+		{name: "by name", ref: "hold", wantID: holdID},
+		{name: "by id", ref: holdID.String(), wantID: holdID},
+		{name: "not an approval job", ref: "build", wantCode: "job.not_approval"},
+		{name: "already decided", ref: "approved", wantCode: "job.approval_not_pending"},
+		{name: "unknown", ref: "deploy", wantCode: "job.not_found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := findApprovalJob(jobs, tt.ref, uuid.Nil)
+			if tt.wantCode != "" {
+				assert.Assert(t, err != nil)
+				assert.Check(t, cmp.Equal(err.Code, tt.wantCode))
+				return
+			}
+			assert.Assert(t, err == nil)
+			assert.Check(t, cmp.Equal(job.ID, tt.wantID))
+		})
+	}
+}
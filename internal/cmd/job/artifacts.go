@@ -95,7 +95,7 @@ func newArtifactCmd() *cobra.Command {
 
 			if outputDir != "" {
 				sp := iostream.Spinner(ctx, true, fmt.Sprintf("Downloading %d artifact(s) to %q", len(entries), outputDir))
-				dlErr := artifacts.Download(ctx, client, entries, outputDir)
+				_, dlErr := artifacts.Download(ctx, client, entries, outputDir, artifacts.DownloadOptions{Parallel: artifacts.DefaultParallel})
 				sp.Stop()
 				if dlErr != nil {
 					return clierrors.New("artifacts.download_failed", "Download failed", dlErr.Error()).
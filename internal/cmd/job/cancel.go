@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+)
+
+func newCancelCmd() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "cancel <job-id>",
+		Short: "Cancel a running job",
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Docf(`
+				%[1]s<job-id>%[1]s is the UUID of the job to cancel. Job UUIDs are shown in
+				the output of %[1]scircleci workflow get%[1]s and %[1]scircleci run get --json%[1]s.
+			`, "`"),
+			"destructiveHint": "true",
+		},
+		Long: heredoc.Doc(`
+			Cancel a single running CircleCI job.
+
+			The rest of the workflow is not cancelled, but jobs that require the
+			cancelled one will not run. To stop every job, use
+			'circleci workflow cancel'.
+		`),
+		Example: heredoc.Doc(`
+			# Cancel a running job (with confirmation)
+			$ circleci job cancel 8e50c384-0083-43d0-bc8f-93f0db589d6b
+
+			# Cancel without confirmation
+			$ circleci job cancel 8e50c384-0083-43d0-bc8f-93f0db589d6b --force
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cliErr := cmdutil.RequireArgs(args, "job-id"); cliErr != nil {
+				return cliErr
+			}
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return clierrors.New("args.invalid_job_id", "Invalid job ID",
+					fmt.Sprintf("%q is not a valid job UUID.", args[0])).
+					WithExitCode(clierrors.ExitBadArguments)
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+
+			return runCancel(ctx, client, id, force)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "skip confirmation prompt")
+	return cmd
+}
+
+func runCancel(ctx context.Context, client *apiclient.Client, id uuid.UUID, force bool) error {
+	if err := cmdutil.ConfirmOrForce(ctx, iostream.Get(ctx), force,
+		fmt.Sprintf("Cancel job %s?", id),
+		clierrors.New("job.cancel_aborted", "Cancellation aborted",
+			"Job cancellation was not confirmed.").
+			WithExitCode(clierrors.ExitCancelled),
+		clierrors.New("job.cancel_requires_force", "Cancellation requires --force",
+			fmt.Sprintf("Cancelling job %s will stop it and skip the jobs that require it.", id)).
+			WithExitCode(clierrors.ExitCancelled),
+	); err != nil {
+		return err
+	}
+
+	if err := client.CancelJob(ctx, id); err != nil {
+		return cmdutil.APIErr(err, id.String(), "job.not_found", "No job found for %q.")
+	}
+
+	iostream.Printf(ctx, "%s Cancelled job %s\n", iostream.SymbolOK(ctx), id)
+	return nil
+}
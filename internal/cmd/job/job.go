@@ -43,7 +43,9 @@ func NewJobCmd() *cobra.Command {
 	}
 
 	cmdutil.AddGroup(cmd, "Targeted commands",
+		newApproveCmd(),
 		newArtifactCmd(),
+		newCancelCmd(),
 		newGetCmd(),
 		newOpenCmd(),
 		newOutputCmd(),
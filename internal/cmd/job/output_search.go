@@ -0,0 +1,352 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package job
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+)
+
+func newOutputSearchCmd() *cobra.Command {
+	var (
+		execution int
+		flags     SearchFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "search <job-id> <regex>",
+		Short: "Search a job's step output",
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Docf(`
+				%[1]s<job-id>%[1]s is the UUID of the job to search. %[1]s<regex>%[1]s is a Go regular
+				expression matched against each line of each step's output.
+			`, "`"),
+		},
+		Long: heredoc.Doc(`
+			Search the stdout and stderr of every step in every parallel execution
+			of a job, and print matching lines grep-style under a job/exec/step
+			location. Output is replayed through a virtual terminal first, so
+			lines match what a human would have seen.
+
+			JSON fields: pattern, steps_searched, matches[].job/execution/step/step_num/line_number/line/before/after
+		`),
+		Example: heredoc.Doc(`
+			# Which parallel container hit the error?
+			$ circleci job output search 8e50c384-0083-43d0-bc8f-93f0db589d6b 'connection refused'
+
+			# Case-insensitive, with three lines of context
+			$ circleci job output search 8e50c384-0083-43d0-bc8f-93f0db589d6b -i -C 3 'panic:'
+		`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cliErr := cmdutil.RequireArgs(args, "job-id", "regex"); cliErr != nil {
+				return cliErr
+			}
+			jobID, err := uuid.Parse(args[0])
+			if err != nil {
+				return clierrors.New("args.invalid_job_id", "Invalid job ID",
+					fmt.Sprintf("%q is not a valid job UUID.", args[0])).
+					WithExitCode(clierrors.ExitBadArguments)
+			}
+			opts, err := flags.Options(cmd, args[1])
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("execution") {
+				opts.Execution = &execution
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return SearchOutput(ctx, client, []SearchJob{{ID: jobID}}, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&execution, "execution", 0, "Search only this parallel execution (default all)")
+	flags.Add(cmd)
+
+	return cmd
+}
+
+// SearchFlags are the grep-style flags shared by "job output search" and
+// "run grep".
+type SearchFlags struct {
+	before, after, context int
+	ignoreCase             bool
+	jsonOut                bool
+}
+
+// Add registers the flags on cmd.
+func (f *SearchFlags) Add(cmd *cobra.Command) {
+	cmd.Flags().IntVarP(&f.after, "after-context", "A", 0, "Print N lines of output after each match")
+	cmd.Flags().IntVarP(&f.before, "before-context", "B", 0, "Print N lines of output before each match")
+	cmd.Flags().IntVarP(&f.context, "context", "C", 0, "Print N lines before and after each match")
+	cmd.Flags().BoolVarP(&f.ignoreCase, "ignore-case", "i", false, "Match case-insensitively")
+	cmdutil.AddJSONFlag(cmd, &f.jsonOut)
+	cmdutil.AddJQFlag(cmd)
+}
+
+// Options compiles pattern and resolves the context flags. As in grep, -A
+// and -B override -C for their side.
+func (f *SearchFlags) Options(cmd *cobra.Command, pattern string) (SearchOptions, error) {
+	expr := pattern
+	if f.ignoreCase {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return SearchOptions{}, clierrors.New("args.invalid_regex", "Invalid regular expression",
+			fmt.Sprintf("%q is not a valid regular expression: %s", pattern, err)).
+			WithSuggestions("Patterns use Go regexp syntax: https://pkg.go.dev/regexp/syntax").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if f.before < 0 || f.after < 0 || f.context < 0 {
+		return SearchOptions{}, clierrors.New("args.invalid_context", "Invalid context",
+			"Context line counts cannot be negative.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+
+	opts := SearchOptions{Pattern: re, Before: f.context, After: f.context, JSON: f.jsonOut}
+	if cmd.Flags().Changed("before-context") {
+		opts.Before = f.before
+	}
+	if cmd.Flags().Changed("after-context") {
+		opts.After = f.after
+	}
+	return opts, nil
+}
+
+// SearchOptions configures SearchOutput.
+type SearchOptions struct {
+	Pattern       *regexp.Regexp
+	Before, After int
+	// Execution limits the search to one parallel execution; nil searches
+	// them all.
+	Execution *int
+	JSON      bool
+}
+
+// SearchJob is a job to search. Workflow is set when the search spans a run,
+// and prefixes each match's location.
+type SearchJob struct {
+	ID       uuid.UUID
+	Name     string
+	Workflow string
+}
+
+type searchOutput struct {
+	Pattern       string        `json:"pattern"`
+	StepsSearched int           `json:"steps_searched"`
+	Matches       []searchMatch `json:"matches"`
+}
+
+type searchMatch struct {
+	Workflow   string    `json:"workflow,omitempty"`
+	Job        string    `json:"job"`
+	JobID      uuid.UUID `json:"job_id"`
+	Execution  int       `json:"execution"`
+	Step       string    `json:"step"`
+	StepNum    int       `json:"step_num"`
+	LineNumber int       `json:"line_number"`
+	Line       string    `json:"line"`
+	Before     []string  `json:"before"`
+	After      []string  `json:"after"`
+}
+
+// searchUnit is one step of one execution: the granularity output is
+// fetched at.
+type searchUnit struct {
+	job       SearchJob
+	execution int
+	step      apiclient.JobV3Step
+
+	lines []string
+	hits  []int // indexes into lines
+}
+
+func (u *searchUnit) location() string {
+	loc := fmt.Sprintf("%s/%d/%s", u.job.Name, u.execution, u.step.Name)
+	if u.job.Workflow != "" {
+		loc = u.job.Workflow + "/" + loc
+	}
+	return loc
+}
+
+// SearchOutput fetches every started step's output across jobs concurrently
+// and prints the lines matching opts.Pattern, in job, execution and step
+// order. It is exported for "circleci run grep", which searches every job in
+// a run.
+func SearchOutput(ctx context.Context, client *apiclient.Client, jobs []SearchJob, opts SearchOptions) error {
+	sp := iostream.Spinner(ctx, !opts.JSON, "Fetching steps")
+	units, err := searchUnits(ctx, client, jobs, opts.Execution)
+	if err == nil {
+		sp.Stop()
+		sp = iostream.Spinner(ctx, !opts.JSON, fmt.Sprintf("Searching %d steps", len(units)))
+		err = bulkhead.Do(ctx, maxStepOutputFetches, units, func(u *searchUnit, _ int) error {
+			out, err := renderStepOutput(ctx, client, u.job.ID, u.execution, u.step.Num)
+			if err != nil {
+				return cmdutil.APIErr(err, u.job.ID.String(), "job.output_error",
+					"Failed to fetch step output for job %q.")
+			}
+			if out == "" {
+				return nil
+			}
+			u.lines = strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+			for i, l := range u.lines {
+				if opts.Pattern.MatchString(l) {
+					u.hits = append(u.hits, i)
+				}
+			}
+			return nil
+		})
+	}
+	sp.Stop()
+	if err != nil {
+		return err
+	}
+
+	out := searchOutput{Pattern: opts.Pattern.String(), StepsSearched: len(units), Matches: []searchMatch{}}
+	for _, u := range units {
+		for _, h := range u.hits {
+			out.Matches = append(out.Matches, searchMatch{
+				Workflow:   u.job.Workflow,
+				Job:        u.job.Name,
+				JobID:      u.job.ID,
+				Execution:  u.execution,
+				Step:       u.step.Name,
+				StepNum:    u.step.Num,
+				LineNumber: h + 1,
+				Line:       u.lines[h],
+				Before:     u.lines[max(0, h-opts.Before):h],
+				After:      u.lines[h+1 : min(len(u.lines), h+1+opts.After)],
+			})
+		}
+	}
+
+	if opts.JSON {
+		return iostream.PrintJSON(ctx, out)
+	}
+	printSearch(ctx, units, opts)
+	if len(out.Matches) == 0 {
+		iostream.ErrPrintf(ctx, "No matches for %q in %d steps.\n", opts.Pattern.String(), len(units))
+	}
+	return nil
+}
+
+// searchUnits loads each job's detail and lists the steps to search. Steps
+// that never started have no output and are skipped.
+func searchUnits(ctx context.Context, client *apiclient.Client, jobs []SearchJob, execution *int) ([]*searchUnit, error) {
+	details := make([]*apiclient.JobV3, len(jobs))
+	err := bulkhead.Do(ctx, maxStepOutputFetches, jobs, func(j SearchJob, i int) error {
+		d, err := client.GetJobV3(ctx, j.ID)
+		if err != nil {
+			return cmdutil.APIErr(err, j.ID.String(), "job.not_found", "No job found for %q.")
+		}
+		details[i] = d
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var units []*searchUnit
+	for i, d := range details {
+		j := jobs[i]
+		if j.Name == "" {
+			j.Name = d.Name
+		}
+		execs := d.Executions
+		if execution != nil {
+			steps, err := executionSteps(d, *execution)
+			if err != nil {
+				return nil, err
+			}
+			execs = []apiclient.JobV3Execution{{Index: *execution, Steps: steps}}
+		}
+		for _, e := range execs {
+			for _, s := range e.Steps {
+				if s.StartedAt.IsZero() {
+					continue
+				}
+				units = append(units, &searchUnit{job: j, execution: e.Index, step: s})
+			}
+		}
+	}
+	return units, nil
+}
+
+// printSearch prints matches grep-style: a location header per step, then
+// "N:" for matching lines and "N-" for context, with "--" between groups
+// whose context does not touch.
+func printSearch(ctx context.Context, units []*searchUnit, opts SearchOptions) {
+	s := iostream.Get(ctx)
+	var b strings.Builder
+	first := true
+	for _, u := range units {
+		if len(u.hits) == 0 {
+			continue
+		}
+		if !first {
+			b.WriteString("\n")
+		}
+		first = false
+		_, _ = fmt.Fprintf(&b, "%s\n", s.Title(u.location()))
+
+		isHit := map[int]bool{}
+		for _, h := range u.hits {
+			isHit[h] = true
+		}
+		last := -1
+		for _, h := range u.hits {
+			from := max(0, h-opts.Before, last+1)
+			to := min(len(u.lines)-1, h+opts.After)
+			if last >= 0 && from > last+1 {
+				b.WriteString(s.Muted("--") + "\n")
+			}
+			for i := from; i <= to; i++ {
+				if isHit[i] {
+					line := opts.Pattern.ReplaceAllStringFunc(u.lines[i], func(m string) string { return s.Warning(m) })
+					_, _ = fmt.Fprintf(&b, "%s:%s\n", s.Muted(fmt.Sprint(i+1)), line)
+				} else {
+					_, _ = fmt.Fprintf(&b, "%s-%s\n", s.Muted(fmt.Sprint(i+1)), u.lines[i])
+				}
+			}
+			last = max(last, to)
+		}
+	}
+	iostream.Print(ctx, b.String())
+}
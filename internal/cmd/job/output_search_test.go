@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package job
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+)
+
+// TestPrintSearch verifies grep-style context: overlapping context merges,
+// separate groups get a "--" separator, and a match inside another's context
+// is still marked as a match.
+func TestPrintSearch(t *testing.T) {
+	var out bytes.Buffer
+	ctx := iostream.WithStreams(context.Background(), iostream.Streams{Out: &out, Err: &out})
+
+	lines := strings.Split("a\nERR one\nb\nERR two\nc\nd\ne\nf\nERR three\ng", "\n")
+	u := &searchUnit{
+		job:       SearchJob{Name: "test", Workflow: "build"},
+		execution: 2,
+		step:      apiclient.JobV3Step{Name: "Run tests"},
+		lines:     lines,
+		hits:      []int{1, 3, 8},
+	}
+	printSearch(ctx, []*searchUnit{u, {job: SearchJob{Name: "lint"}}}, SearchOptions{
+		Pattern: regexp.MustCompile("ERR"), Before: 1, After: 1,
+	})
+
+	assert.Check(t, cmp.Equal(out.String(), strings.Join([]string{
+		"build/test/2/Run tests",
+		"1-a",
+		"2:ERR one",
+		"3-b",
+		"4:ERR two",
+		"5-c",
+		"--",
+		"8-f",
+		"9:ERR three",
+		"10-g",
+		"",
+	}, "\n")))
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package job
+
+import (
+	"math"
+	"slices"
+)
+
+// resourceClass is one rung of the ladder recommendations move a job along.
+type resourceClass struct {
+	Name             string
+	CPUCount         float64
+	MemoryLimitBytes int64
+	// CreditsPerMinute is the class's list rate per executor-minute.
+	CreditsPerMinute float64
+}
+
+const gib = 1024 * 1024 * 1024
+
+// dockerClasses is the Docker executor's resource class ladder, smallest first,
+// at its published credit rates. Only Docker is covered: it is where nearly all
+// rightsizing happens, and machine and macOS classes share some of its names
+// but not its sizes or rates, so guessing at them would be worse than saying
+// nothing.
+var dockerClasses = []resourceClass{
+	{Name: "small", CPUCount: 1, MemoryLimitBytes: 2 * gib, CreditsPerMinute: 5},
+	{Name: "medium", CPUCount: 2, MemoryLimitBytes: 4 * gib, CreditsPerMinute: 10},
+	{Name: "medium+", CPUCount: 3, MemoryLimitBytes: 6 * gib, CreditsPerMinute: 15},
+	{Name: "large", CPUCount: 4, MemoryLimitBytes: 8 * gib, CreditsPerMinute: 20},
+	{Name: "xlarge", CPUCount: 8, MemoryLimitBytes: 16 * gib, CreditsPerMinute: 40},
+	{Name: "2xlarge", CPUCount: 16, MemoryLimitBytes: 32 * gib, CreditsPerMinute: 80},
+	{Name: "2xlarge+", CPUCount: 20, MemoryLimitBytes: 40 * gib, CreditsPerMinute: 100},
+}
+
+// sizingHeadroom is the margin a recommended class must leave above what the
+// job was seen to use, so one heavier run than those sampled does not tip it
+// over.
+const sizingHeadroom = 1.2
+
+// Recommendation actions.
+const (
+	actionKeep     = "keep"
+	actionDownsize = "downsize"
+	actionUpsize   = "upsize"
+	// actionUnknown is for a class outside dockerClasses, which there is no
+	// ladder to move along.
+	actionUnknown = "unknown"
+)
+
+// OOM risk levels, by how much of the recommended class's memory the job's
+// peak would fill.
+const (
+	oomRiskLow    = "low"
+	oomRiskMedium = "medium"
+	oomRiskHigh   = "high"
+	// oomRiskUnknown is for a class whose memory limit the API did not report.
+	oomRiskUnknown = "unknown"
+)
+
+func dockerClass(name string) (resourceClass, bool) {
+	i := slices.IndexFunc(dockerClasses, func(c resourceClass) bool { return c.Name == name })
+	if i < 0 {
+		return resourceClass{}, false
+	}
+	return dockerClasses[i], true
+}
+
+// fitClass is the smallest class with room for cpu cores and memory bytes,
+// plus headroom. CPU is sized to a high percentile rather than the peak,
+// because running short of it only slows a job down; memory is sized to the
+// peak, because running short of it kills the job. A job too big for every
+// class gets the largest.
+func fitClass(cpu, memory float64) resourceClass {
+	for _, c := range dockerClasses {
+		if c.CPUCount >= cpu*sizingHeadroom && float64(c.MemoryLimitBytes) >= memory*sizingHeadroom {
+			return c
+		}
+	}
+	return dockerClasses[len(dockerClasses)-1]
+}
+
+// oomRisk grades how close peak memory comes to a class's limit.
+func oomRisk(peak float64, c resourceClass) string {
+	switch fill := peak / float64(c.MemoryLimitBytes); {
+	case fill >= 0.9:
+		return oomRiskHigh
+	case fill >= 0.75:
+		return oomRiskMedium
+	default:
+		return oomRiskLow
+	}
+}
+
+// percentile is the nearest-rank p-th percentile of sorted, which must be
+// ascending and non-empty.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank-1, 0)]
+}
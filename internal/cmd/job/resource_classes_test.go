@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package job
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+)
+
+func TestPercentile(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	assert.Check(t, cmp.Equal(percentile(sorted, 50), 5.0))
+	assert.Check(t, cmp.Equal(percentile(sorted, 95), 10.0))
+	assert.Check(t, cmp.Equal(percentile([]float64{3}, 95), 3.0))
+}
+
+func TestFitClass(t *testing.T) {
+	tests := []struct {
+		name   string
+		cpu    float64
+		memory float64
+		want   string
+	}{
+		{name: "idle job fits the smallest class", cpu: 0.1, memory: 256 << 20, want: "small"},
+		{name: "headroom pushes a full core up a class", cpu: 1, memory: 256 << 20, want: "medium"},
+		{name: "memory decides when it needs more than CPU", cpu: 0.5, memory: 5 * gib, want: "medium+"},
+		{name: "peak memory at the limit needs the next class", cpu: 2, memory: 8 * gib, want: "xlarge"},
+		{name: "too big for every class gets the largest", cpu: 64, memory: 128 * gib, want: "2xlarge+"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Check(t, cmp.Equal(fitClass(tc.cpu, tc.memory).Name, tc.want))
+		})
+	}
+}
+
+func TestRecommendClass(t *testing.T) {
+	large := apiclient.JobResourceClass{Name: "large", CPUCount: 4, MemoryLimitBytes: 8 * gib}
+
+	t.Run("downsize saves the rate difference", func(t *testing.T) {
+		rec := recommendClass(large, usagePercentiles{P95: 1.2}, usagePercentiles{Max: 2 * gib}, 3)
+		assert.Check(t, cmp.Equal(rec.Action, actionDownsize))
+		assert.Check(t, cmp.Equal(rec.ResourceClass, "medium"))
+		assert.Check(t, cmp.Equal(*rec.CreditsPerRun, 60.0))
+		assert.Check(t, cmp.Equal(*rec.CreditsSavedPerRun, 30.0))
+		assert.Check(t, cmp.Equal(rec.OOMRisk, oomRiskLow))
+	})
+
+	t.Run("saturated job is upsized at a cost", func(t *testing.T) {
+		rec := recommendClass(large, usagePercentiles{P95: 4}, usagePercentiles{Max: 7 * gib}, 1)
+		assert.Check(t, cmp.Equal(rec.Action, actionUpsize))
+		assert.Check(t, cmp.Equal(rec.ResourceClass, "xlarge"))
+		assert.Check(t, cmp.Equal(*rec.CreditsSavedPerRun, -20.0))
+	})
+
+	t.Run("a job that fits stays put", func(t *testing.T) {
+		rec := recommendClass(large, usagePercentiles{P95: 3}, usagePercentiles{Max: 6 * gib}, 1)
+		assert.Check(t, cmp.Equal(rec.Action, actionKeep))
+		assert.Check(t, cmp.Equal(*rec.CreditsSavedPerRun, 0.0))
+		assert.Check(t, cmp.Equal(rec.OOMRisk, oomRiskMedium))
+	})
+
+	t.Run("a class off the ladder gets only its OOM risk", func(t *testing.T) {
+		mac := apiclient.JobResourceClass{Name: "macos.m1.medium.gen1", CPUCount: 4, MemoryLimitBytes: 6 * gib}
+		rec := recommendClass(mac, usagePercentiles{P95: 1}, usagePercentiles{Max: 5.8 * gib}, 1)
+		assert.Check(t, cmp.Equal(rec.Action, actionUnknown))
+		assert.Check(t, rec.CreditsPerRun == nil)
+		assert.Check(t, cmp.Equal(rec.OOMRisk, oomRiskHigh))
+	})
+}
@@ -46,6 +46,7 @@ func newResourceUsageCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(newResourceUsageGetCmd())
+	cmd.AddCommand(newResourceUsageRecommendCmd())
 
 	return cmd
 }
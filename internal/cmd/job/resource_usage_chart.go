@@ -53,9 +53,9 @@ func usageSeries(m usageMetric, execs []executionUsageOutput) []components.Chart
 //
 // Colors are applied only when the caller has them, and survive the code fence the
 // chart is embedded in — glamour passes escape sequences inside one through
-// untouched.
-func usageChart(series []components.ChartSeries, m usageMetric, duration time.Duration, color bool) string {
-	return components.LineChart{
+// untouched. A step, when given, is marked where it began.
+func usageChart(series []components.ChartSeries, m usageMetric, duration time.Duration, color bool, step *usageStep) string {
+	chart := components.LineChart{
 		Series:  series,
 		Ceiling: m.Ceiling,
 		FormatY: m.FormatTick,
@@ -63,7 +63,14 @@ func usageChart(series []components.ChartSeries, m usageMetric, duration time.Du
 			return usageElapsed(time.Duration(frac * float64(duration)))
 		},
 		Color: color,
-	}.Render()
+	}
+	if step != nil && duration > 0 {
+		chart.Markers = []components.ChartMarker{{
+			At:    float64(step.Offset) / float64(duration),
+			Label: step.Name,
+		}}
+	}
+	return chart.Render()
 }
 
 // usageShape is the summary table's inline sparkline. It is scaled to the series'
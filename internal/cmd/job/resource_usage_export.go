@@ -0,0 +1,184 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package job
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/chartimg"
+)
+
+// validateUsageExport checks --export and --csv against each other and the
+// flags they cannot be combined with. The image format comes from the file
+// extension.
+func validateUsageExport(path string, csvOut, follow, jsonOut, jq bool) error {
+	if path != "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".svg", ".png":
+		default:
+			return clierrors.New("args.invalid_export", "Invalid --export file",
+				fmt.Sprintf("Cannot tell the image format of %q.", path)).
+				WithSuggestions("Name the file with a .svg or .png extension").
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+	}
+	if (path != "" || csvOut) && follow {
+		return clierrors.New("args.conflicting_flags", "Conflicting flags",
+			"--export and --csv cannot be used with --follow; export the job once it has finished.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	// Both write their own output, so neither has JSON for --json or --jq.
+	output := ""
+	switch {
+	case jsonOut:
+		output = "--json"
+	case jq:
+		output = "--jq"
+	}
+	if output != "" && (path != "" || csvOut) {
+		export := "--export"
+		if csvOut {
+			export = "--csv"
+		}
+		return clierrors.New("args.conflicting_flags", "Conflicting flags",
+			fmt.Sprintf("%s and %s cannot be used together.", export, output)).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	return nil
+}
+
+// exportResourceUsage writes a job's usage as an image, as CSV on stdout, or
+// both — for a ticket or a spreadsheet rather than a terminal.
+func exportResourceUsage(ctx context.Context, client *apiclient.Client, jobID uuid.UUID, execution int, path string, csvOut bool) error {
+	u, err := fetchResourceUsage(ctx, client, jobID, execution)
+	if err != nil {
+		return err
+	}
+
+	if path != "" {
+		if err := writeUsageImage(path, usageFigure(u)); err != nil {
+			return clierrors.New("job.export_write_failed", "Could not write chart", err.Error()).
+				WithExitCode(clierrors.ExitGeneralError)
+		}
+		iostream.ErrPrintf(ctx, "%s Wrote CPU and memory charts for %s to %s\n",
+			iostream.SymbolOK(ctx), pluralExecutions(len(u.Executions)), path)
+	}
+	if csvOut {
+		return writeUsageCSV(iostream.Out(ctx), u)
+	}
+	return nil
+}
+
+func writeUsageImage(path string, fig chartimg.Figure) (err error) {
+	f, err := os.Create(path) //#nosec:G304 // path is user-supplied
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	if strings.EqualFold(filepath.Ext(path), ".png") {
+		return fig.WritePNG(f)
+	}
+	return fig.WriteSVG(f)
+}
+
+// usageFigure lays out the same charts the terminal draws: one panel per
+// metric, every execution overlaid and scaled to the resource class limit,
+// with time elapsed along the bottom.
+func usageFigure(u *resourceUsageOutput) chartimg.Figure {
+	subtitle := []string{"Job " + u.ID.String(), resourceClassName(u.ResourceClass)}
+	if interval, ok := commonInterval(u.Executions); ok {
+		subtitle = append(subtitle, "sampled every "+usageDuration(interval))
+	}
+	fig := chartimg.Figure{
+		Title:    "Job Resource Usage",
+		Subtitle: strings.Join(subtitle, " · "),
+	}
+
+	duration := longestDuration(u.Executions)
+	for _, m := range usageMetrics(u.ResourceClass) {
+		p := chartimg.Panel{
+			Title:   m.Title,
+			Ceiling: m.Ceiling,
+			XMax:    duration.Seconds(),
+			FormatX: func(s float64) string { return usageElapsed(time.Duration(s * float64(time.Second))) },
+			FormatY: m.FormatTick,
+		}
+		if m.Ceiling > 0 {
+			p.CeilingLabel = "limit " + m.Limit
+		}
+		for _, e := range u.Executions {
+			p.Series = append(p.Series, chartimg.Series{
+				Name:   "exec " + strconv.Itoa(e.Index),
+				Values: m.Series(e),
+				Step:   (time.Duration(e.IntervalMS) * time.Millisecond).Seconds(),
+			})
+		}
+		fig.Panels = append(fig.Panels, p)
+	}
+	return fig
+}
+
+// writeUsageCSV writes one row per sample, long rather than wide, so
+// executions of different lengths need no padding.
+func writeUsageCSV(w io.Writer, u *resourceUsageOutput) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"execution", "sample", "elapsed_seconds", "cpu_cores", "memory_bytes"})
+	for _, e := range u.Executions {
+		interval := time.Duration(e.IntervalMS) * time.Millisecond
+		for i := range e.Samples {
+			_ = cw.Write([]string{
+				strconv.Itoa(e.Index),
+				strconv.Itoa(i),
+				strconv.FormatFloat((time.Duration(i) * interval).Seconds(), 'f', -1, 64),
+				strconv.FormatFloat(e.CPUCores[i], 'f', -1, 64),
+				strconv.FormatInt(e.MemoryBytes[i], 10),
+			})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func pluralExecutions(n int) string {
+	if n == 1 {
+		return "1 execution"
+	}
+	return fmt.Sprintf("%d executions", n)
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/google/uuid"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+	"github.com/CircleCI-Public/circleci-cli/internal/ui"
+)
+
+// usagePollInterval is how often --follow polls. CIRCLE_RESOURCE_USAGE_POLL_MS
+// overrides it for testing.
+func usagePollInterval() time.Duration {
+	if ms := os.Getenv("CIRCLE_RESOURCE_USAGE_POLL_MS"); ms != "" {
+		if n, err := strconv.Atoi(ms); err == nil {
+			return time.Duration(n) * time.Millisecond
+		}
+	}
+	return ui.ResourceUsagePollInterval
+}
+
+// usageStep is the step an execution is running, and how far into the job it
+// began — which is where the charts mark it.
+type usageStep struct {
+	Name   string
+	Offset time.Duration
+}
+
+// stepOf is the step execution index is running, or nil when none is known.
+func (u *resourceUsageOutput) stepOf(index int) *usageStep {
+	if s, ok := u.steps[index]; ok {
+		return &s
+	}
+	return nil
+}
+
+// firstStep is the step an overlaid chart marks. One marker per execution
+// would bury the lines it is drawn behind, so it is the lowest-numbered
+// execution's.
+func (u *resourceUsageOutput) firstStep() *usageStep {
+	for _, e := range u.Executions {
+		if s := u.stepOf(e.Index); s != nil {
+			return s
+		}
+	}
+	return nil
+}
+
+// usageSample is one line of --follow's JSONL output: a single sample from
+// one execution, with the step that was running when it was taken.
+type usageSample struct {
+	Execution      int     `json:"execution"`
+	Sample         int     `json:"sample"`
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+	CPUCores       float64 `json:"cpu_cores"`
+	MemoryBytes    int64   `json:"memory_bytes"`
+	Step           string  `json:"step,omitempty"`
+}
+
+// usagePoll is one poll of a followed job. usage is nil until the job has
+// recorded its first sample.
+type usagePoll struct {
+	job   *apiclient.JobV3
+	usage *resourceUsageOutput
+}
+
+func (p usagePoll) done() bool {
+	return p.job.Phase == apiclient.PhaseEnded
+}
+
+// followResourceUsage polls a job until it ends: a live report in an
+// interactive terminal, and a JSONL stream of new samples everywhere else, so
+// a pipe gets something it can consume line by line.
+func followResourceUsage(ctx context.Context, client *apiclient.Client, jobID uuid.UUID, execution int, chartMode string, jsonOut bool) error {
+	if jsonOut || !iostream.IsInteractive(ctx) {
+		return followUsageJSONL(ctx, client, jobID, execution)
+	}
+	return followUsageLive(ctx, client, jobID, execution, chartMode)
+}
+
+func followUsageLive(ctx context.Context, client *apiclient.Client, jobID uuid.UUID, execution int, chartMode string) error {
+	color := iostream.ColorEnabled(ctx)
+	model := ui.NewResourceUsageFollow(ctx, ui.ResourceUsageFollowOptions{
+		JobID:        jobID,
+		Color:        color,
+		Animate:      iostream.SpinnerEnabled(ctx),
+		PollInterval: usagePollInterval(),
+		Fetch: func(ctx context.Context) (func(int) string, bool, error) {
+			poll, err := fetchUsagePoll(ctx, client, jobID, execution)
+			if err != nil {
+				return nil, false, err
+			}
+			md := "# Job Resource Usage\n\nNo samples yet — the job has not been running long enough.\n"
+			if poll.usage != nil {
+				md = resourceUsageMarkdown(poll.usage, chartMode, color)
+			}
+			return func(width int) string { return iostream.RenderMarkdownAt(ctx, md, width) }, poll.done(), nil
+		},
+	})
+
+	final, err := tea.NewProgram(model,
+		tea.WithContext(ctx),
+		tea.WithInput(iostream.In(ctx)),
+		tea.WithOutput(iostream.Err(ctx)),
+	).Run()
+	if err != nil {
+		if errors.Is(err, tea.ErrInterrupted) || errors.Is(err, tea.ErrProgramKilled) ||
+			errors.Is(err, context.Canceled) {
+			return followInterrupted(jobID)
+		}
+		return clierrors.New("job.follow_display_failed", "Failed to display resource usage",
+			err.Error()).WithExitCode(clierrors.ExitGeneralError)
+	}
+
+	res := final.(ui.ResourceUsageFollowModel).Result()
+	switch {
+	case res.Cancelled:
+		return followInterrupted(jobID)
+	case res.Err != nil:
+		var cliErr *clierrors.CLIError
+		if errors.As(res.Err, &cliErr) {
+			return cliErr
+		}
+		return clierrors.New("api.error", "API error while following job", res.Err.Error()).
+			WithExitCode(clierrors.ExitAPIError)
+	}
+	return nil
+}
+
+// followUsageJSONL writes each sample once, as soon as a poll first sees it.
+// It writes straight to stdout rather than through PrintJSONStream, which
+// holds everything back for --jq to see the whole stream — no use to a
+// consumer watching memory climb.
+func followUsageJSONL(ctx context.Context, client *apiclient.Client, jobID uuid.UUID, execution int) error {
+	enc := json.NewEncoder(iostream.Out(ctx))
+	enc.SetEscapeHTML(false)
+	emitted := map[int]int{}
+	interval := usagePollInterval()
+
+	for {
+		poll, err := fetchUsagePoll(ctx, client, jobID, execution)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return followInterrupted(jobID)
+			}
+			return err
+		}
+		for _, s := range newUsageSamples(poll, emitted) {
+			if err := enc.Encode(s); err != nil {
+				return err
+			}
+		}
+		if poll.done() {
+			return nil
+		}
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return followInterrupted(jobID)
+		case <-t.C:
+		}
+	}
+}
+
+// newUsageSamples returns the samples a poll holds past those already
+// emitted, and advances emitted to match.
+func newUsageSamples(poll usagePoll, emitted map[int]int) []usageSample {
+	if poll.usage == nil {
+		return nil
+	}
+	var out []usageSample
+	for _, e := range poll.usage.Executions {
+		interval := time.Duration(e.IntervalMS) * time.Millisecond
+		for i := emitted[e.Index]; i < e.Samples; i++ {
+			elapsed := time.Duration(i) * interval
+			out = append(out, usageSample{
+				Execution:      e.Index,
+				Sample:         i,
+				ElapsedSeconds: elapsed.Seconds(),
+				CPUCores:       e.CPUCores[i],
+				MemoryBytes:    e.MemoryBytes[i],
+				Step:           stepAt(poll.job, e.Index, elapsed),
+			})
+		}
+		emitted[e.Index] = max(emitted[e.Index], e.Samples)
+	}
+	return out
+}
+
+// fetchUsagePoll reads the job and its usage so far. A running job that has
+// not been sampled yet has no usage to report, which is not an error until the
+// job ends without any.
+func fetchUsagePoll(ctx context.Context, client *apiclient.Client, jobID uuid.UUID, execution int) (usagePoll, error) {
+	job, err := client.GetJobV3(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return usagePoll{}, err
+		}
+		return usagePoll{}, resourceUsageErr(err, jobID)
+	}
+	poll := usagePoll{job: job}
+
+	usage, err := client.GetJobResourceUsage(ctx, jobID)
+	switch {
+	case err != nil && httpcl.HasStatusCode(err, http.StatusNotFound) && !poll.done():
+		return poll, nil
+	case err != nil:
+		if errors.Is(err, context.Canceled) {
+			return usagePoll{}, err
+		}
+		return usagePoll{}, resourceUsageErr(err, jobID)
+	}
+
+	if execution != allExecutions && !poll.done() && !hasExecution(usage, execution) {
+		// A parallel execution can start sampling later than the others.
+		return poll, nil
+	}
+	poll.usage, err = resourceUsageFrom(usage, execution)
+	if err != nil {
+		return usagePoll{}, err
+	}
+	if !poll.done() {
+		poll.usage.steps = runningSteps(job)
+	}
+	return poll, nil
+}
+
+func hasExecution(usage *apiclient.JobResourceUsage, index int) bool {
+	for _, e := range usage.Executions {
+		if e.Index == index {
+			return true
+		}
+	}
+	return false
+}
+
+// runningSteps finds the step each execution is in the middle of, by
+// execution index. A step that has started and not stopped is running; if
+// the API lists more than one, the latest to start is the current one.
+func runningSteps(job *apiclient.JobV3) map[int]usageStep {
+	steps := map[int]usageStep{}
+	if job.StartedAt.IsZero() {
+		return steps
+	}
+	for _, ex := range job.Executions {
+		for _, st := range ex.Steps {
+			if st.StartedAt.IsZero() || st.StoppedAt != nil || st.Phase == apiclient.PhaseEnded {
+				continue
+			}
+			offset := st.StartedAt.Sub(job.StartedAt)
+			if cur, ok := steps[ex.Index]; ok && cur.Offset > offset {
+				continue
+			}
+			steps[ex.Index] = usageStep{Name: st.Name, Offset: offset}
+		}
+	}
+	return steps
+}
+
+// stepAt names the step execution index was running elapsed into the job, or
+// "" when no step covers that moment.
+func stepAt(job *apiclient.JobV3, index int, elapsed time.Duration) string {
+	if job.StartedAt.IsZero() {
+		return ""
+	}
+	at := job.StartedAt.Add(elapsed)
+	name := ""
+	for _, ex := range job.Executions {
+		if ex.Index != index {
+			continue
+		}
+		for _, st := range ex.Steps {
+			if st.StartedAt.IsZero() || at.Before(st.StartedAt) {
+				continue
+			}
+			if st.StoppedAt != nil && !at.Before(*st.StoppedAt) {
+				continue
+			}
+			name = st.Name
+		}
+	}
+	return name
+}
+
+func followInterrupted(jobID uuid.UUID) *clierrors.CLIError {
+	return clierrors.New("job.follow_interrupted", "Stopped following job",
+		fmt.Sprintf("Stopped following job %s before it finished. The job is still running in CircleCI.", jobID)).
+		WithExitCode(clierrors.ExitCancelled)
+}
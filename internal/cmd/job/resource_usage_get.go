@@ -71,6 +71,10 @@ type resourceUsageOutput struct {
 	ID            uuid.UUID                  `json:"id"`
 	ResourceClass apiclient.JobResourceClass `json:"resource_class"`
 	Executions    []executionUsageOutput     `json:"executions"`
+
+	// steps is the step each execution is running, by execution index. Only a
+	// followed job has any; the charts mark where each began.
+	steps map[int]usageStep
 }
 
 // executionUsageOutput is one parallel execution's series, plus the summary
@@ -101,9 +105,12 @@ type metricStats struct {
 
 func newResourceUsageGetCmd() *cobra.Command {
 	var (
-		execution int
-		chartMode string
-		jsonOut   bool
+		execution  int
+		chartMode  string
+		follow     bool
+		exportPath string
+		csvOut     bool
+		jsonOut    bool
 	)
 
 	cmd := &cobra.Command{
@@ -121,6 +128,7 @@ func newResourceUsageGetCmd() *cobra.Command {
 			Parallel executions are overlaid in color, or charted apart past 5 (--chart).
 
 			JSON fields: id, resource_class.name/cpu_count/memory_limit_bytes, executions[].execution/interval_ms/samples/duration_seconds/cpu_cores/memory_bytes/network_rx_bytes/network_tx_bytes/cpu.min/mean/max/peak_percent_of_limit (and memory.*)
+			--follow JSONL fields, a record per sample: execution, sample, elapsed_seconds, cpu_cores, memory_bytes, step
 		`),
 		Example: heredoc.Doc(`
 			# Chart a job's CPU and memory usage
@@ -150,17 +158,29 @@ func newResourceUsageGetCmd() *cobra.Command {
 			if err := validateChartMode(chartMode); err != nil {
 				return err
 			}
+			if err := validateUsageExport(exportPath, csvOut, follow, jsonOut, cmd.Flags().Changed("jq")); err != nil {
+				return err
+			}
 			ctx := cmd.Context()
 			client, err := cmdutil.LoadClient(ctx)
 			if err != nil {
 				return err
 			}
+			if follow {
+				return followResourceUsage(ctx, client, jobID, execution, chartMode, jsonOut)
+			}
+			if exportPath != "" || csvOut {
+				return exportResourceUsage(ctx, client, jobID, execution, exportPath, csvOut)
+			}
 			return runResourceUsageGet(ctx, client, jobID, execution, chartMode, jsonOut)
 		},
 	}
 
 	cmd.Flags().IntVar(&execution, "execution", allExecutions, "Parallel execution index to report on (default all)")
 	cmd.Flags().StringVar(&chartMode, "chart", chartAuto, "Plot parallel executions together or apart: auto|combined|separate")
+	cmd.Flags().BoolVar(&follow, "follow", false, "Poll a running job and redraw as it goes, marking the current step; JSONL samples when piped")
+	cmd.Flags().StringVar(&exportPath, "export", "", "Write the charts to an image file instead: chart.svg or chart.png")
+	cmd.Flags().BoolVar(&csvOut, "csv", false, "Write every sample as CSV instead")
 	cmdutil.AddJSONFlag(cmd, &jsonOut)
 	cmdutil.AddJQFlag(cmd)
 
@@ -218,12 +238,21 @@ func ResourceUsageMarkdown(ctx context.Context, client *apiclient.Client, jobID
 func fetchResourceUsage(ctx context.Context, client *apiclient.Client, jobID uuid.UUID, execution int) (*resourceUsageOutput, error) {
 	usage, err := client.GetJobResourceUsage(ctx, jobID)
 	if err != nil {
-		return nil, cmdutil.APIErr(err, jobID.String(), "job.resource_usage_not_found",
-			"No resource usage recorded for job %q.",
-			"Usage is only recorded for jobs that ran an executor — an approval job, or one cancelled before it started, has none.",
-			"Check the job exists with: circleci job get "+jobID.String())
+		return nil, resourceUsageErr(err, jobID)
 	}
+	return resourceUsageFrom(usage, execution)
+}
+
+func resourceUsageErr(err error, jobID uuid.UUID) error {
+	return cmdutil.APIErr(err, jobID.String(), "job.resource_usage_not_found",
+		"No resource usage recorded for job %q.",
+		"Usage is only recorded for jobs that ran an executor — an approval job, or one cancelled before it started, has none.",
+		"Check the job exists with: circleci job get "+jobID.String())
+}
 
+// resourceUsageFrom adapts the API's usage to the output shape, keeping only
+// the selected execution unless execution is allExecutions.
+func resourceUsageFrom(usage *apiclient.JobResourceUsage, execution int) (*resourceUsageOutput, error) {
 	execs := usage.Executions
 	if execution != allExecutions {
 		found := false
@@ -406,7 +435,7 @@ func resourceUsageMarkdown(u *resourceUsageOutput, chartMode string, color bool)
 			} else {
 				_, _ = fmt.Fprintf(&md, "\n## Execution %d\n", e.Index)
 			}
-			md.WriteString(executionUsageMarkdown(e, u.ResourceClass, color, uniform))
+			md.WriteString(executionUsageMarkdown(e, u.ResourceClass, color, uniform, u.stepOf(e.Index)))
 		}
 	}
 
@@ -494,7 +523,7 @@ func combinedUsageMarkdown(u *resourceUsageOutput, color, headerInterval bool) s
 			table.Row(append([]string{strconv.Itoa(e.Index)}, statsCells(m.Stats(e), m.Format, m.Series(e))...)...)
 		}
 		md.WriteString(table.Render())
-		if block := usageChartBlock(usageSeries(m, u.Executions), m, longestDuration(u.Executions), color); block != "" {
+		if block := usageChartBlock(usageSeries(m, u.Executions), m, longestDuration(u.Executions), color, u.firstStep()); block != "" {
 			md.WriteString("\n" + block)
 		}
 	}
@@ -505,8 +534,8 @@ func combinedUsageMarkdown(u *resourceUsageOutput, color, headerInterval bool) s
 // executionUsageMarkdown renders one execution: its sampling metadata, a summary
 // table with a row per metric, and a chart per metric. headerInterval reports that
 // the header already stated the sampling interval, in which case the sample count
-// here does not repeat it.
-func executionUsageMarkdown(e executionUsageOutput, rc apiclient.JobResourceClass, color, headerInterval bool) string {
+// here does not repeat it. step, when set, is marked on the charts.
+func executionUsageMarkdown(e executionUsageOutput, rc apiclient.JobResourceClass, color, headerInterval bool, step *usageStep) string {
 	var md strings.Builder
 
 	interval := time.Duration(e.IntervalMS) * time.Millisecond
@@ -546,7 +575,7 @@ func executionUsageMarkdown(e executionUsageOutput, rc apiclient.JobResourceClas
 			title = metricPeakTitle(m.Title, stats, m.Format, m.Limit)
 		}
 		_, _ = fmt.Fprintf(&md, "\n### %s\n\n", title)
-		md.WriteString(usageChartBlock(usageSeries(m, []executionUsageOutput{e}), m, duration, color))
+		md.WriteString(usageChartBlock(usageSeries(m, []executionUsageOutput{e}), m, duration, color, step))
 	}
 
 	return md.String()
@@ -599,8 +628,8 @@ func metricPeakTitle(name string, s *metricStats, format func(float64) string, l
 // reproduces its grid verbatim — and passes the per-series colors through —
 // rather than reflowing it as prose. A chart with no samples yields nothing at
 // all, so the caller does not have to guard for it.
-func usageChartBlock(series []components.ChartSeries, m usageMetric, duration time.Duration, color bool) string {
-	plot := usageChart(series, m, duration, color)
+func usageChartBlock(series []components.ChartSeries, m usageMetric, duration time.Duration, color bool, step *usageStep) string {
+	plot := usageChart(series, m, duration, color, step)
 	if plot == "" {
 		return ""
 	}
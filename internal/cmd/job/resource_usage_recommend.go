@@ -0,0 +1,467 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package job
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/clikit/mdtable"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+)
+
+const (
+	defaultRecommendRuns = 10
+	maxRecommendRuns     = 100
+	// recommendSearchDays is how far back the run search reaches.
+	recommendSearchDays = 90
+	// recommendScanFactor bounds the scan at this many times --runs runs. A job
+	// that only runs on one commit in ten would otherwise page back through
+	// months of history looking for enough of itself.
+	recommendScanFactor = 3
+	// maxUsageFetches bounds how many jobs' usage is fetched at once. A scan
+	// can sample up to maxRecommendRuns runs of several jobs each, which would
+	// otherwise open a connection per job.
+	maxUsageFetches = 8
+)
+
+type recommendOptions struct {
+	Project string
+	JobName string
+	Runs    int
+	JSON    bool
+}
+
+// recommendOutput is the typed output of "circleci job resource-usage
+// recommend".
+type recommendOutput struct {
+	Project     string              `json:"project"`
+	RunsScanned int                 `json:"runs_scanned"`
+	Jobs        []jobRecommendation `json:"jobs"`
+}
+
+// jobRecommendation is one job's usage across the runs sampled, and the class
+// it should run on. CPU is in cores and memory in bytes.
+type jobRecommendation struct {
+	Job            string                     `json:"job"`
+	Runs           int                        `json:"runs"`
+	Samples        int                        `json:"samples"`
+	ResourceClass  apiclient.JobResourceClass `json:"resource_class"`
+	CPU            usagePercentiles           `json:"cpu"`
+	Memory         usagePercentiles           `json:"memory"`
+	Recommendation classRecommendation        `json:"recommendation"`
+}
+
+type usagePercentiles struct {
+	P50 float64 `json:"p50"`
+	P95 float64 `json:"p95"`
+	Max float64 `json:"max"`
+}
+
+// classRecommendation is the class a job fits. The credit figures are per run,
+// from the executor-minutes sampled, and are omitted for a class with no known
+// rate. OOMRisk is graded against the recommended class, or the current one
+// when there is nothing to recommend.
+type classRecommendation struct {
+	Action                   string   `json:"action"`
+	ResourceClass            string   `json:"resource_class,omitempty"`
+	CPUCount                 float64  `json:"cpu_count,omitempty"`
+	MemoryLimitBytes         int64    `json:"memory_limit_bytes,omitempty"`
+	MinutesPerRun            float64  `json:"minutes_per_run"`
+	CreditsPerRun            *float64 `json:"credits_per_run,omitempty"`
+	RecommendedCreditsPerRun *float64 `json:"recommended_credits_per_run,omitempty"`
+	CreditsSavedPerRun       *float64 `json:"credits_saved_per_run,omitempty"`
+	OOMRisk                  string   `json:"oom_risk"`
+}
+
+func newResourceUsageRecommendCmd() *cobra.Command {
+	var opts recommendOptions
+
+	cmd := &cobra.Command{
+		Use:   "recommend",
+		Short: "Recommend resource classes from recent usage",
+		Long: heredoc.Doc(`
+			Pool each job's CPU and memory samples over its last N runs and suggest the
+			smallest Docker class that fits: CPU to p95, memory to peak, each with 20%
+			headroom. Credit estimates use list rates over the minutes sampled.
+
+			JSON fields: project, runs_scanned, jobs[].job/runs/samples/resource_class/cpu.p50/p95/max/memory.*/recommendation.action/resource_class/minutes_per_run/credits_per_run/recommended_credits_per_run/credits_saved_per_run/oom_risk
+		`),
+		Example: heredoc.Doc(`
+			# Every job in the current project, over its last 10 runs
+			$ circleci job resource-usage recommend
+
+			# One job, over more history
+			$ circleci job resource-usage recommend --project gh/acme/api --job-name test --runs 30
+
+			# Jobs that would save credits on a smaller class
+			$ circleci job resource-usage recommend --json \
+			    | jq '.jobs[] | select(.recommendation.action == "downsize") | .job'
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.Runs < 1 || opts.Runs > maxRecommendRuns {
+				return clierrors.New("args.invalid_runs", "Invalid --runs value",
+					fmt.Sprintf("--runs must be between 1 and %d.", maxRecommendRuns)).
+					WithExitCode(clierrors.ExitBadArguments)
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runResourceUsageRecommend(ctx, client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Project, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
+	cmd.Flags().StringVar(&opts.JobName, "job-name", "", "Only recommend for jobs with this name")
+	cmd.Flags().IntVar(&opts.Runs, "runs", defaultRecommendRuns, "How many recent runs of each job to sample")
+	cmdutil.AddJSONFlag(cmd, &opts.JSON)
+	cmdutil.AddJQFlag(cmd)
+
+	return cmd
+}
+
+func runResourceUsageRecommend(ctx context.Context, client *apiclient.Client, opts recommendOptions) error {
+	slug, err := cmdutil.ResolveProjectSlug(opts.Project)
+	if err != nil {
+		return err
+	}
+	projectID, err := cmdutil.ResolveProjectID(ctx, client, slug, "")
+	if err != nil {
+		return err
+	}
+
+	sp := iostream.Spinner(ctx, !opts.JSON, "Finding recent runs")
+	samples, scanned, err := scanRecentJobs(ctx, client, projectID, opts)
+	if err == nil {
+		sp.Stop()
+		sp = iostream.Spinner(ctx, !opts.JSON, fmt.Sprintf("Fetching usage for %d jobs", len(samples)))
+		err = fetchSampledUsage(ctx, client, samples)
+	}
+	sp.Stop()
+	if err != nil {
+		return err
+	}
+
+	if opts.JobName != "" && len(samples) == 0 {
+		return clierrors.New("job.not_found", "Job not found",
+			fmt.Sprintf("No job named %q ran in the last %d runs of %s.", opts.JobName, scanned, slug)).
+			WithSuggestions("Check the job name against: circleci run get --project " + slug).
+			WithExitCode(clierrors.ExitNotFound)
+	}
+
+	out := recommendOutput{Project: slug, RunsScanned: scanned, Jobs: recommendJobs(samples)}
+	if opts.JSON {
+		return iostream.PrintJSON(ctx, out)
+	}
+	if len(out.Jobs) == 0 {
+		iostream.ErrPrintf(ctx, "No resource usage recorded in the last %d runs of %s.\n", scanned, slug)
+		return nil
+	}
+	iostream.PrintMarkdown(ctx, recommendMarkdown(out))
+	return nil
+}
+
+// sampledJob is one run of a job, and the usage it recorded. usage stays nil
+// for a job the API has no usage for.
+type sampledJob struct {
+	id    uuid.UUID
+	name  string
+	usage *apiclient.JobResourceUsage
+}
+
+// scanRecentJobs walks the project's runs newest first, collecting up to
+// opts.Runs finished runs of each job. It stops once every job seen so far has
+// that many, or the scan bound is reached, and returns how many runs it read.
+func scanRecentJobs(ctx context.Context, client *apiclient.Client, projectID string, opts recommendOptions) ([]*sampledJob, int, error) {
+	var (
+		jobs    []*sampledJob
+		counts  = map[string]int{}
+		scanned int
+	)
+	done := func() bool {
+		if scanned >= opts.Runs*recommendScanFactor {
+			return true
+		}
+		if opts.JobName != "" {
+			return counts[opts.JobName] >= opts.Runs
+		}
+		if scanned < opts.Runs {
+			return false
+		}
+		for _, n := range counts {
+			if n < opts.Runs {
+				return false
+			}
+		}
+		return true
+	}
+
+	now := time.Now().UTC()
+	params := apiclient.RunSearchParams{
+		ProjectIDs: []string{projectID},
+		From:       now.AddDate(0, 0, -recommendSearchDays),
+		To:         now,
+	}
+	err := client.SearchRunsV3Pages(ctx, params, func(runs []apiclient.RunV3) (bool, error) {
+		for _, r := range runs {
+			found, err := finishedJobs(ctx, client, r.ID)
+			if err != nil {
+				return false, err
+			}
+			scanned++
+			for _, j := range found {
+				if opts.JobName != "" && j.name != opts.JobName || counts[j.name] >= opts.Runs {
+					continue
+				}
+				counts[j.name]++
+				jobs = append(jobs, j)
+			}
+			if done() {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, 0, cmdutil.APIErr(err, projectID, "project.not_found", "No runs found for project %q.")
+	}
+	return jobs, scanned, nil
+}
+
+// finishedJobs lists the jobs in a run that ran an executor to the end.
+// Approval jobs never do, and a job still running is still being sampled.
+func finishedJobs(ctx context.Context, client *apiclient.Client, runID uuid.UUID) ([]*sampledJob, error) {
+	workflows, err := client.GetRunWorkflowsV3(ctx, runID)
+	if err != nil {
+		if httpcl.HasStatusCode(err, http.StatusNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var out []*sampledJob
+	for _, wf := range workflows {
+		jobs, err := client.GetWorkflowJobsV3(ctx, wf.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, j := range jobs {
+			if j.Type == apiclient.JobTypeApproval || j.StartedAt == nil || j.Phase != apiclient.PhaseEnded {
+				continue
+			}
+			out = append(out, &sampledJob{id: j.ID, name: j.Name})
+		}
+	}
+	return out, nil
+}
+
+// fetchSampledUsage loads every job's usage concurrently. A job with none
+// recorded — cancelled before its executor started, say — is left without.
+func fetchSampledUsage(ctx context.Context, client *apiclient.Client, jobs []*sampledJob) error {
+	return bulkhead.Do(ctx, maxUsageFetches, jobs, func(j *sampledJob, _ int) error {
+		usage, err := client.GetJobResourceUsage(ctx, j.id)
+		switch {
+		case err != nil && httpcl.HasStatusCode(err, http.StatusNotFound):
+			return nil
+		case err != nil:
+			return resourceUsageErr(err, j.id)
+		}
+		j.usage = usage
+		return nil
+	})
+}
+
+// recommendJobs pools each job's samples and sizes it, in the order jobs were
+// first seen. Only runs on the job's latest class are pooled: usage measured
+// against an old limit says little about the one it has now.
+func recommendJobs(jobs []*sampledJob) []jobRecommendation {
+	var names []string
+	byName := map[string][]*sampledJob{}
+	for _, j := range jobs {
+		if j.usage == nil {
+			continue
+		}
+		if _, ok := byName[j.name]; !ok {
+			names = append(names, j.name)
+		}
+		byName[j.name] = append(byName[j.name], j)
+	}
+
+	out := []jobRecommendation{}
+	for _, name := range names {
+		if r, ok := recommendJob(name, byName[name]); ok {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// recommendJob sizes one job from its runs, newest first. It reports false
+// when none of them recorded a sample.
+func recommendJob(name string, runs []*sampledJob) (jobRecommendation, bool) {
+	rc := runs[0].usage.ResourceClass
+	var (
+		cpu, memory []float64
+		minutes     float64
+		counted     int
+	)
+	for _, j := range runs {
+		if j.usage.ResourceClass.Name != rc.Name {
+			continue
+		}
+		sampled := false
+		for _, e := range j.usage.Executions {
+			n := e.Samples()
+			if n == 0 {
+				continue
+			}
+			sampled = true
+			cpu = append(cpu, e.CPUCores[:n]...)
+			memory = append(memory, bytesToFloats(e.MemoryBytes[:n])...)
+			minutes += e.Duration().Minutes()
+		}
+		if sampled {
+			counted++
+		}
+	}
+	if counted == 0 {
+		return jobRecommendation{}, false
+	}
+	slices.Sort(cpu)
+	slices.Sort(memory)
+
+	r := jobRecommendation{
+		Job:           name,
+		Runs:          counted,
+		Samples:       len(cpu),
+		ResourceClass: rc,
+		CPU:           usagePercentiles{P50: percentile(cpu, 50), P95: percentile(cpu, 95), Max: cpu[len(cpu)-1]},
+		Memory:        usagePercentiles{P50: percentile(memory, 50), P95: percentile(memory, 95), Max: memory[len(memory)-1]},
+	}
+	r.Recommendation = recommendClass(rc, r.CPU, r.Memory, minutes/float64(counted))
+	return r, true
+}
+
+// recommendClass fits a job with the given usage to the Docker ladder, and
+// prices the move at minutesPerRun executor-minutes a run.
+func recommendClass(rc apiclient.JobResourceClass, cpu, memory usagePercentiles, minutesPerRun float64) classRecommendation {
+	rec := classRecommendation{MinutesPerRun: minutesPerRun}
+	current, ok := dockerClass(rc.Name)
+	if !ok {
+		rec.Action = actionUnknown
+		rec.OOMRisk = oomRiskUnknown
+		if rc.MemoryLimitBytes > 0 {
+			rec.OOMRisk = oomRisk(memory.Max, resourceClass{MemoryLimitBytes: rc.MemoryLimitBytes})
+		}
+		return rec
+	}
+
+	target := fitClass(cpu.P95, memory.Max)
+	switch {
+	case target.CreditsPerMinute < current.CreditsPerMinute:
+		rec.Action = actionDownsize
+	case target.CreditsPerMinute > current.CreditsPerMinute:
+		rec.Action = actionUpsize
+	default:
+		rec.Action = actionKeep
+	}
+	rec.ResourceClass = target.Name
+	rec.CPUCount = target.CPUCount
+	rec.MemoryLimitBytes = target.MemoryLimitBytes
+	rec.CreditsPerRun = new(minutesPerRun * current.CreditsPerMinute)
+	rec.RecommendedCreditsPerRun = new(minutesPerRun * target.CreditsPerMinute)
+	rec.CreditsSavedPerRun = new(*rec.CreditsPerRun - *rec.RecommendedCreditsPerRun)
+	rec.OOMRisk = oomRisk(memory.Max, target)
+	return rec
+}
+
+// --- rendering ---
+
+func recommendMarkdown(out recommendOutput) string {
+	var md strings.Builder
+	md.WriteString("# Resource Class Recommendations\n")
+	_, _ = fmt.Fprintf(&md, "- Project: %s\n", out.Project)
+	_, _ = fmt.Fprintf(&md, "- Runs scanned: %d\n\n", out.RunsScanned)
+
+	t := mdtable.New("Job", "Runs", "Class", "CPU p50 / p95 / max", "Memory p50 / p95 / max", "Recommend", "Credits/run", "OOM risk")
+	for _, j := range out.Jobs {
+		t.Row(
+			j.Job,
+			strconv.Itoa(j.Runs),
+			resourceClassName(j.ResourceClass),
+			fmt.Sprintf("%s / %s / %s", formatCores(j.CPU.P50), formatCores(j.CPU.P95), formatCores(j.CPU.Max)),
+			fmt.Sprintf("%s / %s / %s", formatBytes(j.Memory.P50), formatBytes(j.Memory.P95), formatBytes(j.Memory.Max)),
+			recommendCell(j.Recommendation),
+			creditsCell(j.Recommendation),
+			j.Recommendation.OOMRisk,
+		)
+	}
+	md.WriteString(t.Render())
+	md.WriteString("\nCPU is sized to p95 and memory to peak, each with 20% headroom. " +
+		"Credits are Docker list rates over the minutes sampled; a smaller class may run a CPU-bound job for longer.\n")
+	return md.String()
+}
+
+func recommendCell(r classRecommendation) string {
+	switch r.Action {
+	case actionDownsize:
+		return "↓ " + r.ResourceClass
+	case actionUpsize:
+		return "↑ " + r.ResourceClass
+	case actionKeep:
+		return "keep"
+	default:
+		return "- (not a Docker class)"
+	}
+}
+
+// creditsCell shows the per-run credits a move saves, or costs when the job
+// needs a bigger class.
+func creditsCell(r classRecommendation) string {
+	switch {
+	case r.CreditsSavedPerRun == nil:
+		return "-"
+	case *r.CreditsSavedPerRun > 0:
+		return fmt.Sprintf("saves %.1f", *r.CreditsSavedPerRun)
+	case *r.CreditsSavedPerRun < 0:
+		return fmt.Sprintf("costs %.1f more", -*r.CreditsSavedPerRun)
+	default:
+		return fmt.Sprintf("%.1f", *r.CreditsPerRun)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package my
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/clikit/mdtable"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+)
+
+// approvalsParallelism bounds the concurrent project lookups and per-run
+// workflow/job fetches "my approvals" fans out.
+const approvalsParallelism = 8
+
+func newApprovalsCmd() *cobra.Command {
+	var (
+		limit   int
+		jsonOut bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "approvals",
+		Short: "List approval jobs waiting on a decision",
+		Long: heredoc.Doc(`
+			List pending approval jobs across every project you follow.
+
+			Each row is an approval job in an on-hold run; approve one with
+			'circleci job approve <job-id>'.
+
+			JSON: an array of { project, run_id, branch, tag, workflow_id,
+			workflow, job_id, job, created_at }, where created_at is the run's.
+		`),
+		Example: heredoc.Doc(`
+			# List pending approvals
+			$ circleci my approvals
+
+			# Approve every pending "hold" job
+			$ circleci my approvals --json --jq '.[] | select(.job=="hold") | .job_id' | xargs -n1 circleci job approve --force
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runMyApprovals(ctx, client, limit, jsonOut)
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 20, "Maximum number of on-hold runs to check [default: 20]")
+	cmdutil.AddJSONFlag(cmd, &jsonOut)
+	cmdutil.AddJQFlag(cmd)
+
+	return cmd
+}
+
+type approvalEntry struct {
+	Project    string    `json:"project"`
+	RunID      uuid.UUID `json:"run_id"`
+	Branch     string    `json:"branch,omitempty"`
+	Tag        string    `json:"tag,omitempty"`
+	WorkflowID uuid.UUID `json:"workflow_id"`
+	Workflow   string    `json:"workflow"`
+	JobID      uuid.UUID `json:"job_id"`
+	Job        string    `json:"job"`
+	CreatedAt  string    `json:"created_at"`
+}
+
+func runMyApprovals(ctx context.Context, client *apiclient.Client, limit int, jsonOut bool) error {
+	projects, err := followedProjectIDs(ctx, client)
+	if err != nil {
+		return cmdutil.APIErr(err, "your projects",
+			"my.approvals_failed", "Could not list your projects.")
+	}
+
+	entries := []approvalEntry{}
+	if len(projects) > 0 {
+		ids := make([]string, 0, len(projects))
+		for id := range projects {
+			ids = append(ids, id)
+		}
+		slices.Sort(ids)
+		now := time.Now().UTC()
+		runs, err := client.SearchRunsV3(ctx, apiclient.RunSearchParams{
+			ProjectIDs: ids,
+			From:       now.AddDate(0, 0, -90),
+			To:         now,
+			Filter:     apiclient.BuildRunFilter("", apiclient.StatusOnHold),
+			Limit:      limit,
+		})
+		if err != nil {
+			return cmdutil.APIErr(err, "on-hold runs",
+				"my.approvals_failed", "Could not search %s.")
+		}
+		entries, err = pendingApprovals(ctx, client, runs, projects)
+		if err != nil {
+			return cmdutil.APIErr(err, "on-hold runs",
+				"my.approvals_failed", "Could not list the workflows of %s.")
+		}
+	}
+
+	if jsonOut {
+		return iostream.PrintJSON(ctx, entries)
+	}
+
+	if len(entries) == 0 {
+		iostream.ErrPrintln(ctx, "No pending approvals.")
+		return nil
+	}
+
+	printApprovals(ctx, entries)
+	return nil
+}
+
+// followedProjectIDs resolves the projects the user follows to a project UUID
+// → slug map. A followed project the V3 API no longer knows is skipped rather
+// than failing the whole list.
+func followedProjectIDs(ctx context.Context, client *apiclient.Client) (map[string]string, error) {
+	projects, err := client.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]*apiclient.ProjectRef, len(projects))
+	err = bulkhead.Do(ctx, approvalsParallelism, projects, func(p apiclient.Project, i int) error {
+		ref, err := client.GetProjectBySlug(ctx, p.FullSlug())
+		if errors.Is(err, apiclient.ErrProjectNotFound) {
+			return nil
+		}
+		refs[i] = ref
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	slugs := make(map[string]string, len(projects))
+	for i, ref := range refs {
+		if ref != nil {
+			slugs[ref.ID.String()] = projects[i].FullSlug()
+		}
+	}
+	return slugs, nil
+}
+
+// pendingApprovals walks each run's unfinished workflows and collects their
+// approval jobs still awaiting a decision, keeping the runs' order.
+func pendingApprovals(ctx context.Context, client *apiclient.Client, runs []apiclient.RunV3, slugs map[string]string) ([]approvalEntry, error) {
+	perRun := make([][]approvalEntry, len(runs))
+	err := bulkhead.Do(ctx, approvalsParallelism, runs, func(r apiclient.RunV3, i int) error {
+		workflows, err := client.GetRunWorkflowsV3(ctx, r.ID)
+		if err != nil {
+			return err
+		}
+		for _, wf := range workflows {
+			if wf.Phase == apiclient.PhaseEnded {
+				continue
+			}
+			jobs, err := client.GetWorkflowJobsV3(ctx, wf.ID)
+			if err != nil {
+				return err
+			}
+			for _, j := range jobs {
+				if !j.AwaitingApproval() {
+					continue
+				}
+				perRun[i] = append(perRun[i], approvalEntry{
+					Project:    slugs[r.ProjectID.String()],
+					RunID:      r.ID,
+					Branch:     r.Branch,
+					Tag:        r.Tag,
+					WorkflowID: wf.ID,
+					Workflow:   wf.Name,
+					JobID:      j.ID,
+					Job:        j.Name,
+					CreatedAt:  r.CreatedAt.Format("2006-01-02 15:04 UTC"),
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	entries := []approvalEntry{}
+	for _, e := range perRun {
+		entries = append(entries, e...)
+	}
+	return entries, nil
+}
+
+func printApprovals(ctx context.Context, entries []approvalEntry) {
+	var b strings.Builder
+	b.WriteString("# Pending approvals\n\n")
+	table := mdtable.New("Project", "Ref", "Workflow", "Job", "Job ID", "Created")
+	for _, e := range entries {
+		table.Row(
+			e.Project,
+			refDisplay(e.Branch, e.Tag),
+			e.Workflow,
+			e.Job,
+			"`"+e.JobID.String()+"`",
+			e.CreatedAt,
+		)
+	}
+	b.WriteString(table.Render())
+	iostream.PrintMarkdown(ctx, b.String())
+}
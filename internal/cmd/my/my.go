@@ -48,6 +48,7 @@ func NewMyCmd() *cobra.Command {
 	}
 
 	cmdutil.AddGroup(cmd, "General commands",
+		newApprovalsCmd(),
 		newRunsCmd(),
 	)
 
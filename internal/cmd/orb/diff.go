@@ -25,7 +25,6 @@ package orb
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/hexops/gotextdiff"
@@ -125,26 +124,6 @@ func runOrbDiff(ctx context.Context, client *apiclient.Client, orbName, v1, v2 s
 		return nil
 	}
 
-	iostream.Print(ctx, colorizeDiff(fmt.Sprintf("%s", unified), iostream.ColorEnabled(ctx)))
+	iostream.Print(ctx, cmdutil.ColorizeDiff(fmt.Sprintf("%s", unified), iostream.ColorEnabled(ctx)))
 	return nil
 }
-
-func colorizeDiff(diff string, color bool) string {
-	if !color {
-		return diff
-	}
-	lines := strings.Split(diff, "\n")
-	for i, line := range lines {
-		switch {
-		case strings.HasPrefix(line, "--- ") || strings.HasPrefix(line, "+++ "):
-			lines[i] = "\033[33m" + line + "\033[0m" // yellow
-		case strings.HasPrefix(line, "@@ "):
-			lines[i] = "\033[36m" + line + "\033[0m" // cyan
-		case strings.HasPrefix(line, "-"):
-			lines[i] = "\033[31m" + line + "\033[0m" // red
-		case strings.HasPrefix(line, "+"):
-			lines[i] = "\033[32m" + line + "\033[0m" // green
-		}
-	}
-	return strings.Join(lines, "\n")
-}
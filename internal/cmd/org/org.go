@@ -52,6 +52,7 @@ func NewOrgCmd() *cobra.Command {
 	)
 	cmdutil.AddGroup(cmd, "Subcommands",
 		newSettingsCmd(),
+		newUsageCmd(),
 	)
 
 	return cmd
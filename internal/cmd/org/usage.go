@@ -0,0 +1,303 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/usage"
+)
+
+const (
+	usageDateLayout = "2006-01-02"
+	// maxUsageSpan is the longest range one export may cover.
+	maxUsageSpan = 32 * 24 * time.Hour
+	// maxUsageAge is how far back the API keeps usage.
+	maxUsageAge = 365 * 24 * time.Hour
+
+	usagePollInterval    = 5 * time.Second
+	usageMaxPollInterval = 30 * time.Second
+	defaultUsageTimeout  = 30 * time.Minute
+	// usageSummaryTop is how many projects and resource classes a summary lists.
+	usageSummaryTop = 10
+)
+
+func newUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage <command>",
+		Short: "Export an organization's credit usage",
+		Long: heredoc.Doc(`
+			Export an organization's usage data — credits used by each job, with
+			its project, resource class and duration — as CSV.
+		`),
+		RunE:               cmdutil.GroupRunE,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+	}
+
+	cmd.AddCommand(newUsageExportCmd())
+
+	return cmd
+}
+
+type usageExportOptions struct {
+	Org     string
+	From    string
+	To      string
+	Output  string
+	Summary bool
+	Timeout time.Duration
+	JSON    bool
+}
+
+// usageExportOutput is the typed output of "circleci org usage export".
+type usageExportOutput struct {
+	ExportID uuid.UUID      `json:"export_id"`
+	From     string         `json:"from"`
+	To       string         `json:"to"`
+	Files    []string       `json:"files"`
+	Summary  *usage.Summary `json:"summary,omitempty"`
+}
+
+func newUsageExportCmd() *cobra.Command {
+	var opts usageExportOptions
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export credit usage between two dates as CSV",
+		Long: heredoc.Doc(`
+			Request a usage export for the organization, wait for CircleCI to prepare
+			it, then download and decompress its CSV files into --output. Dates are
+			UTC and inclusive; one export covers at most 32 days, within the last
+			year. --summary adds the projects and resource classes that used the
+			most credits.
+
+			JSON fields: export_id, from, to, files, summary (total_credits, rows, projects, resource_classes)
+		`),
+		Example: heredoc.Doc(`
+			# Last month's usage for the current org, with a summary
+			$ circleci org usage export --from 2026-09-01 --to 2026-09-30 --summary
+
+			# Into a directory of its own, for a specific org
+			$ circleci org usage export --org gh/acme --from 2026-09-01 --to 2026-09-30 --output usage/2026-09
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			start, end, err := usageRange(opts.From, opts.To, time.Now().UTC())
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			orgID, err := cmdutil.ResolveOrgSlugOrID(ctx, client, opts.Org, "circleci org usage export")
+			if err != nil {
+				return err
+			}
+			return runUsageExport(ctx, client, orgID, start, end, opts)
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org, cmdutil.OrgFlag{DefaultsToGitRemote: true})
+	cmd.Flags().StringVar(&opts.From, "from", "", "First day to export, as YYYY-MM-DD (required)")
+	cmd.Flags().StringVar(&opts.To, "to", "", "Last day to export, as YYYY-MM-DD (required)")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", ".", "Directory to write the CSV files to")
+	cmd.Flags().BoolVar(&opts.Summary, "summary", false, "Print the top projects and resource classes by credits")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", defaultUsageTimeout, "Maximum time to wait for the export")
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to")
+	cmdutil.AddJSONFlag(cmd, &opts.JSON)
+	cmdutil.AddJQFlag(cmd)
+
+	return cmd
+}
+
+// usageRange turns --from and --to into the export's bounds: from the start
+// of the first day to the end of the last, or now if that is sooner.
+func usageRange(from, to string, now time.Time) (time.Time, time.Time, error) {
+	start, err := time.Parse(usageDateLayout, from)
+	if err != nil {
+		return time.Time{}, time.Time{}, invalidUsageDate("--from", from)
+	}
+	last, err := time.Parse(usageDateLayout, to)
+	if err != nil {
+		return time.Time{}, time.Time{}, invalidUsageDate("--to", to)
+	}
+	end := last.AddDate(0, 0, 1)
+	if end.After(now) {
+		end = now
+	}
+
+	var msg string
+	switch {
+	case last.Before(start):
+		msg = "--to must not be before --from."
+	case !start.Before(now):
+		msg = "--from must be in the past."
+	case end.Sub(start) > maxUsageSpan:
+		msg = "An export covers at most 32 days; split the range into several exports."
+	case now.Sub(start) > maxUsageAge:
+		msg = "Usage is kept for a year; --from must be within the last 365 days."
+	default:
+		return start, end, nil
+	}
+	return time.Time{}, time.Time{}, clierrors.New("args.invalid_range", "Invalid date range", msg).
+		WithExitCode(clierrors.ExitBadArguments)
+}
+
+func invalidUsageDate(flag, value string) *clierrors.CLIError {
+	return clierrors.New("args.invalid_date", "Invalid date",
+		fmt.Sprintf("%s must be a date as YYYY-MM-DD, not %q.", flag, value)).
+		WithExitCode(clierrors.ExitBadArguments)
+}
+
+func runUsageExport(ctx context.Context, client *apiclient.Client, orgID uuid.UUID, start, end time.Time, opts usageExportOptions) error {
+	job, err := client.CreateUsageExport(ctx, orgID, start, end)
+	if err != nil {
+		return usageAPIErr(err, orgID)
+	}
+
+	sp := iostream.Spinner(ctx, !opts.JSON, "Waiting for CircleCI to prepare the usage export")
+	job, err = waitForUsageExport(ctx, client, orgID, job, opts.Timeout)
+	sp.Stop()
+	if err != nil {
+		return err
+	}
+	if job.State == apiclient.UsageExportFailed {
+		reason := job.FailureReason
+		if reason == "" {
+			reason = job.ErrorReason
+		}
+		return clierrors.New("usage.export_failed", "Usage export failed",
+			fmt.Sprintf("Usage export %s failed: %s", job.ID, reason)).
+			WithExitCode(clierrors.ExitGeneralError)
+	}
+
+	sp = iostream.Spinner(ctx, !opts.JSON, fmt.Sprintf("Downloading %d file(s) to %q", len(job.DownloadURLs), opts.Output))
+	files, err := usage.Download(ctx, client, job.DownloadURLs, opts.Output)
+	sp.Stop()
+	if err != nil {
+		return clierrors.New("usage.download_failed", "Download failed", err.Error()).
+			WithExitCode(clierrors.ExitGeneralError)
+	}
+
+	out := usageExportOutput{ExportID: job.ID, From: opts.From, To: opts.To, Files: files}
+	if opts.Summary {
+		s, err := usage.Summarize(files, usageSummaryTop)
+		if err != nil {
+			return clierrors.New("usage.summary_failed", "Could not summarize usage", err.Error()).
+				WithExitCode(clierrors.ExitGeneralError)
+		}
+		out.Summary = &s
+	}
+
+	if opts.JSON {
+		return iostream.PrintJSON(ctx, out)
+	}
+	iostream.ErrPrintf(ctx, "%s Wrote %d CSV file(s) to %s\n", iostream.SymbolOK(ctx), len(files), opts.Output)
+	for _, f := range files {
+		iostream.Printf(ctx, "%s\n", f)
+	}
+	if out.Summary != nil {
+		iostream.Printf(ctx, "\n")
+		iostream.PrintMarkdown(ctx, usage.FormatSummaryMarkdown(*out.Summary))
+	}
+	return nil
+}
+
+// waitForUsageExport polls an export until it has completed or failed, backing
+// off from usagePollInterval to usageMaxPollInterval.
+func waitForUsageExport(ctx context.Context, client *apiclient.Client, orgID uuid.UUID, job *apiclient.UsageExport, timeout time.Duration) (*apiclient.UsageExport, error) {
+	deadline := time.Now().Add(timeout)
+	interval, maxInterval := usagePollIntervals()
+	for !job.Done() {
+		if time.Now().After(deadline) {
+			return nil, clierrors.New("usage.timeout", "Usage export timed out",
+				fmt.Sprintf("Usage export %s was not ready within %s.", job.ID, timeout)).
+				WithSuggestions("Try again with a longer --timeout, or a shorter date range").
+				WithExitCode(clierrors.ExitTimeout)
+		}
+		if err := sleepOrCancel(ctx, interval); err != nil {
+			return nil, usageInterrupted()
+		}
+		interval = min(interval+usagePollInterval, maxInterval)
+
+		next, err := client.GetUsageExport(ctx, orgID, job.ID)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil, usageInterrupted()
+			}
+			return nil, usageAPIErr(err, orgID)
+		}
+		job = next
+	}
+	return job, nil
+}
+
+// usagePollIntervals is the first and longest wait between polls.
+// CIRCLE_USAGE_POLL_MS sets both, so tests need not wait.
+func usagePollIntervals() (time.Duration, time.Duration) {
+	if ms := os.Getenv("CIRCLE_USAGE_POLL_MS"); ms != "" {
+		if n, err := strconv.Atoi(ms); err == nil {
+			d := time.Duration(n) * time.Millisecond
+			return d, d
+		}
+	}
+	return usagePollInterval, usageMaxPollInterval
+}
+
+func sleepOrCancel(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func usageInterrupted() *clierrors.CLIError {
+	return clierrors.New("usage.interrupted", "Export interrupted",
+		"Stopped waiting before the usage export was ready.").
+		WithExitCode(clierrors.ExitCancelled)
+}
+
+func usageAPIErr(err error, orgID uuid.UUID) error {
+	return cmdutil.APIErr(err, orgID.String(), "org.not_found", "No organization found for ID %q.",
+		"Check the organization with: circleci org list")
+}
@@ -95,22 +95,8 @@ func runProjectList(ctx context.Context, client *apiclient.Client, jsonOut bool)
 
 	out := make([]projectOutput, len(projects))
 	for i, p := range projects {
-		slug := p.Slug
-		if slug == "" {
-			// Build slug from parts when the v1.1 API omits the top-level slug field.
-			var vcs string
-			switch p.VCSType {
-			case "github":
-				vcs = "gh"
-			case "bitbucket":
-				vcs = "bb"
-			default:
-				vcs = strings.ToLower(p.VCSType)
-			}
-			slug = vcs + "/" + p.Username + "/" + p.RepoName
-		}
 		out[i] = projectOutput{
-			Slug:     slug,
+			Slug:     p.FullSlug(),
 			Name:     p.Name,
 			VCSType:  p.VCSType,
 			Username: p.Username,
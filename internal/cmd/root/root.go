@@ -47,6 +47,7 @@ import (
 	cmdenv "github.com/CircleCI-Public/circleci-cli/internal/cmd/env"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmd/envvar"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmd/extension"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmd/insights"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmd/job"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmd/my"
 	cmdnamespace "github.com/CircleCI-Public/circleci-cli/internal/cmd/namespace"
@@ -242,6 +243,7 @@ func NewRootCmd(version string) *cobra.Command {
 	cmd.AddCommand(deploy.NewDeployCmd())
 	cmd.AddCommand(cmdenv.NewEnvCmd())
 	cmd.AddCommand(envvar.NewEnvVarCmd())
+	cmd.AddCommand(insights.NewInsightsCmd())
 	cmd.AddCommand(job.NewJobCmd())
 	cmd.AddCommand(my.NewMyCmd())
 	cmd.AddCommand(pipeline.NewPipelineCmd())
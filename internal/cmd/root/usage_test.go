@@ -56,7 +56,7 @@ const helpLineBudget = 40
 // maxOverBudget bounds the allow-list below so it cannot quietly grow. It is a
 // ratchet: lower it as entries are removed. Growing it is a deliberate act that
 // needs a reason in review.
-const maxOverBudget = 25
+const maxOverBudget = 28
 
 // unbudgeted commands are long-form by design. A reader reaching for them wants
 // the whole inventory, and truncating it degrades gracefully. `circleci help
@@ -75,11 +75,13 @@ var unbudgeted = map[string]bool{
 // rots into a permanent excuse.
 var overBudget = map[string]int{
 	"circleci/api":                    43,
+	"circleci/artifact":               55, // eight new flags, a row each, the diff subcommand, and --pattern/--latest examples
 	"circleci/config/process":         41,
 	"circleci/context/get":            43,
 	"circleci/context/secret/list":    42,
 	"circleci/job/output/get":         42,
 	"circleci/job/output/list":        43,
+	"circleci/job/resource-usage/get": 44, // three new flags, a row each, on a page that was at the budget; --follow JSONL fields
 	"circleci/orb":                    41,
 	"circleci/orb/init":               42,
 	"circleci/orb/list":               48,
@@ -93,9 +95,10 @@ var overBudget = map[string]int{
 	"circleci/project":                42,
 	"circleci/project/create":         41,
 	"circleci/project/trigger/create": 41,
-	"circleci/run/get":                53,
-	"circleci/run/list":               49,
-	"circleci/run/watch":              48,
+	"circleci/run/get":                59, // two new flags, a row each, the failure-patterns.yml format and the --failure-report schemas
+	"circleci/run/list":               61, // ten new flags, a row each, and their examples; prose already trimmed
+	"circleci/run/trigger":            58, // eight new flags, a row each, and an example per ref and input flag
+	"circleci/run/watch":              52, // three new flags, a row each, and the --events/--exec field lists; prose already trimmed
 	"circleci/testresult/get":         44,
 	"circleci/testresult/list":        47,
 	"circleci/workflow/list":          48,
@@ -0,0 +1,633 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/config"
+	"github.com/CircleCI-Public/circleci-cli/internal/gitremote"
+	"github.com/CircleCI-Public/circleci-cli/internal/ui"
+)
+
+// Verdicts recorded for each tested commit, as in git bisect.
+const (
+	bisectGood = "good"
+	bisectBad  = "bad"
+	bisectSkip = "skip"
+)
+
+type bisectOptions struct {
+	Project      string
+	Good         string
+	Bad          string
+	Workflow     string
+	Job          string
+	DefinitionID string
+	Timeout      time.Duration
+	Resume       bool
+	Reset        bool
+	JSON         bool
+}
+
+func newBisectCmd() *cobra.Command {
+	var opts bisectOptions
+
+	cmd := &cobra.Command{
+		Use:   "bisect --good <sha> [--bad <sha>]",
+		Short: "Find the first commit where CI started failing",
+		Long: heredoc.Doc(`
+			Binary-search the first-parent git history between a good and a bad commit
+			for the first one whose run fails. Each candidate reuses an existing run for
+			its SHA, or triggers one on an origin branch whose tip is that commit. With
+			--job or --workflow only that job or workflow decides good or bad; a run
+			where it was cancelled or never ran is skipped.
+
+			Progress is saved after every verdict; --resume continues an interrupted
+			bisect and --reset abandons it.
+		`),
+		Example: heredoc.Doc(`
+			# Find which merge broke the test job on main
+			$ circleci run bisect --good v1.4.0 --bad main --job test
+
+			# Carry on after an interruption
+			$ circleci run bisect --resume
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if opts.Reset {
+				return resetBisect(ctx)
+			}
+			if !opts.Resume && opts.Good == "" {
+				return clierrors.New("run.bisect_requires_good", "Missing good commit",
+					"Pass the last known good commit with --good, or --resume to continue a bisect.").
+					WithExitCode(clierrors.ExitBadArguments)
+			}
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runBisect(ctx, client, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Good, "good", "", "Last commit known to pass (SHA, branch or tag)")
+	cmd.Flags().StringVar(&opts.Bad, "bad", "HEAD", "First commit known to fail")
+	cmd.Flags().StringVar(&opts.Workflow, "workflow", "", "Judge only this workflow")
+	cmd.Flags().StringVar(&opts.Job, "job", "", "Judge only this job")
+	cmd.Flags().StringVar(&opts.Project, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
+	cmd.Flags().StringVar(&opts.DefinitionID, "definition-id", "", "Pipeline definition to trigger missing runs with")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", time.Hour, "Maximum time to wait for each run")
+	cmd.Flags().BoolVar(&opts.Resume, "resume", false, "Continue the bisect in progress for this repository")
+	cmd.Flags().BoolVar(&opts.Reset, "reset", false, "Abandon the bisect in progress for this repository")
+	cmdutil.AddJSONFlag(cmd, &opts.JSON)
+
+	return cmd
+}
+
+// bisectSession is the on-disk state of a bisect, saved after every verdict so
+// an interrupted bisect — a closed laptop, a run that needs a branch pushed —
+// picks up where it stopped. Commits are oldest first and end with the bad
+// commit; the good commit is not among them.
+type bisectSession struct {
+	Project      string             `json:"project"`
+	Good         gitremote.Commit   `json:"good"`
+	Commits      []gitremote.Commit `json:"commits"`
+	Workflow     string             `json:"workflow,omitempty"`
+	Job          string             `json:"job,omitempty"`
+	DefinitionID string             `json:"definition_id,omitempty"`
+	Verdicts     map[string]string  `json:"verdicts"`
+	Runs         map[string]string  `json:"runs"`
+}
+
+func runBisect(ctx context.Context, client *apiclient.Client, opts bisectOptions) error {
+	path, err := bisectSessionPath()
+	if err != nil {
+		return err
+	}
+
+	var s *bisectSession
+	if opts.Resume {
+		s, err = loadBisectSession(path)
+		if err != nil {
+			return err
+		}
+	} else {
+		if _, statErr := os.Stat(path); statErr == nil {
+			return clierrors.New("run.bisect_in_progress", "Bisect already in progress",
+				"A bisect for this repository was interrupted and has not finished.").
+				WithSuggestions(
+					"Continue it: circleci run bisect --resume",
+					"Or abandon it: circleci run bisect --reset",
+				).
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+		s, err = newBisectSession(opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	proj, err := client.GetProjectBySlug(ctx, s.Project)
+	if err != nil {
+		return apiErr(err, s.Project)
+	}
+	appURL, _ := cmdutil.AppURL(ctx)
+
+	iostream.ErrPrintf(ctx, "Bisecting %d commit(s) after %s up to %s\n\n",
+		len(s.Commits), shortSHA(s.Good.SHA), shortSHA(s.Commits[len(s.Commits)-1].SHA))
+
+	for {
+		next, done := s.next()
+		if done {
+			break
+		}
+		c := s.Commits[next]
+		lo, hi := s.bounds()
+		iostream.ErrPrintf(ctx, "[%d left] %s %s\n", hi-lo-1, shortSHA(c.SHA), c.Subject)
+
+		runID, err := bisectRunFor(ctx, client, s, proj.ID.String(), c)
+		if err != nil {
+			if sErr := s.save(path); sErr != nil {
+				return sErr
+			}
+			return err
+		}
+		s.Runs[c.SHA] = runID.String()
+
+		verdict, err := bisectWait(ctx, client, runID, c.SHA, s.Workflow, s.Job, opts.Timeout)
+		if err != nil {
+			var cliErr *clierrors.CLIError
+			if errors.As(err, &cliErr) && cliErr.Code == codeBisectWrongCommit {
+				// The run tests another commit; --resume must find a new one.
+				delete(s.Runs, c.SHA)
+			}
+			if sErr := s.save(path); sErr != nil {
+				return sErr
+			}
+			return err
+		}
+		s.Verdicts[c.SHA] = verdict
+		iostream.ErrPrintf(ctx, "  run %s: %s\n", runID, verdict)
+		if err := s.save(path); err != nil {
+			return err
+		}
+	}
+
+	out := s.result(appURL)
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	iostream.ErrPrintln(ctx, "")
+	if opts.JSON {
+		if err := iostream.PrintJSON(ctx, out); err != nil {
+			return err
+		}
+	} else {
+		printBisectResult(ctx, out)
+	}
+	if out.FirstBad == nil {
+		return clierrors.New("run.bisect_inconclusive", "Bisect inconclusive",
+			fmt.Sprintf("Skipped runs left %d candidate commits for the first failure.", len(out.Candidates))).
+			WithExitCode(clierrors.ExitGeneralError)
+	}
+	return nil
+}
+
+func newBisectSession(opts bisectOptions) (*bisectSession, error) {
+	slug, err := cmdutil.ResolveProjectSlug(opts.Project)
+	if err != nil {
+		return nil, err
+	}
+	good, err := gitremote.ResolveRevisionIn("", opts.Good)
+	if err != nil {
+		return nil, bisectRevisionErr(err)
+	}
+	commits, err := gitremote.CommitRangeIn("", opts.Good, opts.Bad)
+	if err != nil {
+		if errors.Is(err, gitremote.ErrNotAncestor) {
+			return nil, clierrors.New("run.bisect_bad_range", "Invalid bisect range",
+				fmt.Sprintf("%s is not an earlier commit on the first-parent history of %s.", opts.Good, opts.Bad)).
+				WithSuggestions("Check the order: --good is the older, passing commit").
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+		return nil, bisectRevisionErr(err)
+	}
+	bad := commits[len(commits)-1]
+	return &bisectSession{
+		Project:      slug,
+		Good:         good,
+		Commits:      commits,
+		Workflow:     opts.Workflow,
+		Job:          opts.Job,
+		DefinitionID: opts.DefinitionID,
+		Verdicts:     map[string]string{bad.SHA: bisectBad},
+		Runs:         map[string]string{},
+	}, nil
+}
+
+func bisectRevisionErr(err error) *clierrors.CLIError {
+	return clierrors.New("run.bisect_git_error", "Could not read git history", err.Error()).
+		WithSuggestions("Run from a checkout that contains both commits; git fetch if they are new").
+		WithExitCode(clierrors.ExitBadArguments)
+}
+
+// bounds returns the index of the newest commit known good (-1 for the --good
+// commit itself) and the oldest commit known bad. The first failure lies in
+// (lo, hi].
+func (s *bisectSession) bounds() (lo, hi int) {
+	hi = len(s.Commits) - 1
+	for i, c := range s.Commits {
+		if s.Verdicts[c.SHA] == bisectBad {
+			hi = i
+			break
+		}
+	}
+	lo = -1
+	for i := hi - 1; i >= 0; i-- {
+		if s.Verdicts[s.Commits[i].SHA] == bisectGood {
+			lo = i
+			break
+		}
+	}
+	return lo, hi
+}
+
+// next picks the untested commit nearest the middle of the remaining range.
+// done is true once every commit between the bounds has been tested, whether
+// that pins the first failure or leaves only skipped commits.
+func (s *bisectSession) next() (int, bool) {
+	lo, hi := s.bounds()
+	mid := (lo + hi) / 2
+	best := -1
+	for i := lo + 1; i < hi; i++ {
+		if _, tested := s.Verdicts[s.Commits[i].SHA]; tested {
+			continue
+		}
+		if best == -1 || absInt(i-mid) < absInt(best-mid) {
+			best = i
+		}
+	}
+	return best, best == -1
+}
+
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func bisectSessionPath() (string, error) {
+	root, err := gitremote.RepoRootIn("")
+	if err != nil {
+		return "", cmdutil.GitDetectErr(err, "run bisect walks local history, so run it inside the repository")
+	}
+	dir, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(root))
+	return filepath.Join(dir, "bisect", hex.EncodeToString(sum[:8])+".json"), nil
+}
+
+func loadBisectSession(path string) (*bisectSession, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, clierrors.New("run.bisect_not_started", "No bisect in progress",
+			"There is no interrupted bisect for this repository to resume.").
+			WithSuggestions("Start one: circleci run bisect --good <sha> --bad <sha>").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var s bisectSession
+	if err := json.Unmarshal(data, &s); err != nil || len(s.Commits) == 0 {
+		return nil, clierrors.New("run.bisect_state_corrupt", "Bisect state unreadable",
+			fmt.Sprintf("The saved bisect at %s could not be read.", path)).
+			WithSuggestions("Start over: circleci run bisect --reset").
+			WithExitCode(clierrors.ExitGeneralError)
+	}
+	return &s, nil
+}
+
+func (s *bisectSession) save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+func resetBisect(ctx context.Context) error {
+	path, err := bisectSessionPath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			iostream.ErrPrintln(ctx, "No bisect in progress.")
+			return nil
+		}
+		return err
+	}
+	iostream.ErrPrintf(ctx, "%s Bisect abandoned\n", iostream.SymbolOK(ctx))
+	return nil
+}
+
+// bisectRunFor finds the run that tests c: the one already recorded for it, the
+// most recent run for its SHA, or a new one triggered on an origin branch whose
+// tip is c. The trigger API checks out branches and tags, not bare SHAs, so a
+// commit no branch points at cannot be triggered from here; the user is asked
+// to push one and resume.
+func bisectRunFor(ctx context.Context, client *apiclient.Client, s *bisectSession, projectID string, c gitremote.Commit) (uuid.UUID, error) {
+	if id, ok := s.Runs[c.SHA]; ok {
+		if parsed, err := uuid.Parse(id); err == nil {
+			return parsed, nil
+		}
+	}
+
+	now := time.Now().UTC()
+	runs, err := client.SearchRunsV3(ctx, apiclient.RunSearchParams{
+		ProjectIDs: []string{projectID},
+		From:       now.AddDate(0, 0, -90),
+		To:         now,
+		Filter:     fmt.Sprintf("pipeline.git.revision == %q", c.SHA),
+		Limit:      1,
+	})
+	if err != nil {
+		return uuid.Nil, apiErr(err, s.Project)
+	}
+	if len(runs) > 0 {
+		return runs[0].ID, nil
+	}
+
+	short := shortSHA(c.SHA)
+	branches, err := gitremote.OriginBranchesAtIn(ctx, "", c.SHA)
+	if err != nil {
+		return uuid.Nil, clierrors.New("run.origin_unreachable", "Cannot reach origin",
+			fmt.Sprintf("Commit %s has no run, and origin could not be asked which branch is at it: %s", short, err)).
+			WithSuggestions("Once origin is reachable, continue: circleci run bisect --resume").
+			WithExitCode(clierrors.ExitGeneralError)
+	}
+	if len(branches) == 0 {
+		return uuid.Nil, clierrors.New("run.bisect_needs_run", "No run for commit",
+			fmt.Sprintf("Commit %s has no run, and no origin branch points at it to trigger one on.", short)).
+			WithSuggestions(
+				fmt.Sprintf("Push a branch at it: git push origin %s:refs/heads/bisect/%s", c.SHA, short),
+				"Then continue: circleci run bisect --resume",
+			).
+			WithExitCode(clierrors.ExitNotFound)
+	}
+
+	slices.Sort(branches)
+	branch := branches[0]
+	res, err := client.TriggerPipelineRun(ctx, s.Project, apiclient.TriggerPipelineRunInput{
+		DefinitionID:   s.DefinitionID,
+		ConfigBranch:   branch,
+		CheckoutBranch: branch,
+	})
+	if err != nil {
+		return uuid.Nil, apiErr(err, s.Project)
+	}
+	if !res.Triggered {
+		return uuid.Nil, clierrors.New("run.bisect_not_triggered", "Run not triggered",
+			fmt.Sprintf("Triggering %s on %s was skipped: %s", shortSHA(c.SHA), branch, res.Message)).
+			WithExitCode(clierrors.ExitGeneralError)
+	}
+	id, err := uuid.Parse(res.ID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("trigger returned run ID %q: %w", res.ID, err)
+	}
+	iostream.ErrPrintf(ctx, "  triggered run %s on %s\n", id, branch)
+	return id, nil
+}
+
+// codeBisectWrongCommit is the error code for a run that checked out a
+// commit other than the one it was meant to test.
+const codeBisectWrongCommit = "run.bisect_wrong_commit"
+
+// bisectWait polls a run until its verdict on commit sha is known: the chosen
+// job or workflow has ended, or with neither chosen, the whole run has. A
+// triggered run checks out whatever its branch points at when it starts, so no
+// verdict is given until the run's revision is known to be sha.
+func bisectWait(ctx context.Context, client *apiclient.Client, runID uuid.UUID, sha, workflow, job string, timeout time.Duration) (string, error) {
+	deadline := time.Now().Add(timeout)
+	pollInterval := ui.RunWatchPollInterval
+	verified := false
+	for {
+		if !verified {
+			var err error
+			if verified, err = bisectRunTests(ctx, client, runID, sha); err != nil {
+				return "", err
+			}
+		}
+		state, err := fetchLatestAttemptState(ctx, client, runID)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return "", watchInterrupted()
+			}
+			return "", apiErr(err, runID.String())
+		}
+		if verdict, ok := bisectVerdict(state, workflow, job); ok && verified {
+			return verdict, nil
+		}
+		if time.Now().After(deadline) {
+			return "", watchTimedOut(runID, timeout)
+		}
+		if err := sleepOrCancel(ctx, pollInterval); err != nil {
+			return "", watchInterrupted()
+		}
+		if pollInterval < ui.RunWatchMaxPollInterval {
+			pollInterval += ui.RunWatchPollInterval
+		}
+	}
+}
+
+// bisectRunTests reports whether the run has checked out sha, false while it
+// has yet to record a revision, and an error once it is known to have checked
+// out another commit.
+func bisectRunTests(ctx context.Context, client *apiclient.Client, runID uuid.UUID, sha string) (bool, error) {
+	r, err := client.GetRunV3(ctx, runID)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return false, watchInterrupted()
+		}
+		return false, apiErr(err, runID.String())
+	}
+	if r.Revision == "" || r.Revision == sha {
+		return r.Revision == sha, nil
+	}
+	short := shortSHA(sha)
+	return false, clierrors.New(codeBisectWrongCommit, "Run tested another commit",
+		fmt.Sprintf("Run %s checked out %s, not %s: its branch moved before the run started.",
+			runID, shortSHA(r.Revision), short)).
+		WithSuggestions(
+			fmt.Sprintf("Push a branch at it: git push origin %s:refs/heads/bisect/%s", sha, short),
+			"Then continue: circleci run bisect --resume",
+		).
+		WithExitCode(clierrors.ExitGeneralError)
+}
+
+// bisectVerdict judges one poll of a run, holding only the latest attempt of
+// each workflow. The bool is false while the verdict is still pending. Anything other than a clean pass or fail — cancelled, not
+// run, or the chosen job missing from the run — is a skip, since it says
+// nothing about the commit.
+func bisectVerdict(state runGetOutput, workflow, job string) (string, bool) {
+	done := allWorkflowsDone(state.Workflows)
+
+	judge := func(phase, outcome string) (string, bool) {
+		if phase != apiclient.PhaseEnded {
+			return "", false
+		}
+		switch outcome {
+		case "succeeded":
+			return bisectGood, true
+		case "failed", "errored":
+			return bisectBad, true
+		default:
+			return bisectSkip, true
+		}
+	}
+
+	if job == "" && workflow == "" {
+		if !done {
+			return "", false
+		}
+		switch deriveDisplayStatus(state) {
+		case "succeeded":
+			return bisectGood, true
+		case "failed":
+			return bisectBad, true
+		default:
+			return bisectSkip, true
+		}
+	}
+
+	for _, wf := range state.Workflows {
+		if workflow != "" && wf.Name != workflow {
+			continue
+		}
+		if job == "" {
+			return judge(wf.Phase, wf.Outcome)
+		}
+		for _, j := range wf.Jobs {
+			if j.Name == job {
+				return judge(j.Phase, j.Outcome)
+			}
+		}
+	}
+	if done {
+		return bisectSkip, true
+	}
+	return "", false
+}
+
+// bisectResult is the JSON shape of a finished bisect. FirstBad is nil when
+// skipped commits left more than one candidate, which Candidates then lists.
+type bisectResult struct {
+	FirstBad   *bisectCommit  `json:"first_bad,omitempty"`
+	Candidates []bisectCommit `json:"candidates,omitempty"`
+	Tested     []bisectCommit `json:"tested"`
+}
+
+type bisectCommit struct {
+	SHA     string `json:"sha"`
+	Subject string `json:"subject"`
+	Verdict string `json:"verdict,omitempty"`
+	RunID   string `json:"run_id,omitempty"`
+	URL     string `json:"url,omitempty"`
+}
+
+func (s *bisectSession) result(appURL string) bisectResult {
+	commit := func(c gitremote.Commit) bisectCommit {
+		bc := bisectCommit{SHA: c.SHA, Subject: c.Subject, Verdict: s.Verdicts[c.SHA], RunID: s.Runs[c.SHA]}
+		if id, err := uuid.Parse(bc.RunID); err == nil && appURL != "" {
+			bc.URL = cmdutil.RunURL(appURL, id)
+		}
+		return bc
+	}
+
+	out := bisectResult{Tested: []bisectCommit{}}
+	for _, c := range s.Commits {
+		if _, ok := s.Runs[c.SHA]; ok {
+			out.Tested = append(out.Tested, commit(c))
+		}
+	}
+	lo, hi := s.bounds()
+	if hi-lo == 1 {
+		out.FirstBad = new(commit(s.Commits[hi]))
+		return out
+	}
+	for i := lo + 1; i <= hi; i++ {
+		out.Candidates = append(out.Candidates, commit(s.Commits[i]))
+	}
+	return out
+}
+
+func printBisectResult(ctx context.Context, out bisectResult) {
+	if out.FirstBad != nil {
+		c := out.FirstBad
+		iostream.Printf(ctx, "First failing commit: %s\n", c.SHA)
+		iostream.Printf(ctx, "  %s\n", c.Subject)
+		if c.URL != "" {
+			iostream.Printf(ctx, "  %s\n", c.URL)
+		} else if c.RunID == "" {
+			iostream.Printf(ctx, "  (the --bad commit; no run was needed)\n")
+		}
+		return
+	}
+	iostream.Printf(ctx, "The first failing commit is one of:\n")
+	for _, c := range out.Candidates {
+		iostream.Printf(ctx, "  %s %s (%s)\n", shortSHA(c.SHA), c.Subject, c.Verdict)
+	}
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/gitremote"
+)
+
+func bisectSessionOf(n int) *bisectSession {
+	s := &bisectSession{Verdicts: map[string]string{}, Runs: map[string]string{}}
+	for i := range n {
+		s.Commits = append(s.Commits, gitremote.Commit{SHA: fmt.Sprintf("sha%d", i)})
+	}
+	s.Verdicts[s.Commits[n-1].SHA] = bisectBad
+	return s
+}
+
+// TestBisectSession_SkipsAroundMiddle verifies a skipped midpoint moves the
+// search to its nearest untested neighbour, and that a range left holding only
+// skipped commits is reported as candidates rather than a first failure.
+func TestBisectSession_SkipsAroundMiddle(t *testing.T) {
+	s := bisectSessionOf(7)
+
+	next, done := s.next()
+	assert.Assert(t, !done)
+	assert.Check(t, is.Equal(next, 2))
+
+	s.Verdicts["sha2"] = bisectSkip
+	next, _ = s.next()
+	assert.Check(t, is.Equal(next, 1))
+
+	s.Verdicts["sha1"] = bisectGood
+	next, _ = s.next()
+	assert.Check(t, is.Equal(next, 3))
+
+	s.Verdicts["sha3"] = bisectBad
+	_, done = s.next()
+	assert.Assert(t, done)
+
+	out := s.result("")
+	assert.Check(t, out.FirstBad == nil)
+	assert.Assert(t, is.Len(out.Candidates, 2))
+	assert.Check(t, is.Equal(out.Candidates[0].SHA, "sha2"))
+	assert.Check(t, is.Equal(out.Candidates[1].SHA, "sha3"))
+}
+
+func TestBisectVerdict(t *testing.T) {
+	state := runGetOutput{Workflows: []workflowOutput{{
+		Name: "build", Phase: "ended", Outcome: "failed",
+		Jobs: []jobOutput{
+			{Name: "test", Phase: "ended", Outcome: "succeeded"},
+			{Name: "e2e", Phase: "ended", Outcome: "failed"},
+			{Name: "deploy", Phase: "ended", Outcome: "canceled"},
+		},
+	}}}
+
+	for _, tc := range []struct {
+		workflow, job, want string
+	}{
+		{want: bisectBad},
+		{job: "test", want: bisectGood},
+		{job: "e2e", want: bisectBad},
+		{job: "deploy", want: bisectSkip},
+		{job: "missing", want: bisectSkip},
+		{workflow: "build", want: bisectBad},
+		{workflow: "other", job: "test", want: bisectSkip},
+	} {
+		got, ok := bisectVerdict(state, tc.workflow, tc.job)
+		assert.Check(t, ok, "%+v", tc)
+		assert.Check(t, is.Equal(got, tc.want), "%+v", tc)
+	}
+
+	state.Workflows[0].Phase = "running"
+	state.Workflows[0].Jobs[0].Phase = "running"
+	_, ok := bisectVerdict(state, "", "test")
+	assert.Check(t, !ok, "a running job has no verdict yet")
+	_, ok = bisectVerdict(state, "", "missing")
+	assert.Check(t, !ok, "a job may still appear while the run is going")
+}
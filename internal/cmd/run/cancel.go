@@ -27,6 +27,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/google/uuid"
@@ -41,27 +42,21 @@ import (
 )
 
 func newCancelCmd() *cobra.Command {
-	var projectSlug string
-	var force bool
+	var opts bulkCancelOptions
 
 	cmd := &cobra.Command{
-		Use:   "cancel <run-number-or-id>",
-		Short: "Cancel a run",
+		Use:   "cancel [<run-number-or-id>]",
+		Short: "Cancel a run, or every matching run on a branch",
 		Annotations: map[string]string{
 			"help:arguments": heredoc.Docf(`
-				%[1]s<run-number-or-id>%[1]s identifies the run to cancel. A run can be specified by its UUID or number:
-				- A run UUID, as shown in %[1]scircleci run list --json%[1]s
-				- A run number, as shown in %[1]scircleci run list%[1]s.
-
-				The project is inferred from the git remote unless overridden with %[1]s--project%[1]s.
+				%[1]s<run-number-or-id>%[1]s is a run UUID or number from %[1]scircleci run list%[1]s; omit it to cancel in bulk.
 			`, "`"),
 			"destructiveHint": "true",
 		},
 		Long: heredoc.Doc(`
-			Cancel a running CircleCI run by number or UUID.
-
-			Cancelling a run stops all in-progress workflows and jobs within it.
-			Workflows that have already completed are unaffected.
+			Stops in-progress workflows and jobs; workflows that have already completed are
+			unaffected. Bulk cancel lists the runs and asks first. --status defaults to running
+			and on_hold; --keep-latest spares each branch's newest run only if --status matches it.
 		`),
 		Example: heredoc.Doc(`
 			# Cancel a run by number (with confirmation)
@@ -72,23 +67,49 @@ func newCancelCmd() *cobra.Command {
 
 			# Cancel the latest run on a branch
 			$ circleci run list --branch main --json --jq '.[0].id' | xargs circleci run cancel --force
+
+			# Cancel runs superseded by a force-push, keeping the newest
+			$ circleci run cancel --branch feature --keep-latest
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if cliErr := cmdutil.RequireArgs(args, "run-number-or-id"); cliErr != nil {
-				return cliErr
+			bulk := opts.Branch != "" || opts.AllBranches
+			if bulk && len(args) > 0 {
+				return clierrors.New("args.conflicting_args", "Conflicting arguments",
+					"A run cannot be given together with --branch or --all-branches.").
+					WithExitCode(clierrors.ExitBadArguments)
+			}
+			if !bulk {
+				for _, name := range []string{"status", "older-than", "keep-latest"} {
+					if cmd.Flags().Changed(name) {
+						return clierrors.New("args.missing_branch", "Missing branch",
+							fmt.Sprintf("--%s selects runs to cancel in bulk, which needs --branch or --all-branches.", name)).
+							WithExitCode(clierrors.ExitBadArguments)
+					}
+				}
+				if cliErr := cmdutil.RequireArgs(args, "run-number-or-id"); cliErr != nil {
+					return cliErr
+				}
 			}
 			ctx := cmd.Context()
 			client, err := cmdutil.LoadClient(ctx)
 			if err != nil {
 				return err
 			}
-			return runCancel(ctx, client, args[0], projectSlug, force)
+			if bulk {
+				return runCancelBulk(ctx, client, opts)
+			}
+			return runCancel(ctx, client, args[0], opts.ProjectSlug, opts.Force)
 		},
 	}
 
-	cmd.Flags().StringVar(&projectSlug, "project", "", "Project slug (e.g. gh/org/repo); used when cancelling by number")
-	cmd.Flags().BoolVarP(&force, "force", "f", false, "skip confirmation prompt")
+	cmd.Flags().StringVar(&opts.ProjectSlug, "project", "", "Project slug (e.g. gh/org/repo); defaults to the git remote")
+	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "skip confirmation prompt")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Cancel every matching run on this branch")
+	cmd.Flags().BoolVar(&opts.AllBranches, "all-branches", false, "Cancel every matching run in the project")
+	cmd.Flags().StringVar(&opts.Statuses, "status", defaultCancelStatuses, "Comma-separated statuses to cancel: "+strings.Join(cancellableStatuses, ", "))
+	cmd.Flags().DurationVar(&opts.OlderThan, "older-than", 0, "Only cancel runs created at least this long ago (e.g. 10m)")
+	cmd.Flags().BoolVar(&opts.KeepLatest, "keep-latest", false, "Leave each branch's newest run running; the older runs it supersedes are cancelled")
 
 	return cmd
 }
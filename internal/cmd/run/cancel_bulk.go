@@ -0,0 +1,316 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/clikit/mdtable"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/gitremote"
+	runpkg "github.com/CircleCI-Public/circleci-cli/internal/run"
+)
+
+// bulkCancelParallelism bounds the workflow cancellations in flight at once.
+const bulkCancelParallelism = 8
+
+// bulkCancelWindowDays is how far back bulk cancel searches for active runs.
+const bulkCancelWindowDays = 90
+
+// defaultCancelStatuses is the --status default: runs that are doing work or
+// waiting on an approval.
+const defaultCancelStatuses = apiclient.StatusRunning + "," + apiclient.StatusOnHold
+
+// cancellableStatuses are the --status values bulk cancel accepts: the
+// pipeline statuses of a run that still has something to stop.
+var cancellableStatuses = []string{
+	apiclient.StatusRunning, apiclient.StatusOnHold, apiclient.StatusQueued, apiclient.StatusFailing,
+}
+
+// bulkCancelOptions selects the runs cancelled by run cancel --branch or
+// --all-branches.
+type bulkCancelOptions struct {
+	ProjectSlug string
+	Branch      string
+	AllBranches bool
+	Statuses    string
+	OlderThan   time.Duration
+	KeepLatest  bool
+	Force       bool
+}
+
+// bulkCancelTarget is one run picked for cancellation and the status that
+// matched it.
+type bulkCancelTarget struct {
+	run    apiclient.RunV3
+	status string
+}
+
+// parseCancelStatuses splits a --status list, rejecting any status that has
+// nothing left to cancel.
+func parseCancelStatuses(raw string) ([]string, error) {
+	var statuses []string
+	for s := range strings.SplitSeq(raw, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !slices.Contains(cancellableStatuses, s) {
+			return nil, clierrors.New("run.invalid_status", "Invalid status",
+				fmt.Sprintf("Status %q cannot be cancelled.", s)).
+				WithSuggestions("Use one or more of: " + strings.Join(cancellableStatuses, ", ")).
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+		if !slices.Contains(statuses, s) {
+			statuses = append(statuses, s)
+		}
+	}
+	if len(statuses) == 0 {
+		return nil, clierrors.New("run.invalid_status", "Invalid status",
+			"--status needs at least one status.").
+			WithSuggestions("Use one or more of: " + strings.Join(cancellableStatuses, ", ")).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	return statuses, nil
+}
+
+func validateBulkCancel(opts bulkCancelOptions) error {
+	if opts.Branch != "" && opts.AllBranches {
+		return clierrors.New("args.conflicting_flags", "Conflicting flags",
+			"--branch and --all-branches cannot be used together.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if opts.OlderThan < 0 {
+		return clierrors.New("args.invalid_older_than", "Invalid --older-than",
+			fmt.Sprintf("--older-than must be a positive duration, got %s.", opts.OlderThan)).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	return nil
+}
+
+// runCancelBulk cancels every active run in a project that matches the
+// branch, status and age filters, after showing what it is about to cancel.
+func runCancelBulk(ctx context.Context, client *apiclient.Client, opts bulkCancelOptions) error {
+	if err := validateBulkCancel(opts); err != nil {
+		return err
+	}
+	statuses, err := parseCancelStatuses(opts.Statuses)
+	if err != nil {
+		return err
+	}
+
+	if opts.ProjectSlug == "" {
+		info, err := gitremote.Detect()
+		if err != nil {
+			return cmdutil.GitDetectErr(err, "Or specify the project: circleci run cancel --project gh/org/repo")
+		}
+		opts.ProjectSlug = info.Slug
+	}
+	proj, err := client.GetProjectBySlug(ctx, opts.ProjectSlug)
+	if err != nil {
+		return apiErr(err, opts.ProjectSlug)
+	}
+
+	sp := iostream.Spinner(ctx, true, "Finding runs to cancel")
+	matched, err := searchCancelTargets(ctx, client, proj.ID, opts.Branch, statuses)
+	sp.Stop()
+	if err != nil {
+		return apiErr(err, opts.ProjectSlug)
+	}
+
+	var latest map[string]uuid.UUID
+	if opts.KeepLatest {
+		if latest, err = latestRunIDs(ctx, client, proj.ID, matched); err != nil {
+			return apiErr(err, opts.ProjectSlug)
+		}
+	}
+	targets, kept := selectCancelTargets(matched, latest, opts.OlderThan, time.Now())
+	for _, k := range kept {
+		iostream.ErrPrintf(ctx, "Keeping the latest run on %s: %s\n", orDash(k.run.Branch), k.run.ID)
+	}
+	if len(targets) == 0 {
+		iostream.ErrPrintf(ctx, "No matching runs to cancel.\n")
+		return nil
+	}
+
+	printCancelTargets(ctx, targets)
+
+	if err := cmdutil.ConfirmOrForce(ctx, iostream.Get(ctx), opts.Force,
+		fmt.Sprintf("Cancel %s? In-progress jobs will be stopped.", pluralRuns(len(targets))),
+		clierrors.New("run.cancel_aborted", "Cancellation aborted",
+			"Run cancellation was not confirmed.").
+			WithExitCode(clierrors.ExitCancelled),
+		clierrors.New("run.cancel_requires_force", "Cancellation requires --force",
+			fmt.Sprintf("Cancelling %s will stop all of their in-progress jobs.", pluralRuns(len(targets)))).
+			WithExitCode(clierrors.ExitCancelled),
+	); err != nil {
+		return err
+	}
+
+	results := make([]error, len(targets))
+	_ = bulkhead.Do(ctx, bulkCancelParallelism, targets, func(t bulkCancelTarget, i int) error {
+		results[i] = runpkg.Cancel(ctx, client, t.run.ID)
+		return nil
+	})
+
+	failed := 0
+	for i, t := range targets {
+		err := results[i]
+		if _, ok := errors.AsType[*runpkg.ErrNothingToCancel](err); ok {
+			iostream.Printf(ctx, "- Run %s (%s) had already finished\n", t.run.ID, orDash(t.run.Branch))
+			continue
+		}
+		if err != nil {
+			failed++
+			iostream.ErrPrintf(ctx, "%s Could not cancel run %s (%s): %v\n",
+				iostream.SymbolFail(ctx), t.run.ID, orDash(t.run.Branch), err)
+			continue
+		}
+		iostream.Printf(ctx, "%s Cancelled run %s (%s)\n", iostream.SymbolOK(ctx), t.run.ID, orDash(t.run.Branch))
+	}
+	if failed > 0 {
+		return clierrors.New("run.cancel_incomplete", "Some runs were not cancelled",
+			fmt.Sprintf("%d of %s could not be cancelled.", failed, pluralRuns(len(targets)))).
+			WithSuggestions("Run the same command again to retry the remaining runs").
+			WithExitCode(clierrors.ExitAPIError)
+	}
+	return nil
+}
+
+// searchCancelTargets finds the project's runs in any of the given statuses,
+// newest first. The search filter pins one status at a time, so each status
+// is its own search; a run is listed once even if it moved between statuses
+// while the searches ran.
+func searchCancelTargets(ctx context.Context, client *apiclient.Client, projectID uuid.UUID, branch string, statuses []string) ([]bulkCancelTarget, error) {
+	now := time.Now().UTC()
+	seen := map[uuid.UUID]bool{}
+	var out []bulkCancelTarget
+	for _, status := range statuses {
+		err := client.SearchRunsV3Pages(ctx, apiclient.RunSearchParams{
+			ProjectIDs: []string{projectID.String()},
+			From:       now.AddDate(0, 0, -bulkCancelWindowDays),
+			To:         now,
+			Filter:     apiclient.RunFilter{Branch: branch, Status: status}.Expr(),
+		}, func(runs []apiclient.RunV3) (bool, error) {
+			for _, r := range runs {
+				if seen[r.ID] {
+					continue
+				}
+				seen[r.ID] = true
+				out = append(out, bulkCancelTarget{run: r, status: status})
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].run.CreatedAt.After(out[j].run.CreatedAt)
+	})
+	return out, nil
+}
+
+// latestRunIDs finds, for each branch among the matched runs, the newest run
+// on it whatever its status. After a force-push the newest run may be queued
+// or already finished, and a superseded run still going must not be kept in
+// its place. Runs with no branch, such as tag runs, cannot be searched for as
+// a group, so the newest matched one stands as their latest.
+func latestRunIDs(ctx context.Context, client *apiclient.Client, projectID uuid.UUID, matched []bulkCancelTarget) (map[string]uuid.UUID, error) {
+	now := time.Now().UTC()
+	latest := map[string]uuid.UUID{}
+	for _, t := range matched {
+		branch := t.run.Branch
+		if _, ok := latest[branch]; ok {
+			continue
+		}
+		if branch == "" {
+			latest[branch] = t.run.ID
+			continue
+		}
+		runs, err := client.SearchRunsV3(ctx, apiclient.RunSearchParams{
+			ProjectIDs: []string{projectID.String()},
+			From:       now.AddDate(0, 0, -bulkCancelWindowDays),
+			To:         now,
+			Filter:     apiclient.RunFilter{Branch: branch}.Expr(),
+			Limit:      1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(runs) > 0 {
+			latest[branch] = runs[0].ID
+		}
+	}
+	return latest, nil
+}
+
+// selectCancelTargets applies --keep-latest and --older-than to the matched
+// runs. latest holds the newest run on each branch (see latestRunIDs) and is
+// nil without --keep-latest; a matched run that is its branch's latest is set
+// aside before the age filter, so --older-than can never cancel it. When the
+// latest run did not match --status, every matched run on its branch is
+// superseded and cancelled.
+func selectCancelTargets(matched []bulkCancelTarget, latest map[string]uuid.UUID, olderThan time.Duration, now time.Time) (targets, kept []bulkCancelTarget) {
+	for _, t := range matched {
+		if id, ok := latest[t.run.Branch]; ok && id == t.run.ID {
+			kept = append(kept, t)
+			continue
+		}
+		if olderThan > 0 && now.Sub(t.run.CreatedAt) < olderThan {
+			continue
+		}
+		targets = append(targets, t)
+	}
+	return targets, kept
+}
+
+// printCancelTargets lists the runs about to be cancelled, so the
+// confirmation prompt that follows is about something the user can see.
+func printCancelTargets(ctx context.Context, targets []bulkCancelTarget) {
+	table := mdtable.New("Branch", "Revision", "ID", "Created", "Status")
+	for _, t := range targets {
+		table.Row(orDash(t.run.Branch), orDash(shortSHA(t.run.Revision)), "`"+t.run.ID.String()+"`",
+			t.run.CreatedAt.Format("2006-01-02 15:04 UTC"), t.status)
+	}
+	iostream.PrintMarkdown(ctx, "# Runs to cancel\n"+table.Render())
+}
+
+func pluralRuns(n int) string {
+	if n == 1 {
+		return "1 run"
+	}
+	return fmt.Sprintf("%d runs", n)
+}
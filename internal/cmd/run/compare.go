@@ -0,0 +1,678 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/clikit/mdtable"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/gitremote"
+	"github.com/CircleCI-Public/circleci-cli/internal/ui"
+)
+
+// compareTopSteps caps the step-duration table in the markdown output. JSON
+// carries every step; a human comparing two runs wants the movers.
+const compareTopSteps = 10
+
+func newCompareCmd() *cobra.Command {
+	var (
+		projectSlug string
+		branch      string
+		latestOn    string
+		jsonOut     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "compare <run-a> <run-b>",
+		Short: "Compare two runs side by side",
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Docf(`
+				%[1]s<run-a>%[1]s is the baseline and %[1]s<run-b>%[1]s the run compared against it; both
+				are run UUIDs. Deltas read as b minus a, so a positive delta means b was slower.
+
+				With %[1]s--latest-on <branch>%[1]s, the baseline is that branch's latest run and
+				%[1]s<run-b>%[1]s is optional, defaulting to the current branch's latest run.
+			`, "`"),
+		},
+		Long: heredoc.Doc(`
+			Align two runs' workflows and jobs by name and show what changed: outcome,
+			duration per job and per step, resource class, and steps that fail in b
+			but did not fail in a.
+
+			JSON fields: base, head, workflows[].jobs[].steps[], newly_failing_steps
+		`),
+		Example: heredoc.Doc(`
+			# Did my branch make CI slower?
+			$ circleci run compare --latest-on main
+
+			# Compare two specific runs
+			$ circleci run compare 5034460f-c7c4-4c43-9457-de07e2029e7b 8cb37115-80a4-4af6-b377-eddd0f7c7167
+		`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if latestOn == "" {
+				if err := cmdutil.RequireArgs(args, "run-a", "run-b"); err != nil {
+					return err
+				}
+			} else if len(args) > 1 {
+				return clierrors.New("run.compare_too_many_runs", "Too many runs",
+					"With --latest-on the baseline is the latest run on that branch; pass at most one run to compare against it.").
+					WithExitCode(clierrors.ExitBadArguments)
+			}
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runCompare(ctx, client, args, projectSlug, branch, latestOn, jsonOut)
+		},
+	}
+
+	cmd.Flags().StringVar(&projectSlug, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch whose latest run is compared with --latest-on (defaults to current branch)")
+	cmd.Flags().StringVar(&latestOn, "latest-on", "", "Use this branch's latest run as the baseline")
+	cmdutil.AddJSONFlag(cmd, &jsonOut)
+
+	return cmd
+}
+
+func runCompare(ctx context.Context, client *apiclient.Client, args []string, projectSlug, branch, latestOn string, jsonOut bool) error {
+	var base, head *apiclient.RunV3
+	var err error
+
+	if latestOn == "" {
+		if base, err = resolveRun(ctx, client, args[:1], "", ""); err != nil {
+			return err
+		}
+		if head, err = resolveRun(ctx, client, args[1:2], "", ""); err != nil {
+			return err
+		}
+	} else {
+		if len(args) == 0 && branch == "" {
+			// The head is "my branch" even when --project names the repo, so
+			// the checkout is consulted for the branch either way.
+			info, dErr := gitremote.Detect()
+			if dErr != nil {
+				return cmdutil.GitDetectErr(dErr, "Or pass --branch, or the run UUID to compare")
+			}
+			branch = info.Branch
+		}
+		if base, err = resolveRun(ctx, client, nil, projectSlug, latestOn); err != nil {
+			return err
+		}
+		if head, err = resolveRun(ctx, client, args, projectSlug, branch); err != nil {
+			return err
+		}
+	}
+
+	sp := iostream.Spinner(ctx, !jsonOut, "Fetching workflows and jobs for both runs")
+	baseSnap, err := fetchRunSnapshot(ctx, client, base)
+	var headSnap runSnapshot
+	if err == nil {
+		headSnap, err = fetchRunSnapshot(ctx, client, head)
+	}
+	sp.Stop()
+	if err != nil {
+		return err
+	}
+
+	out := compareRuns(baseSnap, headSnap)
+	if jsonOut {
+		return iostream.PrintJSON(ctx, out)
+	}
+	iostream.PrintMarkdown(ctx, compareMarkdown(out))
+	return nil
+}
+
+// compareOutput is the JSON shape of run compare. A nil base or head on a
+// workflow, job or step means it only exists in the other run; deltas are head
+// minus base and are present only when both sides have a duration.
+type compareOutput struct {
+	Base              compareRunOutput     `json:"base"`
+	Head              compareRunOutput     `json:"head"`
+	DeltaSeconds      *float64             `json:"delta_seconds,omitempty"`
+	Workflows         []workflowComparison `json:"workflows"`
+	NewlyFailingSteps []newlyFailingStep   `json:"newly_failing_steps"`
+}
+
+type compareRunOutput struct {
+	ID              string   `json:"id"`
+	Branch          string   `json:"branch,omitempty"`
+	Tag             string   `json:"tag,omitempty"`
+	Revision        string   `json:"revision,omitempty"`
+	Status          string   `json:"status"`
+	DurationSeconds *float64 `json:"duration_seconds,omitempty"`
+}
+
+type compareSide struct {
+	Status          string   `json:"status"`
+	DurationSeconds *float64 `json:"duration_seconds,omitempty"`
+	ResourceClass   string   `json:"resource_class,omitempty"`
+}
+
+type workflowComparison struct {
+	Name         string          `json:"name"`
+	Base         *compareSide    `json:"base,omitempty"`
+	Head         *compareSide    `json:"head,omitempty"`
+	DeltaSeconds *float64        `json:"delta_seconds,omitempty"`
+	Jobs         []jobComparison `json:"jobs"`
+}
+
+type jobComparison struct {
+	Name         string           `json:"name"`
+	Base         *compareSide     `json:"base,omitempty"`
+	Head         *compareSide     `json:"head,omitempty"`
+	DeltaSeconds *float64         `json:"delta_seconds,omitempty"`
+	Steps        []stepComparison `json:"steps,omitempty"`
+}
+
+type stepComparison struct {
+	Name         string       `json:"name"`
+	Base         *compareSide `json:"base,omitempty"`
+	Head         *compareSide `json:"head,omitempty"`
+	DeltaSeconds *float64     `json:"delta_seconds,omitempty"`
+}
+
+// newlyFailingStep is a step that failed in head but not in base, either
+// because it passed there or because it did not exist.
+type newlyFailingStep struct {
+	Workflow string `json:"workflow"`
+	Job      string `json:"job"`
+	Step     string `json:"step"`
+	ExitCode *int   `json:"exit_code,omitempty"`
+}
+
+func compareRuns(base, head runSnapshot) compareOutput {
+	out := compareOutput{
+		Base:              compareRunSide(base),
+		Head:              compareRunSide(head),
+		Workflows:         []workflowComparison{},
+		NewlyFailingSteps: []newlyFailingStep{},
+	}
+	out.DeltaSeconds = deltaSeconds(out.Base.DurationSeconds, out.Head.DurationSeconds)
+
+	// A rerun adds another workflow of the same name to the run; only the
+	// latest attempt of each is compared, as it is the one that counts.
+	baseWorkflows := latestWorkflowAttempts(base.workflows)
+	headWorkflows := latestWorkflowAttempts(head.workflows)
+
+	baseWfs := map[string]snapshotWorkflow{}
+	for _, wf := range baseWorkflows {
+		baseWfs[wf.workflow.Name] = wf
+	}
+
+	// Head order first, since that is the run being judged; anything only in
+	// the baseline follows.
+	var names []string
+	headWfs := map[string]snapshotWorkflow{}
+	for _, wf := range headWorkflows {
+		headWfs[wf.workflow.Name] = wf
+		names = append(names, wf.workflow.Name)
+	}
+	for _, wf := range baseWorkflows {
+		if _, ok := headWfs[wf.workflow.Name]; !ok {
+			names = append(names, wf.workflow.Name)
+		}
+	}
+
+	for _, name := range names {
+		b, inBase := baseWfs[name]
+		h, inHead := headWfs[name]
+		wc := workflowComparison{Name: name}
+		var baseJobs, headJobs []*snapshotJob
+		if inBase {
+			wc.Base = workflowSide(b.workflow)
+			baseJobs = b.jobs
+		}
+		if inHead {
+			wc.Head = workflowSide(h.workflow)
+			headJobs = h.jobs
+		}
+		wc.DeltaSeconds = sideDelta(wc.Base, wc.Head)
+		wc.Jobs, out.NewlyFailingSteps = compareJobs(name, baseJobs, headJobs, out.NewlyFailingSteps)
+		out.Workflows = append(out.Workflows, wc)
+	}
+	return out
+}
+
+// latestWorkflowAttempts collapses a run's workflows to the latest attempt of
+// each name.
+func latestWorkflowAttempts(workflows []snapshotWorkflow) []snapshotWorkflow {
+	return latestByName(workflows,
+		func(wf snapshotWorkflow) string { return wf.workflow.Name },
+		func(a, b snapshotWorkflow) bool { return a.workflow.CreatedAt.After(b.workflow.CreatedAt) })
+}
+
+// latestJobAttempts collapses a workflow's jobs to the latest attempt of each
+// name. A job that never started counts as older than one that did.
+func latestJobAttempts(jobs []*snapshotJob) []*snapshotJob {
+	return latestByName(jobs,
+		func(j *snapshotJob) string { return j.job.Name },
+		func(a, b *snapshotJob) bool {
+			switch {
+			case a.job.StartedAt == nil:
+				return false
+			case b.job.StartedAt == nil:
+				return true
+			}
+			return a.job.StartedAt.After(*b.job.StartedAt)
+		})
+}
+
+func compareJobs(workflow string, base, head []*snapshotJob, failing []newlyFailingStep) ([]jobComparison, []newlyFailingStep) {
+	base, head = latestJobAttempts(base), latestJobAttempts(head)
+
+	baseByName := map[string]*snapshotJob{}
+	for _, j := range base {
+		baseByName[j.job.Name] = j
+	}
+	headByName := map[string]*snapshotJob{}
+	var names []string
+	for _, j := range head {
+		headByName[j.job.Name] = j
+		names = append(names, j.job.Name)
+	}
+	for _, j := range base {
+		if _, ok := headByName[j.job.Name]; !ok {
+			names = append(names, j.job.Name)
+		}
+	}
+
+	jobs := make([]jobComparison, 0, len(names))
+	for _, name := range names {
+		b, h := baseByName[name], headByName[name]
+		jc := jobComparison{Name: name}
+		var baseSteps, headSteps []compareStep
+		if b != nil {
+			jc.Base = jobSide(b)
+			baseSteps = aggregateSteps(b.detail)
+		}
+		if h != nil {
+			jc.Head = jobSide(h)
+			headSteps = aggregateSteps(h.detail)
+		}
+		jc.DeltaSeconds = sideDelta(jc.Base, jc.Head)
+		jc.Steps, failing = compareSteps(workflow, name, baseSteps, headSteps, failing)
+		jobs = append(jobs, jc)
+	}
+	return jobs, failing
+}
+
+func compareSteps(workflow, job string, base, head []compareStep, failing []newlyFailingStep) ([]stepComparison, []newlyFailingStep) {
+	baseByKey := map[string]compareStep{}
+	for _, s := range base {
+		baseByKey[s.key] = s
+	}
+	headByKey := map[string]compareStep{}
+	var keys []string
+	for _, s := range head {
+		headByKey[s.key] = s
+		keys = append(keys, s.key)
+	}
+	for _, s := range base {
+		if _, ok := headByKey[s.key]; !ok {
+			keys = append(keys, s.key)
+		}
+	}
+
+	steps := make([]stepComparison, 0, len(keys))
+	for _, key := range keys {
+		b, inBase := baseByKey[key]
+		h, inHead := headByKey[key]
+		sc := stepComparison{}
+		if inBase {
+			sc.Name = b.name
+			sc.Base = &compareSide{Status: b.status, DurationSeconds: b.duration}
+		}
+		if inHead {
+			sc.Name = h.name
+			sc.Head = &compareSide{Status: h.status, DurationSeconds: h.duration}
+			if h.failed && (!inBase || !b.failed) {
+				failing = append(failing, newlyFailingStep{
+					Workflow: workflow, Job: job, Step: h.name, ExitCode: h.exitCode,
+				})
+			}
+		}
+		sc.DeltaSeconds = sideDelta(sc.Base, sc.Head)
+		steps = append(steps, sc)
+	}
+	return steps, failing
+}
+
+// compareStep is one step of a job folded across its parallel executions: it
+// failed if any execution's copy failed, and took as long as the slowest copy,
+// since that is what held the job up.
+type compareStep struct {
+	key      string
+	name     string
+	status   string
+	failed   bool
+	exitCode *int
+	duration *float64
+}
+
+func aggregateSteps(detail *apiclient.JobV3) []compareStep {
+	if detail == nil {
+		return nil
+	}
+	var steps []compareStep
+	index := map[string]int{}
+	for _, exec := range detail.Executions {
+		// The same step name can legitimately appear twice in one job (two
+		// "run tests" steps, say), so the nth occurrence is its own key.
+		seen := map[string]int{}
+		for _, s := range exec.Steps {
+			seen[s.Name]++
+			key := s.Name
+			if n := seen[s.Name]; n > 1 {
+				key = fmt.Sprintf("%s#%d", s.Name, n)
+			}
+			var dur *float64
+			if s.StoppedAt != nil {
+				dur = new(s.StoppedAt.Sub(s.StartedAt).Seconds())
+			}
+			failed := s.Outcome == "failed"
+
+			i, ok := index[key]
+			if !ok {
+				index[key] = len(steps)
+				steps = append(steps, compareStep{
+					key:      key,
+					name:     s.Name,
+					status:   apiclient.PhaseOutcomeText(s.Phase, s.Outcome, ""),
+					failed:   failed,
+					exitCode: s.ExitCode,
+					duration: dur,
+				})
+				continue
+			}
+			st := &steps[i]
+			if failed && !st.failed {
+				st.failed = true
+				st.status = apiclient.PhaseOutcomeText(s.Phase, s.Outcome, "")
+				st.exitCode = s.ExitCode
+			}
+			if dur != nil && (st.duration == nil || *dur > *st.duration) {
+				st.duration = dur
+			}
+		}
+	}
+	return steps
+}
+
+func compareRunSide(s runSnapshot) compareRunOutput {
+	r := s.run
+	state := runGetOutput{Phase: r.Phase, Outcome: r.Outcome, CurrentOutcome: r.CurrentOutcome}
+	var start, end time.Time
+	ended := len(s.workflows) > 0
+	for _, wf := range s.workflows {
+		w := wf.workflow
+		state.Workflows = append(state.Workflows, workflowOutput{
+			Phase: w.Phase, Outcome: w.Outcome, CurrentOutcome: w.CurrentOutcome,
+		})
+		if start.IsZero() || w.CreatedAt.Before(start) {
+			start = w.CreatedAt
+		}
+		if w.EndedAt == nil {
+			ended = false
+		} else if w.EndedAt.After(end) {
+			end = *w.EndedAt
+		}
+	}
+
+	revision := r.Revision
+	if len(revision) > 7 {
+		revision = revision[:7]
+	}
+	out := compareRunOutput{
+		ID:       r.ID.String(),
+		Branch:   r.Branch,
+		Tag:      r.Tag,
+		Revision: revision,
+		Status:   watchRunStatus(state),
+	}
+	if ended {
+		out.DurationSeconds = new(end.Sub(start).Seconds())
+	}
+	return out
+}
+
+func workflowSide(w apiclient.WorkflowV3) *compareSide {
+	side := &compareSide{Status: apiclient.PhaseOutcomeText(w.Phase, w.Outcome, w.CurrentOutcome)}
+	if w.EndedAt != nil {
+		side.DurationSeconds = new(w.EndedAt.Sub(w.CreatedAt).Seconds())
+	}
+	return side
+}
+
+func jobSide(j *snapshotJob) *compareSide {
+	side := &compareSide{
+		Status:        apiclient.PhaseOutcomeText(j.job.Phase, j.job.Outcome, j.job.CurrentOutcome),
+		ResourceClass: j.resourceClass,
+	}
+	if j.job.StartedAt != nil && j.job.EndedAt != nil {
+		side.DurationSeconds = new(j.job.EndedAt.Sub(*j.job.StartedAt).Seconds())
+	}
+	return side
+}
+
+func sideDelta(base, head *compareSide) *float64 {
+	if base == nil || head == nil {
+		return nil
+	}
+	return deltaSeconds(base.DurationSeconds, head.DurationSeconds)
+}
+
+func deltaSeconds(base, head *float64) *float64 {
+	if base == nil || head == nil {
+		return nil
+	}
+	return new(*head - *base)
+}
+
+func compareMarkdown(out compareOutput) string {
+	var md strings.Builder
+	md.WriteString("# Run comparison\n")
+	_, _ = fmt.Fprintf(&md, "- Base: %s\n", compareRunLine(out.Base))
+	_, _ = fmt.Fprintf(&md, "- Head: %s\n", compareRunLine(out.Head))
+	if out.DeltaSeconds != nil {
+		_, _ = fmt.Fprintf(&md, "- Duration change: %s\n", formatDelta(out.DeltaSeconds))
+	}
+	md.WriteString("\n")
+
+	if len(out.Workflows) == 0 {
+		md.WriteString("Neither run has any workflows.\n")
+		return md.String()
+	}
+
+	md.WriteString("## Jobs\n")
+	jobs := mdtable.New("Workflow", "Job", "Outcome", "Base", "Head", "Change", "Resource class")
+	for _, wf := range out.Workflows {
+		for _, j := range wf.Jobs {
+			jobs.Row(wf.Name, j.Name,
+				compareTransition(j.Base, j.Head, func(s *compareSide) string { return s.Status }),
+				sideDuration(j.Base), sideDuration(j.Head), formatDelta(j.DeltaSeconds),
+				resourceClassTransition(j.Base, j.Head))
+		}
+	}
+	md.WriteString(jobs.Render())
+
+	if len(out.NewlyFailingSteps) > 0 {
+		md.WriteString("\n## Newly failing steps\n")
+		failing := mdtable.New("Workflow", "Job", "Step", "Exit code")
+		for _, s := range out.NewlyFailingSteps {
+			exit := ""
+			if s.ExitCode != nil {
+				exit = fmt.Sprintf("%d", *s.ExitCode)
+			}
+			failing.Row(s.Workflow, s.Job, s.Step, exit)
+		}
+		md.WriteString(failing.Render())
+	}
+
+	if movers := stepMovers(out); len(movers) > 0 {
+		md.WriteString("\n## Largest step duration changes\n")
+		steps := mdtable.New("Workflow", "Job", "Step", "Base", "Head", "Change")
+		for _, m := range movers {
+			steps.Row(m.workflow, m.job, m.step.Name,
+				sideDuration(m.step.Base), sideDuration(m.step.Head), formatDelta(m.step.DeltaSeconds))
+		}
+		md.WriteString(steps.Render())
+	}
+	return md.String()
+}
+
+type stepMover struct {
+	workflow string
+	job      string
+	step     stepComparison
+}
+
+// stepMovers returns the steps whose duration changed most, largest first.
+func stepMovers(out compareOutput) []stepMover {
+	var movers []stepMover
+	for _, wf := range out.Workflows {
+		for _, j := range wf.Jobs {
+			for _, s := range j.Steps {
+				if s.DeltaSeconds != nil && roundSeconds(*s.DeltaSeconds) != 0 {
+					movers = append(movers, stepMover{workflow: wf.Name, job: j.Name, step: s})
+				}
+			}
+		}
+	}
+	sort.SliceStable(movers, func(a, b int) bool {
+		da, db := *movers[a].step.DeltaSeconds, *movers[b].step.DeltaSeconds
+		if da < 0 {
+			da = -da
+		}
+		if db < 0 {
+			db = -db
+		}
+		return da > db
+	})
+	if len(movers) > compareTopSteps {
+		movers = movers[:compareTopSteps]
+	}
+	return movers
+}
+
+func compareRunLine(r compareRunOutput) string {
+	ref := r.Branch
+	if r.Tag != "" {
+		ref = r.Tag
+	}
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "`%s`", r.ID)
+	switch {
+	case ref != "" && r.Revision != "":
+		_, _ = fmt.Fprintf(&b, " (%s @ %s)", ref, r.Revision)
+	case ref != "":
+		_, _ = fmt.Fprintf(&b, " (%s)", ref)
+	}
+	_, _ = fmt.Fprintf(&b, " — %s", r.Status)
+	if r.DurationSeconds != nil {
+		_, _ = fmt.Fprintf(&b, " in %s", formatSeconds(*r.DurationSeconds))
+	}
+	return b.String()
+}
+
+// compareTransition renders one attribute across the two runs: the value when
+// unchanged, "a → b" when it changed, and "—" for a side the job is missing
+// from.
+func compareTransition(base, head *compareSide, field func(*compareSide) string) string {
+	b, h := "—", "—"
+	if base != nil {
+		b = field(base)
+	}
+	if head != nil {
+		h = field(head)
+	}
+	if b == h {
+		return b
+	}
+	return b + " → " + h
+}
+
+// resourceClassTransition is compareTransition for the resource class, which
+// is only known for jobs that ran an executor: an unknown class on either side
+// is not a change worth an arrow.
+func resourceClassTransition(base, head *compareSide) string {
+	var b, h string
+	if base != nil {
+		b = base.ResourceClass
+	}
+	if head != nil {
+		h = head.ResourceClass
+	}
+	switch {
+	case b == "" || b == h:
+		return h
+	case h == "":
+		return b
+	default:
+		return b + " → " + h
+	}
+}
+
+func sideDuration(s *compareSide) string {
+	if s == nil || s.DurationSeconds == nil {
+		return "—"
+	}
+	return formatSeconds(*s.DurationSeconds)
+}
+
+func formatSeconds(sec float64) string {
+	return ui.FormatElapsed(time.Duration(sec * float64(time.Second)))
+}
+
+func roundSeconds(sec float64) time.Duration {
+	return time.Duration(sec * float64(time.Second)).Round(time.Second)
+}
+
+// formatDelta renders a head-minus-base duration with an explicit sign, so a
+// slowdown reads "+28s" and a speedup "-3s".
+func formatDelta(sec *float64) string {
+	if sec == nil {
+		return ""
+	}
+	d := roundSeconds(*sec)
+	switch {
+	case d > 0:
+		return "+" + ui.FormatElapsed(d)
+	case d < 0:
+		return "-" + ui.FormatElapsed(-d)
+	default:
+		return "0s"
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+)
+
+// TestAggregateSteps verifies parallel executions fold into one row per step:
+// the slowest copy's duration, and failed if any copy failed.
+func TestAggregateSteps(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	step := func(name, outcome string, secs int) apiclient.JobV3Step {
+		return apiclient.JobV3Step{
+			Name: name, Phase: apiclient.PhaseEnded, Outcome: outcome,
+			StartedAt: start, StoppedAt: new(start.Add(time.Duration(secs) * time.Second)),
+		}
+	}
+	detail := &apiclient.JobV3{Executions: []apiclient.JobV3Execution{
+		{Index: 0, Steps: []apiclient.JobV3Step{step("Run tests", "succeeded", 40), step("Run tests", "succeeded", 5)}},
+		{Index: 1, Steps: []apiclient.JobV3Step{step("Run tests", "failed", 30), step("Run tests", "succeeded", 9)}},
+	}}
+
+	steps := aggregateSteps(detail)
+	assert.Assert(t, is.Len(steps, 2))
+
+	assert.Check(t, is.Equal(steps[0].key, "Run tests"))
+	assert.Check(t, is.Equal(*steps[0].duration, 40.0))
+	assert.Check(t, steps[0].failed)
+	assert.Check(t, is.Equal(steps[0].status, "failed"))
+
+	assert.Check(t, is.Equal(steps[1].key, "Run tests#2"))
+	assert.Check(t, is.Equal(*steps[1].duration, 9.0))
+	assert.Check(t, !steps[1].failed)
+
+	assert.Check(t, is.Len(aggregateSteps(nil), 0))
+}
+
+func TestFormatDelta(t *testing.T) {
+	assert.Check(t, is.Equal(formatDelta(nil), ""))
+	assert.Check(t, is.Equal(formatDelta(new(28.4)), "+28s"))
+	assert.Check(t, is.Equal(formatDelta(new(-63.0)), "-1m3s"))
+	assert.Check(t, is.Equal(formatDelta(new(0.2)), "0s"))
+}
+
+// TestCompareRuns_LatestAttempt verifies a rerun workflow, and a rerun job
+// within one, is compared once, by its latest attempt.
+func TestCompareRuns_LatestAttempt(t *testing.T) {
+	at := func(min int) time.Time { return time.Date(2026, 3, 1, 12, min, 0, 0, time.UTC) }
+	job := func(name, outcome string, started *time.Time) *snapshotJob {
+		return &snapshotJob{job: apiclient.WorkflowJobV3{
+			Name: name, Phase: apiclient.PhaseEnded, Outcome: outcome, StartedAt: started,
+		}}
+	}
+	wf := func(name, outcome string, created int, jobs ...*snapshotJob) snapshotWorkflow {
+		return snapshotWorkflow{
+			workflow: apiclient.WorkflowV3{Name: name, Phase: apiclient.PhaseEnded, Outcome: outcome, CreatedAt: at(created)},
+			jobs:     jobs,
+		}
+	}
+	base := runSnapshot{run: &apiclient.RunV3{}, workflows: []snapshotWorkflow{
+		wf("build", "succeeded", 0, job("test", "succeeded", new(at(1)))),
+	}}
+	head := runSnapshot{run: &apiclient.RunV3{}, workflows: []snapshotWorkflow{
+		wf("build", "succeeded", 10,
+			job("test", "succeeded", new(at(12))),
+			job("test", "failed", new(at(11))),
+			job("test", "blocked", nil)),
+		wf("build", "failed", 0, job("test", "failed", new(at(1)))),
+	}}
+
+	out := compareRuns(base, head)
+
+	assert.Assert(t, is.Len(out.Workflows, 1))
+	assert.Check(t, is.Equal(out.Workflows[0].Head.Status, "succeeded"))
+	assert.Assert(t, is.Len(out.Workflows[0].Jobs, 1))
+	assert.Check(t, is.Equal(out.Workflows[0].Jobs[0].Head.Status, "succeeded"))
+}
@@ -25,23 +25,267 @@ package run
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
 	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/artifacts"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/failureclass"
 	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
 )
 
-// runFailureReport prints condensed output for every failed step in the run,
-// organised as workflow → job → execution → step headers. It is the output path
-// for --failure-report and never enters the TUI.
+// failureReportSchemaVersion versions the --failure-report --json document and
+// stream records. It is bumped when a field is removed or changes meaning;
+// adding a field does not bump it, so consumers should ignore unknown fields.
+const failureReportSchemaVersion = 1
+
+// maxReportTestFailures caps the test failures listed per job. A broken build
+// can fail thousands of tests with the same message; test_failure_count still
+// reports the full number.
+const maxReportTestFailures = 50
+
+// failureReportOptions selects how --failure-report is rendered.
+type failureReportOptions struct {
+	Patterns *failureclass.Library
+	JSON     bool
+	Stream   bool
+}
+
+// failureReport is the --failure-report --json document. Only workflows and
+// jobs with at least one failed step appear in it.
+type failureReport struct {
+	SchemaVersion int                     `json:"schema_version"`
+	Run           failureReportRun        `json:"run"`
+	Workflows     []failureReportWorkflow `json:"workflows"`
+}
+
+type failureReportRun struct {
+	ID       uuid.UUID `json:"id"`
+	Status   string    `json:"status"`
+	Branch   string    `json:"branch,omitempty"`
+	Tag      string    `json:"tag,omitempty"`
+	Revision string    `json:"revision,omitempty"`
+	URL      string    `json:"url"`
+}
+
+type failureReportWorkflow struct {
+	ID     uuid.UUID          `json:"id"`
+	Name   string             `json:"name"`
+	Status string             `json:"status"`
+	URL    string             `json:"url"`
+	Jobs   []failureReportJob `json:"jobs,omitempty"`
+}
+
+type failureReportJob struct {
+	ID     uuid.UUID `json:"id"`
+	Name   string    `json:"name"`
+	Status string    `json:"status"`
+	URL    string    `json:"url"`
+	// Executions is the job's total parallelism, so a consumer can tell one
+	// failed execution of many from a job that failed everywhere.
+	Executions       int                      `json:"executions"`
+	FailedExecutions []failureReportExecution `json:"failed_executions"`
+	TestFailureCount int                      `json:"test_failure_count"`
+	TestFailures     []failureReportTest      `json:"test_failures"`
+	Artifacts        []artifacts.Entry        `json:"artifacts"`
+}
+
+type failureReportExecution struct {
+	Index       int                 `json:"index"`
+	FailedSteps []failureReportStep `json:"failed_steps"`
+}
+
+type failureReportStep struct {
+	Num            int                          `json:"num"`
+	Name           string                       `json:"name"`
+	ExitCode       *int                         `json:"exit_code,omitempty"`
+	StartedAt      time.Time                    `json:"started_at"`
+	StoppedAt      *time.Time                   `json:"stopped_at,omitempty"`
+	Output         string                       `json:"output"`
+	Classification *failureclass.Classification `json:"classification"`
+}
+
+type failureReportTest struct {
+	Classname string  `json:"classname"`
+	Name      string  `json:"name"`
+	Message   string  `json:"message,omitempty"`
+	RunTime   float64 `json:"run_time"`
+}
+
+// failureReportRecord is one line of the --failure-report --stream output: a
+// leading "run" record, then one "job" record per failed job carrying its
+// workflow, so each line stands on its own.
+type failureReportRecord struct {
+	SchemaVersion int                    `json:"schema_version"`
+	Type          string                 `json:"type"`
+	Run           *failureReportRun      `json:"run,omitempty"`
+	Workflow      *failureReportWorkflow `json:"workflow,omitempty"`
+	Job           *failureReportJob      `json:"job,omitempty"`
+}
+
+// classifiedStep is one entry of the markdown report's closing JSON block:
+// where the failed step is, and what the classifier made of it.
+type classifiedStep struct {
+	Workflow  string    `json:"workflow"`
+	Job       string    `json:"job"`
+	JobID     uuid.UUID `json:"job_id"`
+	Execution int       `json:"execution"`
+	Step      int       `json:"step"`
+	StepName  string    `json:"step_name"`
+	ExitCode  *int      `json:"exit_code,omitempty"`
+	*failureclass.Classification
+}
+
+// runFailureReport prints every failed step in the run, organised as workflow →
+// job → execution → step, in the form opts selects.
+//
+// As markdown, each step shows its class, suggested action and condensed
+// output, and the classes are collected again in a closing JSON block so a
+// consumer need not scrape the markdown. As JSON it is a failureReport document,
+// which also carries each job's failed tests and artifacts; with Stream the same
+// data is written as JSONL records as each job is fetched.
 //
 // Output is written to stdout so it can be piped directly into an agent's
-// context window. Empty output (no failed steps, or run not in a failed state)
-// is valid and exits 0.
-func runFailureReport(ctx context.Context, client *apiclient.Client, r *apiclient.RunV3) error {
+// context window. Empty markdown output (no failed steps, or run not in a failed
+// state) is valid and exits 0.
+func runFailureReport(ctx context.Context, client *apiclient.Client, r *apiclient.RunV3, opts failureReportOptions) error {
+	appURL, err := cmdutil.AppURL(ctx)
+	if err != nil {
+		return err
+	}
+	run := failureReportRun{
+		ID:       r.ID,
+		Status:   apiclient.PhaseOutcomeText(r.Phase, r.Outcome, r.CurrentOutcome),
+		Branch:   r.Branch,
+		Tag:      r.Tag,
+		Revision: r.Revision,
+		URL:      cmdutil.RunURL(appURL, r.ID),
+	}
+
+	switch {
+	case opts.Stream:
+		return iostream.PrintJSONStream(ctx, func(emit func(any) error) error {
+			if err := emit(failureReportRecord{SchemaVersion: failureReportSchemaVersion, Type: "run", Run: &run}); err != nil {
+				return err
+			}
+			return collectFailureReport(ctx, client, r, opts.Patterns, appURL, true,
+				func(wf failureReportWorkflow, job failureReportJob) error {
+					return emit(failureReportRecord{SchemaVersion: failureReportSchemaVersion, Type: "job", Workflow: &wf, Job: &job})
+				})
+		})
+
+	case opts.JSON:
+		report := failureReport{SchemaVersion: failureReportSchemaVersion, Run: run, Workflows: []failureReportWorkflow{}}
+		err := collectFailureReport(ctx, client, r, opts.Patterns, appURL, true,
+			func(wf failureReportWorkflow, job failureReportJob) error {
+				if n := len(report.Workflows); n == 0 || report.Workflows[n-1].ID != wf.ID {
+					report.Workflows = append(report.Workflows, wf)
+				}
+				last := &report.Workflows[len(report.Workflows)-1]
+				last.Jobs = append(last.Jobs, job)
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+		return iostream.PrintJSON(ctx, report)
+	}
+
+	var (
+		out        strings.Builder
+		classified []classifiedStep
+		lastWf     uuid.UUID
+	)
+	err = collectFailureReport(ctx, client, r, opts.Patterns, appURL, false,
+		func(wf failureReportWorkflow, job failureReportJob) error {
+			// Write the workflow header the first time it has output.
+			if wf.ID != lastWf {
+				fmt.Fprintf(&out, "## workflow: %s\n\n", wf.Name)
+				lastWf = wf.ID
+			}
+			for _, fe := range job.FailedExecutions {
+				// Job header: include execution index and failure count only for
+				// parallel jobs (more than one execution total).
+				if job.Executions > 1 {
+					fmt.Fprintf(&out, "### job: %s (execution %d, %d of %d failed)\n\n",
+						job.Name, fe.Index, len(job.FailedExecutions), job.Executions)
+				} else {
+					fmt.Fprintf(&out, "### job: %s\n\n", job.Name)
+				}
+				for _, step := range fe.FailedSteps {
+					writeFailedStep(&out, step)
+					classified = append(classified, classifiedStep{
+						Workflow:       wf.Name,
+						Job:            job.Name,
+						JobID:          job.ID,
+						Execution:      fe.Index,
+						Step:           step.Num,
+						StepName:       step.Name,
+						ExitCode:       step.ExitCode,
+						Classification: step.Classification,
+					})
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	if len(classified) > 0 {
+		data, err := json.MarshalIndent(classified, "", "  ")
+		if err != nil {
+			return err
+		}
+		out.WriteString("## classification\n\n```json\n")
+		out.Write(data)
+		out.WriteString("\n```\n")
+	}
+
+	if out.Len() > 0 {
+		iostream.Print(ctx, out.String())
+	}
+	return nil
+}
+
+func writeFailedStep(out *strings.Builder, step failureReportStep) {
+	if step.ExitCode != nil {
+		fmt.Fprintf(out, "#### step %d: %s [exit: %d]\n\n", step.Num, step.Name, *step.ExitCode)
+	} else {
+		fmt.Fprintf(out, "#### step %d: %s\n\n", step.Num, step.Name)
+	}
+	class := step.Classification
+	if class.Evidence != "" {
+		fmt.Fprintf(out, "- class: `%s` (%s; %s)\n", class.Class, class.Title, class.Evidence)
+	} else {
+		fmt.Fprintf(out, "- class: `%s`\n", class.Class)
+	}
+	fmt.Fprintf(out, "- suggested action: %s\n\n", class.Action)
+
+	if strings.TrimSpace(step.Output) == "" {
+		out.WriteString("(no output)\n\n")
+		return
+	}
+	out.WriteString(step.Output)
+	if !strings.HasSuffix(step.Output, "\n") {
+		out.WriteString("\n")
+	}
+	out.WriteString("\n")
+}
+
+// collectFailureReport walks the run's workflows and jobs in order and calls fn
+// once for every job with at least one failed step, with that job's failed
+// executions fetched and classified. details adds the job's failed tests and
+// artifacts, which only the JSON forms report.
+func collectFailureReport(ctx context.Context, client *apiclient.Client, r *apiclient.RunV3, lib *failureclass.Library, appURL string, details bool,
+	fn func(failureReportWorkflow, failureReportJob) error) error {
 	workflows, err := client.GetRunWorkflowsV3(ctx, r.ID)
 	if err != nil {
 		if httpcl.HasStatusCode(err, http.StatusNotFound) {
@@ -50,97 +294,182 @@ func runFailureReport(ctx context.Context, client *apiclient.Client, r *apiclien
 		return apiErr(err, r.ID.String())
 	}
 
-	var out strings.Builder
-
 	for _, wf := range workflows {
-		wfWritten := false
-
 		jobs, err := client.GetWorkflowJobsV3(ctx, wf.ID)
 		if err != nil {
 			return apiErr(err, wf.ID.String())
 		}
+		wfOut := failureReportWorkflow{
+			ID:     wf.ID,
+			Name:   wf.Name,
+			Status: apiclient.PhaseOutcomeText(wf.Phase, wf.Outcome, wf.CurrentOutcome),
+			URL:    cmdutil.WorkflowURL(appURL, wf.ID),
+		}
 
 		for _, j := range jobs {
-			jobDetail, err := client.GetJobV3(ctx, j.ID)
+			job, err := failedJobReport(ctx, client, lib, wf.ID, j, appURL, details)
 			if err != nil {
-				if httpcl.HasStatusCode(err, http.StatusNotFound) {
-					continue
-				}
-				return apiErr(err, j.ID.String())
-			}
-
-			// Collect executions that contain at least one failed step.
-			type failedExec struct {
-				exec  apiclient.JobV3Execution
-				steps []apiclient.JobV3Step // only the failed steps
+				return err
 			}
-			var failed []failedExec
-			for _, exec := range jobDetail.Executions {
-				var failedSteps []apiclient.JobV3Step
-				for _, step := range exec.Steps {
-					if step.Outcome == "failed" {
-						failedSteps = append(failedSteps, step)
-					}
-				}
-				if len(failedSteps) > 0 {
-					failed = append(failed, failedExec{exec: exec, steps: failedSteps})
-				}
-			}
-			if len(failed) == 0 {
+			if job == nil {
 				continue
 			}
-
-			// Lazy-write the workflow header the first time we have output for it.
-			if !wfWritten {
-				fmt.Fprintf(&out, "## workflow: %s\n\n", wf.Name)
-				wfWritten = true
+			if err := fn(wfOut, *job); err != nil {
+				return err
 			}
+		}
+	}
+	return nil
+}
 
-			totalExecs := len(jobDetail.Executions)
-			failedCount := len(failed)
+// failedJobReport fetches one job's failed steps, or returns nil when the job
+// has none (or never ran, so the API has no detail for it).
+func failedJobReport(ctx context.Context, client *apiclient.Client, lib *failureclass.Library, workflowID uuid.UUID, j apiclient.WorkflowJobV3, appURL string, details bool) (*failureReportJob, error) {
+	jobDetail, err := client.GetJobV3(ctx, j.ID)
+	if err != nil {
+		if httpcl.HasStatusCode(err, http.StatusNotFound) {
+			return nil, nil
+		}
+		return nil, apiErr(err, j.ID.String())
+	}
 
-			for _, fe := range failed {
-				// Job header: include execution index and failure count only for
-				// parallel jobs (more than one execution total).
-				if totalExecs > 1 {
-					fmt.Fprintf(&out, "### job: %s (execution %d, %d of %d failed)\n\n",
-						j.Name, fe.exec.Index, failedCount, totalExecs)
-				} else {
-					fmt.Fprintf(&out, "### job: %s\n\n", j.Name)
-				}
+	// Collect executions that contain at least one failed step.
+	type failedExec struct {
+		exec  apiclient.JobV3Execution
+		steps []apiclient.JobV3Step // only the failed steps
+	}
+	var failed []failedExec
+	for _, exec := range jobDetail.Executions {
+		var failedSteps []apiclient.JobV3Step
+		for _, step := range exec.Steps {
+			if step.Outcome == "failed" {
+				failedSteps = append(failedSteps, step)
+			}
+		}
+		if len(failedSteps) > 0 {
+			failed = append(failed, failedExec{exec: exec, steps: failedSteps})
+		}
+	}
+	if len(failed) == 0 {
+		return nil, nil
+	}
+
+	// Resource usage is only needed to spot an OOM kill, so it is fetched once
+	// per failed job; a job that never ran an executor has none.
+	usage, err := client.GetJobResourceUsage(ctx, j.ID)
+	if err != nil {
+		if !httpcl.HasStatusCode(err, http.StatusNotFound) {
+			return nil, apiErr(err, j.ID.String())
+		}
+		usage = nil
+	}
 
-				for _, step := range fe.steps {
-					if step.ExitCode != nil {
-						fmt.Fprintf(&out, "#### step %d: %s [exit: %d]\n\n", step.Num, step.Name, *step.ExitCode)
-					} else {
-						fmt.Fprintf(&out, "#### step %d: %s\n\n", step.Num, step.Name)
-					}
-
-					condensed, err := client.GetJobStdoutCondensed(ctx, j.ID, fe.exec.Index, step.Num)
-					if err != nil {
-						if httpcl.HasStatusCode(err, http.StatusNotFound) {
-							condensed = nil
-						} else {
-							return apiErr(err, fmt.Sprintf("step %d of job %s", step.Num, j.ID))
-						}
-					}
-
-					if len(bytes.TrimSpace(condensed)) == 0 {
-						out.WriteString("(no output)\n\n")
-					} else {
-						out.Write(condensed)
-						if !bytes.HasSuffix(condensed, []byte("\n")) {
-							out.WriteString("\n")
-						}
-						out.WriteString("\n")
-					}
+	job := &failureReportJob{
+		ID:           j.ID,
+		Name:         j.Name,
+		Status:       apiclient.PhaseOutcomeText(j.Phase, j.Outcome, j.CurrentOutcome),
+		URL:          cmdutil.JobURL(appURL, workflowID, j.ID),
+		Executions:   len(jobDetail.Executions),
+		TestFailures: []failureReportTest{},
+		Artifacts:    []artifacts.Entry{},
+	}
+	for _, fe := range failed {
+		execOut := failureReportExecution{Index: fe.exec.Index}
+		for _, step := range fe.steps {
+			condensed, err := client.GetJobStdoutCondensed(ctx, j.ID, fe.exec.Index, step.Num)
+			if err != nil {
+				if !httpcl.HasStatusCode(err, http.StatusNotFound) {
+					return nil, apiErr(err, fmt.Sprintf("step %d of job %s", step.Num, j.ID))
 				}
+				condensed = nil
 			}
+
+			cs := failureclass.Step{Output: condensed, ExitCode: step.ExitCode}
+			cs.MemoryPeakBytes, cs.MemoryLimitBytes = stepMemoryPeak(usage, fe.exec, step)
+			execOut.FailedSteps = append(execOut.FailedSteps, failureReportStep{
+				Num:            step.Num,
+				Name:           step.Name,
+				ExitCode:       step.ExitCode,
+				StartedAt:      step.StartedAt,
+				StoppedAt:      step.StoppedAt,
+				Output:         string(bytes.TrimRight(condensed, "\n")),
+				Classification: lib.Classify(cs),
+			})
 		}
+		job.FailedExecutions = append(job.FailedExecutions, execOut)
 	}
 
-	if out.Len() > 0 {
-		iostream.Print(ctx, out.String())
+	if !details {
+		return job, nil
 	}
-	return nil
+
+	err = client.StreamJobTests(ctx, j.ID, func(tr apiclient.TestResult) {
+		if tr.Result != "failure" {
+			return
+		}
+		job.TestFailureCount++
+		if len(job.TestFailures) < maxReportTestFailures {
+			job.TestFailures = append(job.TestFailures, failureReportTest{
+				Classname: tr.Classname,
+				Name:      tr.Name,
+				Message:   tr.Message,
+				RunTime:   tr.RunTime,
+			})
+		}
+	})
+	if err != nil && !httpcl.HasStatusCode(err, http.StatusNotFound) {
+		return nil, apiErr(err, j.ID.String())
+	}
+
+	entries, err := artifacts.ForJob(ctx, client, j.ID.String())
+	if err != nil && !httpcl.HasStatusCode(err, http.StatusNotFound) {
+		return nil, apiErr(err, j.ID.String())
+	}
+	if len(entries) > 0 {
+		job.Artifacts = entries
+	}
+	return job, nil
+}
+
+// stepMemoryPeak returns the highest memory sample taken while step ran, and the
+// resource class limit to read it against. Samples are indexed from the start
+// of the execution, which is taken to be its earliest step's start. One sample
+// past the step's end is included, since a process killed for memory is often
+// only caught by the next sample. Both values are zero when usage is nil or has
+// no series for the execution.
+func stepMemoryPeak(usage *apiclient.JobResourceUsage, exec apiclient.JobV3Execution, step apiclient.JobV3Step) (peak, limit int64) {
+	if usage == nil {
+		return 0, 0
+	}
+	var series *apiclient.JobResourceUsageExecution
+	for i := range usage.Executions {
+		if usage.Executions[i].Index == exec.Index {
+			series = &usage.Executions[i]
+			break
+		}
+	}
+	if series == nil || len(series.MemoryBytes) == 0 {
+		return 0, 0
+	}
+
+	from, to := 0, len(series.MemoryBytes)
+	if interval := series.Interval(); interval > 0 && !step.StartedAt.IsZero() {
+		var start time.Time
+		for _, s := range exec.Steps {
+			if !s.StartedAt.IsZero() && (start.IsZero() || s.StartedAt.Before(start)) {
+				start = s.StartedAt
+			}
+		}
+		from = int(step.StartedAt.Sub(start) / interval)
+		if step.StoppedAt != nil {
+			to = min(to, int(step.StoppedAt.Sub(start)/interval)+2)
+		}
+		if from < 0 || from >= to {
+			from, to = 0, len(series.MemoryBytes)
+		}
+	}
+	for _, m := range series.MemoryBytes[from:to] {
+		peak = max(peak, m)
+	}
+	return peak, usage.ResourceClass.MemoryLimitBytes
 }
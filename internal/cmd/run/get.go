@@ -23,7 +23,6 @@
 package run
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -31,7 +30,6 @@ import (
 	"strconv"
 	"strings"
 	"time"
-	"unicode/utf8"
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/MakeNowJust/heredoc"
@@ -48,6 +46,7 @@ import (
 	"github.com/CircleCI-Public/circleci-cli/internal/cmd/job"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmd/workflow"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/failureclass"
 	"github.com/CircleCI-Public/circleci-cli/internal/gitremote"
 	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
 	"github.com/CircleCI-Public/circleci-cli/internal/ui"
@@ -72,6 +71,7 @@ func newGetCmd() *cobra.Command {
 		mine          bool
 		noInteractive bool
 		failureReport bool
+		stream        bool
 	)
 
 	cmd := &cobra.Command{
@@ -89,14 +89,19 @@ func newGetCmd() *cobra.Command {
 		Long: heredoc.Doc(`
 			Display the status of a CircleCI run and its workflows.
 
-			With no run UUID in an interactive terminal, a picker walks you through the
-			recent runs; --no-interactive, --json, or a non-interactive session skips it
-			and resolves the latest run directly.
+			With no run UUID in an interactive terminal, a picker walks you through recent
+			runs; --no-interactive, --json or a non-interactive session skips it.
+
+			--failure-report classes come from built-in rules and failure-patterns.yml beside
+			the config file (~/.config/circleci/): "rules": [{class, title, action, patterns,
+			exit_codes, memory_at_limit, disable}]; a rule replaces its class's built-in one.
 
 			JSON fields: id, phase, outcome, current_outcome, branch, tag, revision,
 			repository_url, commit.subject/url/author_name/author_login, created_at,
 			errors[].type/message, workflows[].id/name/phase/outcome/current_outcome/duration/
 			jobs[].id/name/phase/outcome/current_outcome/type
+			--failure-report --json fields (schema_version 1, bumped only when a field is removed or changes meaning): schema_version, run.id/status/branch/tag/revision/url, workflows[].id/name/status/url/jobs[].id/name/status/url/executions/test_failure_count/test_failures[].classname/name/message/run_time/artifacts[].path/url/execution/failed_executions[].index/failed_steps[].num/name/exit_code/started_at/stopped_at/output/classification.class/title/suggested_action/evidence
+			--stream records: {schema_version, type: "run", run}, then {schema_version, type: "job", workflow, job} per failed job
 		`),
 		Example: heredoc.Doc(`
 			# Get the latest run for the current branch
@@ -117,17 +122,33 @@ func newGetCmd() *cobra.Command {
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
-			if failureReport && (jsonOut || cmd.Flags().Changed("jq")) {
-				return clierrors.New("run.failure_report_no_json",
-					"--failure-report cannot be combined with --json or --jq",
-					"--failure-report prints plain-text output for agent consumption and does not support JSON formatting.").
+			if stream && !failureReport {
+				return clierrors.New("run.stream_requires_failure_report",
+					"--stream requires --failure-report",
+					"--stream only applies to --failure-report, which it writes as JSONL.").
+					WithSuggestions("circleci run get --failure-report --stream").
 					WithExitCode(clierrors.ExitBadArguments)
 			}
+			var report *failureReportOptions
+			if failureReport {
+				path := failureclass.Path(cmdutil.ConfigPath(cmd))
+				lib, err := failureclass.Load(path)
+				if err != nil {
+					return clierrors.New("run.invalid_failure_patterns", "Invalid failure pattern file", err.Error()).
+						WithSuggestions("Fix or remove " + path).
+						WithExitCode(clierrors.ExitBadArguments)
+				}
+				report = &failureReportOptions{
+					Patterns: lib,
+					JSON:     jsonOut || cmd.Flags().Changed("jq"),
+					Stream:   stream,
+				}
+			}
 			client, err := cmdutil.LoadClient(ctx)
 			if err != nil {
 				return err
 			}
-			return runGet(ctx, client, args, projectSlug, branch, jsonOut, mine, noInteractive, failureReport)
+			return runGet(ctx, client, args, projectSlug, branch, jsonOut, mine, noInteractive, report)
 		},
 	}
 
@@ -135,7 +156,8 @@ func newGetCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch name (defaults to the current branch, or main when --project is set)")
 	cmd.Flags().BoolVarP(&mine, "mine", "m", false, "Filter to runs owned by you.")
 	cmd.Flags().BoolVar(&noInteractive, "no-interactive", false, "Skip the interactive picker and resolve the latest run directly")
-	cmd.Flags().BoolVar(&failureReport, "failure-report", false, "Print condensed output for every failed step; intended for agent consumption")
+	cmd.Flags().BoolVar(&failureReport, "failure-report", false, "Print condensed, classified output for every failed step; with --json, a versioned JSON report")
+	cmd.Flags().BoolVar(&stream, "stream", false, "Stream the --failure-report as JSONL, one record per failed job")
 	cmdutil.AddJSONFlag(cmd, &jsonOut)
 	cmdutil.AddJQFlag(cmd)
 
@@ -205,74 +227,24 @@ type jobOutput struct {
 	Type           string    `json:"type,omitempty"`
 }
 
-func runGet(ctx context.Context, client *apiclient.Client, args []string, projectSlug, branch string, jsonOut, mine, noInteractive, failureReport bool) error {
+// failureReport is nil unless --failure-report is set.
+func runGet(ctx context.Context, client *apiclient.Client, args []string, projectSlug, branch string, jsonOut, mine, noInteractive bool, failureReport *failureReportOptions) error {
 	// --failure-report always bypasses the TUI — it is an output-mode flag.
 	// With no run ID and an interactive terminal, walk the user through a series
 	// of pickers (run → workflow → job) instead of silently resolving the latest
 	// run. JSON output stays non-interactive so scripting is unaffected, and
 	// --no-interactive forces the same direct latest-run lookup in a TTY.
-	if len(args) == 0 && !jsonOut && !noInteractive && !failureReport && iostream.IsInteractive(ctx) {
+	if len(args) == 0 && !jsonOut && !noInteractive && failureReport == nil && iostream.IsInteractive(ctx) {
 		return runGetInteractive(ctx, client, projectSlug, branch, mine)
 	}
 
-	var r *apiclient.RunV3
-
-	if len(args) == 1 {
-		id, err := uuid.Parse(args[0])
-		if err != nil {
-			return err
-		}
-
-		r, err = client.GetRunV3(ctx, id)
-		if err != nil {
-			return apiErr(err, id.String())
-		}
-	} else {
-		effectiveBranch := branch
-		if projectSlug == "" {
-			// No --project: infer both the project and (when not supplied) the
-			// branch from the current git repository.
-			info, err := gitremote.Detect()
-			if err != nil {
-				return cmdutil.GitDetectErr(err, "Or provide a run UUID: circleci run get <uuid>")
-			}
-			projectSlug = info.Slug
-			if effectiveBranch == "" {
-				effectiveBranch = info.Branch
-			}
-		} else if effectiveBranch == "" {
-			// --project was given explicitly; the local branch is meaningless for a
-			// (possibly different) project, so default to "main" rather than the
-			// checked-out branch.
-			effectiveBranch = defaultBranchGuess
-		}
-
-		proj, err := client.GetProjectBySlug(ctx, projectSlug)
-		if err != nil {
-			return apiErr(err, projectSlug)
-		}
-
-		sp := iostream.Spinner(ctx, !jsonOut, fmt.Sprintf("Fetching latest run for %s on branch %s", projectSlug, effectiveBranch))
-		now := time.Now().UTC()
-		runs, searchErr := client.SearchRunsV3(ctx, apiclient.RunSearchParams{
-			ProjectIDs: []string{proj.ID.String()},
-			From:       now.AddDate(0, 0, -90),
-			To:         now,
-			Filter:     apiclient.BuildRunFilter(effectiveBranch, ""),
-			Limit:      1,
-		})
-		sp.Stop()
-		if searchErr != nil {
-			return apiErr(searchErr, fmt.Sprintf("%s@%s", projectSlug, effectiveBranch))
-		}
-		if len(runs) == 0 {
-			return apiErr(fmt.Errorf("no runs found"), fmt.Sprintf("%s@%s", projectSlug, effectiveBranch))
-		}
-		r = &runs[0]
+	r, err := resolveRun(ctx, client, args, projectSlug, branch)
+	if err != nil {
+		return err
 	}
 
-	if failureReport {
-		return runFailureReport(ctx, client, r)
+	if failureReport != nil {
+		return runFailureReport(ctx, client, r, *failureReport)
 	}
 	return displayRun(ctx, client, r, jsonOut)
 }
@@ -555,56 +527,21 @@ func artifactItems(client *apiclient.Client) func(context.Context, uuid.UUID) ([
 	}
 }
 
-// maxArtifactPreview caps how much of an artifact is pulled in to be viewed. An
-// artifact can be a multi-gigabyte build output, and the whole thing is held in
-// memory — re-wrapped on every resize for text, decoded and scaled for an image —
-// so a browse action must not be able to pull one down in full. 8 MiB clears any
-// screenshot while keeping a stray log file from filling memory; past it the
-// browser says so and points at the download key.
-const maxArtifactPreview = 8 << 20 // 8 MiB
-
 // artifactContent returns the closure the browser uses to view one artifact,
-// reporting whether the bytes are displayable text. Images are recognised by the
-// UI (which renders them as a block mosaic), so this only has to say whether the
-// bytes are safe to page verbatim.
+// reporting whether the bytes are displayable text. Past the preview cap the
+// browser says so and points at the download key.
 func artifactContent(client *apiclient.Client) func(context.Context, ui.RunGetArtifactItem) ([]byte, bool, error) {
 	return func(ctx context.Context, item ui.RunGetArtifactItem) ([]byte, bool, error) {
-		w := &cappedBuffer{limit: maxArtifactPreview}
-		if err := client.DownloadArtifact(ctx, item.URL, w); err != nil {
-			if errors.Is(err, errArtifactTooLarge) {
+		data, text, err := artifacts.Preview(ctx, client, item.URL)
+		if err != nil {
+			if errors.Is(err, artifacts.ErrTooLarge) {
 				return nil, false, fmt.Errorf("%s is over %d MiB — too large to view, press d to download it",
-					item.Path, maxArtifactPreview>>20)
+					item.Path, artifacts.MaxPreview>>20)
 			}
 			return nil, false, apiErr(err, item.Path)
 		}
-		data := w.buf.Bytes()
-		return data, displayableText(data), nil
-	}
-}
-
-// errArtifactTooLarge aborts a preview download once it passes the cap. It
-// travels back through the transport's io.Copy, so the transfer stops rather than
-// buffering a file no one is going to look at.
-var errArtifactTooLarge = errors.New("artifact is larger than the preview limit")
-
-// cappedBuffer accumulates up to limit bytes and then fails the write.
-type cappedBuffer struct {
-	buf   bytes.Buffer
-	limit int
-}
-
-func (w *cappedBuffer) Write(p []byte) (int, error) {
-	if w.buf.Len()+len(p) > w.limit {
-		return 0, errArtifactTooLarge
+		return data, text, nil
 	}
-	return w.buf.Write(p)
-}
-
-// displayableText reports whether data can be shown in the pager: valid UTF-8
-// with no NUL byte. Empty content counts as text, so an empty artifact opens to
-// an empty page rather than looking like a binary.
-func displayableText(data []byte) bool {
-	return utf8.Valid(data) && !bytes.ContainsRune(data, 0)
 }
 
 // downloadArtifacts returns the closure the browser's download key uses. Each
@@ -617,7 +554,8 @@ func downloadArtifacts(client *apiclient.Client) func(context.Context, []ui.RunG
 		for i, item := range items {
 			entries[i] = artifacts.Entry{Path: item.Path, URL: item.URL, Execution: item.Execution}
 		}
-		return artifacts.DownloadPaths(ctx, client, entries, dir)
+		_, err := artifacts.DownloadPaths(ctx, client, entries, dir, artifacts.DownloadOptions{Parallel: artifacts.DefaultParallel})
+		return err
 	}
 }
 
@@ -0,0 +1,137 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmd/job"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+)
+
+func newGrepCmd() *cobra.Command {
+	var (
+		jobNames []string
+		flags    job.SearchFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "grep <run-id> <regex>",
+		Short: "Search the step output of every job in a run",
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Docf(`
+				%[1]s<run-id>%[1]s is the run UUID. %[1]s<regex>%[1]s is a Go regular expression
+				matched against each line of each step's output.
+			`, "`"),
+		},
+		Long: heredoc.Doc(`
+			Search the stdout and stderr of every step, in every parallel execution
+			of every job in a run, and print matching lines grep-style under a
+			workflow/job/exec/step location. Jobs that never started are skipped.
+
+			JSON fields: pattern, steps_searched, matches[].workflow/job/execution/step/step_num/line_number/line/before/after
+		`),
+		Example: heredoc.Doc(`
+			# Find every container that printed an error
+			$ circleci run grep 5034460f-c7c4-4c43-9457-de07e2029e7b 'ECONNRESET|connection reset'
+
+			# Only the test job, with context
+			$ circleci run grep 5034460f-c7c4-4c43-9457-de07e2029e7b --job test -C 2 'FAIL:'
+		`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cliErr := cmdutil.RequireArgs(args, "run-id", "regex"); cliErr != nil {
+				return cliErr
+			}
+			runID, err := uuid.Parse(args[0])
+			if err != nil {
+				return apiErr(err, args[0])
+			}
+			opts, err := flags.Options(cmd, args[1])
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runGrep(ctx, client, runID, jobNames, opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&jobNames, "job", nil, "Search only jobs with this name (repeatable)")
+	flags.Add(cmd)
+
+	return cmd
+}
+
+func runGrep(ctx context.Context, client *apiclient.Client, runID uuid.UUID, jobNames []string, opts job.SearchOptions) error {
+	if _, err := client.GetRunV3(ctx, runID); err != nil {
+		return apiErr(err, runID.String())
+	}
+	workflows, err := client.GetRunWorkflowsV3(ctx, runID)
+	if err != nil && !httpcl.HasStatusCode(err, http.StatusNotFound) {
+		return apiErr(err, runID.String())
+	}
+
+	var targets []job.SearchJob
+	seen := map[string]bool{}
+	for _, wf := range workflows {
+		jobs, err := client.GetWorkflowJobsV3(ctx, wf.ID)
+		if err != nil {
+			return apiErr(err, wf.ID.String())
+		}
+		for _, j := range jobs {
+			seen[j.Name] = true
+			if j.Type == apiclient.JobTypeApproval || j.StartedAt == nil {
+				continue
+			}
+			if len(jobNames) > 0 && !slices.Contains(jobNames, j.Name) {
+				continue
+			}
+			targets = append(targets, job.SearchJob{ID: j.ID, Name: j.Name, Workflow: wf.Name})
+		}
+	}
+
+	for _, name := range jobNames {
+		if !seen[name] {
+			return clierrors.New("run.job_not_found", "Job not found",
+				fmt.Sprintf("Run %s has no job named %q.", runID, name)).
+				WithSuggestions("List the run's jobs with: circleci run get " + runID.String()).
+				WithExitCode(clierrors.ExitNotFound)
+		}
+	}
+
+	return job.SearchOutput(ctx, client, targets, opts)
+}
@@ -24,44 +24,82 @@ package run
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
 	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/clikit/jq"
 	"github.com/CircleCI-Public/circleci-cli/clikit/mdtable"
 	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
 	"github.com/CircleCI-Public/circleci-cli/internal/gitremote"
 )
 
+// runStatuses are the pipeline.status values --status accepts.
+var runStatuses = []string{
+	apiclient.StatusSuccess, apiclient.StatusFailed, apiclient.StatusFailing,
+	apiclient.StatusRunning, apiclient.StatusOnHold, apiclient.StatusQueued,
+	apiclient.StatusCanceled, apiclient.StatusError, apiclient.StatusNotRun,
+	apiclient.StatusUnauthorized,
+}
+
+// runTriggerTypes are the trigger event sources --trigger accepts.
+var runTriggerTypes = []string{"webhook", "schedule", "vcs", "api"}
+
+// runListSearchDays is how far back run list searches when --created-after is
+// not given.
+const runListSearchDays = 90
+
+// runListOptions carries run list's flags once they have been parsed.
+type runListOptions struct {
+	ProjectSlug string
+	// Org is an organization slug; when set, every followed project in it is
+	// searched instead of ProjectSlug.
+	Org     string
+	Branch  string
+	Status  string
+	SHA     string
+	Mine    bool
+	Actor   string
+	Trigger string
+	// PipelineDefinition is a pipeline definition's ID or name.
+	PipelineDefinition string
+	CreatedAfter       time.Time
+	CreatedBefore      time.Time
+	Limit              int
+	All                bool
+	JSON               bool
+}
+
 func newListCmd() *cobra.Command {
 	var (
-		projectSlug   string
-		branch        string
+		opts          runListOptions
 		currentBranch bool
-		limit         int
-		jsonOut       bool
+		createdAfter  string
+		createdBefore string
 	)
 
 	cmd := &cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
-		Short:   "List recent runs for a project",
+		Short:   "List recent runs for a project or organization",
 		Long: heredoc.Doc(`
-			List recent runs for a CircleCI project.
-
-			The project is inferred from the current git repository's remote
-			unless overridden with --project. Use --branch to filter results
-			to a single branch, or --current-branch (-B) to automatically use
-			the branch you have checked out.
+			List recent runs for a CircleCI project. The project is inferred from the git
+			remote unless overridden with --project; -B filters to the branch you have checked
+			out. Filters combine; dates take 2026-01-31, RFC3339 or an age (7d). The table
+			includes the commit subject; the JSON adds the full commit and repository detail.
+			--all --json streams JSONL (by project with --org).
 
-			The markdown table includes the commit subject; the JSON adds the full
-			commit and repository detail.
-
-			JSON fields: id, phase, outcome, current_outcome, branch, tag, revision, repository_url, commit.subject/url/author_name/author_login, created_at
+			JSON fields: id, phase, outcome, current_outcome, branch, tag, revision, repository_url, commit.subject/url/author_name/author_login, created_at, project, user_id, trigger_type, pipeline_definition_id, local_config
 		`),
 		Example: heredoc.Doc(`
 			# List recent runs for the current project
@@ -73,6 +111,12 @@ func newListCmd() *cobra.Command {
 			# Filter to a specific branch
 			$ circleci run list --branch main
 
+			# Your failed runs on main from the last week
+			$ circleci run list --mine --status failed --branch main --created-after 7d
+
+			# Everything currently failing on main across the org
+			$ circleci run list --org gh/myorg --branch main --status failing
+
 			# List runs for an explicit project
 			$ circleci run list --project gh/org/repo
 
@@ -81,84 +125,277 @@ func newListCmd() *cobra.Command {
 
 			# Output as JSON for scripting
 			$ circleci run list --json
+
+			# Export every run as JSON lines
+			$ circleci run list --all --json > runs.jsonl
 		`),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
+			if err := validateRunListFlags(cmd, &opts, createdAfter, createdBefore, time.Now().UTC()); err != nil {
+				return err
+			}
 			client, err := cmdutil.LoadClient(ctx)
 			if err != nil {
 				return err
 			}
-			if currentBranch && branch == "" {
+			if currentBranch && opts.Branch == "" {
 				info, err := gitremote.Detect()
 				if err != nil {
 					return cmdutil.GitDetectErr(err, "Or specify the branch explicitly: circleci run list --branch <name>")
 				}
-				branch = info.Branch
+				opts.Branch = info.Branch
 			}
-			return runList(ctx, client, projectSlug, branch, limit, jsonOut)
+			return runList(ctx, client, opts)
 		},
 	}
 
-	cmd.Flags().StringVar(&projectSlug, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
-	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Filter by branch")
+	cmd.Flags().StringVar(&opts.ProjectSlug, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Search every followed project in this organization (e.g. gh/myorg)")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Filter by branch")
 	cmd.Flags().BoolVarP(&currentBranch, "current-branch", "B", false, "Filter by the currently checked-out branch")
-	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of runs to show [default: 10]")
-	cmdutil.AddJSONFlag(cmd, &jsonOut)
+	cmd.Flags().StringVar(&opts.Status, "status", "", "Filter by status: "+strings.Join(runStatuses, ", "))
+	cmd.Flags().BoolVarP(&opts.Mine, "mine", "m", false, "Filter to runs you triggered")
+	cmd.Flags().StringVar(&opts.Actor, "actor", "", "Filter to runs triggered by this user ID")
+	cmd.Flags().StringVar(&createdAfter, "created-after", "", "Only runs created after this date, time or age (e.g. 2026-01-31, 7d)")
+	cmd.Flags().StringVar(&createdBefore, "created-before", "", "Only runs created before this date, time or age")
+	cmd.Flags().StringVar(&opts.PipelineDefinition, "pipeline-definition", "", "Filter by pipeline definition ID or name")
+	cmd.Flags().StringVar(&opts.Trigger, "trigger", "", "Filter by trigger type: "+strings.Join(runTriggerTypes, ", "))
+	cmd.Flags().StringVar(&opts.SHA, "sha", "", "Filter by commit SHA (full or abbreviated)")
+	cmd.Flags().IntVar(&opts.Limit, "limit", 10, "Maximum number of runs to show [default: 10]")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Fetch every matching run rather than stopping at --limit")
+	cmdutil.AddJSONFlag(cmd, &opts.JSON)
 	cmdutil.AddJQFlag(cmd)
 
 	return cmd
 }
 
+// validateRunListFlags checks the flags that can be rejected before any API call
+// and parses the created-at bounds into opts.
+func validateRunListFlags(cmd *cobra.Command, opts *runListOptions, createdAfter, createdBefore string, now time.Time) error {
+	if opts.Status != "" && !slices.Contains(runStatuses, opts.Status) {
+		return clierrors.New("run.invalid_status", "Invalid status",
+			fmt.Sprintf("Unknown status %q.", opts.Status)).
+			WithSuggestions("Use one of: " + strings.Join(runStatuses, ", ")).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if opts.Trigger != "" && !slices.Contains(runTriggerTypes, opts.Trigger) {
+		return clierrors.New("run.invalid_trigger_type", "Invalid trigger type",
+			fmt.Sprintf("Unknown trigger type %q.", opts.Trigger)).
+			WithSuggestions("Use one of: " + strings.Join(runTriggerTypes, ", ")).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if opts.SHA != "" {
+		if !isHexSHA(opts.SHA) {
+			return clierrors.New("run.invalid_sha", "Invalid SHA",
+				fmt.Sprintf("--sha takes a commit SHA of 4 to %d hex digits, got %q.", fullSHALength, opts.SHA)).
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+		opts.SHA = strings.ToLower(opts.SHA)
+	}
+	if opts.Org != "" && opts.ProjectSlug != "" {
+		return clierrors.New("run.conflicting_flags", "Conflicting flags",
+			"--org and --project cannot be used together.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if opts.Org != "" && opts.PipelineDefinition != "" {
+		return clierrors.New("run.conflicting_flags", "Conflicting flags",
+			"--pipeline-definition cannot be used with --org; pipeline definitions belong to a single project.").
+			WithSuggestions("Use --project to list one project's runs by pipeline definition").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if opts.Mine && opts.Actor != "" {
+		return clierrors.New("run.conflicting_flags", "Conflicting flags",
+			"--mine and --actor cannot be used together.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if opts.Actor != "" {
+		if _, err := uuid.Parse(opts.Actor); err != nil {
+			return clierrors.New("run.invalid_actor", "Invalid actor",
+				fmt.Sprintf("--actor takes a user ID (UUID), got %q.", opts.Actor)).
+				WithSuggestions("Use --mine for your own runs").
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+	}
+	if opts.All && cmd.Flags().Changed("limit") {
+		return clierrors.New("run.conflicting_flags", "Conflicting flags",
+			"--all and --limit cannot be used together.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+
+	var err error
+	if createdAfter != "" {
+		if opts.CreatedAfter, err = parseCreatedBound("--created-after", createdAfter, now); err != nil {
+			return err
+		}
+	}
+	if createdBefore != "" {
+		if opts.CreatedBefore, err = parseCreatedBound("--created-before", createdBefore, now); err != nil {
+			return err
+		}
+	}
+	if !opts.CreatedAfter.IsZero() && !opts.CreatedBefore.IsZero() && !opts.CreatedAfter.Before(opts.CreatedBefore) {
+		return clierrors.New("run.invalid_time_range", "Invalid time range",
+			"--created-after must be earlier than --created-before.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	return nil
+}
+
+// isHexSHA reports whether s looks like a full or abbreviated commit SHA.
+func isHexSHA(s string) bool {
+	if len(s) < 4 || len(s) > fullSHALength {
+		return false
+	}
+	for _, c := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// createdBoundLayouts are the absolute forms parseCreatedBound accepts.
+var createdBoundLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// parseCreatedBound reads a --created-after/--created-before value: an absolute
+// date or time (taken as UTC when it has no zone), or an age counted back from
+// now — a Go duration such as 36h, or a whole number of days such as 7d.
+func parseCreatedBound(flag, s string, now time.Time) (time.Time, error) {
+	for _, layout := range createdBoundLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, clierrors.New("run.invalid_time", "Invalid time",
+		fmt.Sprintf("%s: unrecognised date, time or age %q.", flag, s)).
+		WithSuggestions("Use a date (2026-01-31), an RFC3339 time (2026-01-31T09:00:00Z) or an age (36h, 7d)").
+		WithExitCode(clierrors.ExitBadArguments)
+}
+
 type runListEntry struct {
-	ID             uuid.UUID     `json:"id"`
-	Phase          string        `json:"phase"`
-	Outcome        string        `json:"outcome,omitempty"`
-	CurrentOutcome string        `json:"current_outcome,omitempty"`
-	Branch         string        `json:"branch,omitempty"`
-	Tag            string        `json:"tag,omitempty"`
-	Revision       string        `json:"revision,omitempty"`
-	RepositoryURL  string        `json:"repository_url,omitempty"`
-	Commit         *commitOutput `json:"commit,omitempty"`
-	CreatedAt      string        `json:"created_at"`
-}
-
-func runList(ctx context.Context, client *apiclient.Client, projectSlug, branch string, limit int, jsonOut bool) error {
-	if projectSlug == "" {
+	ID                   uuid.UUID     `json:"id"`
+	Phase                string        `json:"phase"`
+	Outcome              string        `json:"outcome,omitempty"`
+	CurrentOutcome       string        `json:"current_outcome,omitempty"`
+	Branch               string        `json:"branch,omitempty"`
+	Tag                  string        `json:"tag,omitempty"`
+	Revision             string        `json:"revision,omitempty"`
+	RepositoryURL        string        `json:"repository_url,omitempty"`
+	Commit               *commitOutput `json:"commit,omitempty"`
+	CreatedAt            string        `json:"created_at"`
+	Project              string        `json:"project,omitempty"`
+	UserID               string        `json:"user_id,omitempty"`
+	TriggerType          string        `json:"trigger_type,omitempty"`
+	PipelineDefinitionID string        `json:"pipeline_definition_id,omitempty"`
+	// LocalConfig is set for runs this machine triggered with run trigger
+	// --config-file.
+	LocalConfig bool `json:"local_config,omitempty"`
+}
+
+// fullSHALength is the length of an unabbreviated commit SHA. The search API
+// matches pipeline.git.revision exactly, so only a full SHA can be sent as a
+// filter; an abbreviated one is prefix-matched client-side instead.
+const fullSHALength = 40
+
+// runListQuery is a resolved run list search: the server-side search parameters
+// plus the filters the search endpoint cannot express, which are applied to
+// each page as it arrives.
+type runListQuery struct {
+	params               apiclient.RunSearchParams
+	actorID              uuid.UUID
+	trigger              string
+	pipelineDefinitionID string
+	revisionPrefix       string
+}
+
+func (q runListQuery) keep(r *apiclient.RunV3) bool {
+	if q.actorID != uuid.Nil && r.UserID != q.actorID {
+		return false
+	}
+	if q.trigger != "" && r.TriggerType != q.trigger {
+		return false
+	}
+	if q.pipelineDefinitionID != "" && r.PipelineDefinitionID != q.pipelineDefinitionID {
+		return false
+	}
+	if q.revisionPrefix != "" && !strings.HasPrefix(r.Revision, q.revisionPrefix) {
+		return false
+	}
+	return true
+}
+
+func runList(ctx context.Context, client *apiclient.Client, opts runListOptions) error {
+	if opts.Org != "" {
+		return runListOrg(ctx, client, opts)
+	}
+	if opts.ProjectSlug == "" {
 		info, err := gitremote.Detect()
 		if err != nil {
 			return cmdutil.GitDetectErr(err, "Or specify the project: circleci run list --project gh/org/repo")
 		}
-		projectSlug = info.Slug
+		opts.ProjectSlug = info.Slug
 	}
 
-	proj, err := client.GetProjectBySlug(ctx, projectSlug)
+	proj, err := client.GetProjectBySlug(ctx, opts.ProjectSlug)
 	if err != nil {
-		return apiErr(err, projectSlug)
+		return apiErr(err, opts.ProjectSlug)
+	}
+
+	q, err := resolveRunListQuery(ctx, client, proj.ID, opts, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	q.params.ProjectIDs = []string{proj.ID.String()}
+
+	if opts.All && opts.JSON {
+		return streamRunList(ctx, client, q, opts.ProjectSlug)
 	}
 
-	now := time.Now().UTC()
-	runs, err := client.SearchRunsV3(ctx, apiclient.RunSearchParams{
-		ProjectIDs: []string{proj.ID.String()},
-		From:       now.AddDate(0, 0, -90),
-		To:         now,
-		Filter:     apiclient.BuildRunFilter(branch, ""),
-		Limit:      limit,
+	var entries []runListEntry
+	err = client.SearchRunsV3Pages(ctx, q.params, func(runs []apiclient.RunV3) (bool, error) {
+		for i := range runs {
+			if !q.keep(&runs[i]) {
+				continue
+			}
+			entries = append(entries, toListEntry(&runs[i]))
+			if !opts.All && len(entries) == opts.Limit {
+				return false, nil
+			}
+		}
+		return true, nil
 	})
 	if err != nil {
-		return apiErr(err, projectSlug)
+		return apiErr(err, opts.ProjectSlug)
 	}
+	return printRunList(ctx, entries, opts.JSON)
+}
 
-	entries := make([]runListEntry, len(runs))
-	for i, r := range runs {
-		entries[i] = toListEntry(&r)
+// printRunList marks local-config runs, then writes entries as JSON or as the
+// runs table.
+func printRunList(ctx context.Context, entries []runListEntry, jsonOut bool) error {
+	if entries == nil {
+		entries = []runListEntry{}
 	}
+	markLocalConfigRuns(entries)
 
 	if jsonOut {
 		return iostream.PrintJSON(ctx, entries)
 	}
 
-	if len(runs) == 0 {
+	if len(entries) == 0 {
 		iostream.ErrPrintln(ctx, "No runs found.")
 		return nil
 	}
@@ -167,6 +404,107 @@ func runList(ctx context.Context, client *apiclient.Client, projectSlug, branch
 	return nil
 }
 
+// streamRunList writes every matching run as a JSONL record as each page
+// arrives, so an export of any size holds only one page in memory.
+func streamRunList(ctx context.Context, client *apiclient.Client, q runListQuery, projectSlug string) error {
+	local := loadLocalConfigRuns()
+	err := iostream.PrintJSONStream(ctx, func(emit func(any) error) error {
+		return client.SearchRunsV3Pages(ctx, q.params, func(runs []apiclient.RunV3) (bool, error) {
+			for i := range runs {
+				if !q.keep(&runs[i]) {
+					continue
+				}
+				e := toListEntry(&runs[i])
+				_, e.LocalConfig = local[e.ID.String()]
+				if err := emit(e); err != nil {
+					return false, err
+				}
+			}
+			return true, nil
+		})
+	})
+	if err != nil {
+		if errors.As(err, new(*jq.Error)) {
+			return err
+		}
+		return apiErr(err, projectSlug)
+	}
+	return nil
+}
+
+// resolveRunListQuery turns opts into a search: the created-at bounds become
+// the search scope, branch, status and SHA the filter expression, and --mine
+// and --pipeline-definition are resolved to the IDs runs carry. projectID is
+// only used to resolve --pipeline-definition; callers set the project scope.
+func resolveRunListQuery(ctx context.Context, client *apiclient.Client, projectID uuid.UUID, opts runListOptions, now time.Time) (runListQuery, error) {
+	from, to := opts.CreatedAfter, opts.CreatedBefore
+	if to.IsZero() {
+		to = now
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -runListSearchDays)
+	}
+
+	filter := apiclient.RunFilter{Branch: opts.Branch, Status: opts.Status}
+	q := runListQuery{trigger: opts.Trigger}
+	if len(opts.SHA) == fullSHALength {
+		filter.Revision = opts.SHA
+	} else {
+		q.revisionPrefix = opts.SHA
+	}
+	q.params = apiclient.RunSearchParams{
+		From:   from,
+		To:     to,
+		Filter: filter.Expr(),
+		Limit:  opts.Limit,
+	}
+	if opts.All {
+		q.params.Limit = 0
+	}
+
+	switch {
+	case opts.Mine:
+		me, err := client.GetMe(ctx)
+		if err != nil {
+			return q, apiErr(err, "current user")
+		}
+		q.actorID = me.ID
+	case opts.Actor != "":
+		q.actorID = uuid.MustParse(opts.Actor) // validated with the other flags
+	}
+
+	if opts.PipelineDefinition != "" {
+		defs, err := client.ListPipelineDefinitions(ctx, projectID.String())
+		if err != nil {
+			return q, apiErr(err, opts.ProjectSlug)
+		}
+		for _, d := range defs {
+			if d.ID == opts.PipelineDefinition || d.Name == opts.PipelineDefinition {
+				q.pipelineDefinitionID = d.ID
+				break
+			}
+		}
+		if q.pipelineDefinitionID == "" {
+			return q, clierrors.New("run.pipeline_definition_not_found", "Pipeline definition not found",
+				fmt.Sprintf("No pipeline definition with ID or name %q in %s.", opts.PipelineDefinition, opts.ProjectSlug)).
+				WithSuggestions("List the project's definitions: circleci pipeline list --project " + opts.ProjectSlug).
+				WithExitCode(clierrors.ExitNotFound)
+		}
+	}
+	return q, nil
+}
+
+// markLocalConfigRuns flags the entries recorded as local-config runs.
+func markLocalConfigRuns(entries []runListEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	local := loadLocalConfigRuns()
+	for i := range entries {
+		_, entries[i].LocalConfig = local[entries[i].ID.String()]
+	}
+}
+
 func toListEntry(r *apiclient.RunV3) runListEntry {
 	rev := r.Revision
 	if len(rev) > 7 {
@@ -183,13 +521,41 @@ func toListEntry(r *apiclient.RunV3) runListEntry {
 		RepositoryURL:  r.RepositoryURL,
 		Commit:         commitOutputFrom(r.Commit),
 		CreatedAt:      r.CreatedAt.Format("2006-01-02 15:04 UTC"),
+
+		UserID:               uuidOrEmpty(r.UserID),
+		TriggerType:          r.TriggerType,
+		PipelineDefinitionID: r.PipelineDefinitionID,
 	}
 }
 
+// uuidOrEmpty renders id, or "" for the nil UUID so an omitempty field is
+// dropped rather than showing all-zeroes.
+func uuidOrEmpty(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
+
+// printList renders the runs table, leading with a Project column when the
+// entries come from an org-wide search.
 func printList(ctx context.Context, entries []runListEntry) {
-	table := mdtable.New("Ref", "Revision", "Subject", "ID", "Created", "Status")
+	withProject := entries[0].Project != ""
+	headers := []string{"Ref", "Revision", "Subject", "ID", "Created", "Status"}
+	if withProject {
+		headers = append([]string{"Project"}, headers...)
+	}
+	table := mdtable.New(headers...)
 	for _, e := range entries {
-		table.Row(refDisplay(e.Branch, e.Tag), orDash(e.Revision), orDash(entrySubject(e)), "`"+e.ID.String()+"`", e.CreatedAt, apiclient.PhaseOutcomeStatus(e.Phase, e.Outcome, e.CurrentOutcome))
+		ref := refDisplay(e.Branch, e.Tag)
+		if e.LocalConfig {
+			ref += " (local config)"
+		}
+		row := []string{ref, orDash(e.Revision), orDash(entrySubject(e)), "`" + e.ID.String() + "`", e.CreatedAt, apiclient.PhaseOutcomeStatus(e.Phase, e.Outcome, e.CurrentOutcome)}
+		if withProject {
+			row = append([]string{e.Project}, row...)
+		}
+		table.Row(row...)
 	}
 	iostream.PrintMarkdown(ctx, "# Runs\n"+table.Render())
 }
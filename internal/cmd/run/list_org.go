@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/clikit/jq"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+)
+
+// runListOrgParallelism bounds how many projects an org-wide run list searches
+// at once.
+const runListOrgParallelism = 8
+
+// orgProject is a followed project in the organization being searched.
+type orgProject struct {
+	ID   uuid.UUID
+	Slug string
+}
+
+// orgRun is a matching run tagged with the project it was found in.
+type orgRun struct {
+	run  apiclient.RunV3
+	slug string
+}
+
+// runListOrg searches each followed project in opts.Org with the same query,
+// then merges the results newest first. Each project is searched for up to
+// --limit runs, so the merged list is the true newest --limit across the org.
+func runListOrg(ctx context.Context, client *apiclient.Client, opts runListOptions) error {
+	projects, err := orgProjects(ctx, client, opts.Org)
+	if err != nil {
+		return apiErr(err, opts.Org)
+	}
+	if len(projects) == 0 {
+		return clierrors.New("run.org_no_projects", "No projects in organization",
+			fmt.Sprintf("You do not follow any projects in %s.", opts.Org)).
+			WithSuggestions(
+				"Check the slug, e.g. gh/myorg",
+				"Follow a project: circleci project follow --project "+opts.Org+"/<repo>",
+			).
+			WithExitCode(clierrors.ExitNotFound)
+	}
+
+	q, err := resolveRunListQuery(ctx, client, uuid.Nil, opts, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if opts.All && opts.JSON {
+		return streamRunListOrg(ctx, client, q, projects)
+	}
+
+	perProject := make([][]orgRun, len(projects))
+	err = bulkhead.Do(ctx, runListOrgParallelism, projects, func(p orgProject, i int) error {
+		pq := q
+		pq.params.ProjectIDs = []string{p.ID.String()}
+		err := client.SearchRunsV3Pages(ctx, pq.params, func(runs []apiclient.RunV3) (bool, error) {
+			for _, r := range runs {
+				if !q.keep(&r) {
+					continue
+				}
+				perProject[i] = append(perProject[i], orgRun{run: r, slug: p.Slug})
+				if !opts.All && len(perProject[i]) == opts.Limit {
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			return apiErr(err, p.Slug)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	runs := mergeOrgRuns(perProject)
+	if !opts.All && len(runs) > opts.Limit {
+		runs = runs[:opts.Limit]
+	}
+
+	entries := make([]runListEntry, len(runs))
+	for i, r := range runs {
+		entries[i] = toListEntry(&r.run)
+		entries[i].Project = r.slug
+	}
+
+	return printRunList(ctx, entries, opts.JSON)
+}
+
+// streamRunListOrg writes every matching run in the org as a JSONL record as
+// each project's pages arrive, so an export holds at most one page per project
+// being searched in memory. Ordering runs across projects would mean holding
+// them all, so records come project by project, each newest first; the project
+// field lets a consumer sort them.
+func streamRunListOrg(ctx context.Context, client *apiclient.Client, q runListQuery, projects []orgProject) error {
+	local := loadLocalConfigRuns()
+	var mu sync.Mutex // emit writes to one stream, and projects are searched concurrently
+	return iostream.PrintJSONStream(ctx, func(emit func(any) error) error {
+		return bulkhead.Do(ctx, runListOrgParallelism, projects, func(p orgProject, _ int) error {
+			pq := q
+			pq.params.ProjectIDs = []string{p.ID.String()}
+			err := client.SearchRunsV3Pages(ctx, pq.params, func(runs []apiclient.RunV3) (bool, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				for i := range runs {
+					if !q.keep(&runs[i]) {
+						continue
+					}
+					e := toListEntry(&runs[i])
+					e.Project = p.Slug
+					_, e.LocalConfig = local[e.ID.String()]
+					if err := emit(e); err != nil {
+						return false, err
+					}
+				}
+				return true, nil
+			})
+			if err != nil {
+				if errors.As(err, new(*jq.Error)) {
+					return err
+				}
+				return apiErr(err, p.Slug)
+			}
+			return nil
+		})
+	})
+}
+
+// mergeOrgRuns flattens the per-project results newest first. Runs created at
+// the same moment keep project order, so output is stable across calls.
+func mergeOrgRuns(perProject [][]orgRun) []orgRun {
+	var runs []orgRun
+	for _, rs := range perProject {
+		runs = append(runs, rs...)
+	}
+	slices.SortStableFunc(runs, func(a, b orgRun) int {
+		return b.run.CreatedAt.Compare(a.run.CreatedAt)
+	})
+	return runs
+}
+
+// orgProjects resolves the followed projects in org to their V3 IDs, sorted by
+// slug. A followed project the V3 API no longer knows is skipped rather than
+// failing the whole search.
+func orgProjects(ctx context.Context, client *apiclient.Client, org string) ([]orgProject, error) {
+	followed, err := client.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefix := normalizeOrgSlug(org) + "/"
+	var slugs []string
+	for _, p := range followed {
+		slug := p.FullSlug()
+		if strings.HasPrefix(strings.ToLower(slug), prefix) {
+			slugs = append(slugs, slug)
+		}
+	}
+	slices.Sort(slugs)
+
+	refs := make([]*apiclient.ProjectRef, len(slugs))
+	err = bulkhead.Do(ctx, runListOrgParallelism, slugs, func(slug string, i int) error {
+		ref, err := client.GetProjectBySlug(ctx, slug)
+		if errors.Is(err, apiclient.ErrProjectNotFound) {
+			return nil
+		}
+		refs[i] = ref
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []orgProject
+	for i, ref := range refs {
+		if ref != nil {
+			projects = append(projects, orgProject{ID: ref.ID, Slug: slugs[i]})
+		}
+	}
+	return projects, nil
+}
+
+// normalizeOrgSlug lower-cases an organization slug and shortens a spelled-out
+// VCS prefix (github/myorg) to the form project slugs use (gh/myorg).
+func normalizeOrgSlug(org string) string {
+	org = strings.ToLower(strings.TrimSuffix(org, "/"))
+	vcs, name, ok := strings.Cut(org, "/")
+	if !ok {
+		return org
+	}
+	switch vcs {
+	case "github":
+		vcs = "gh"
+	case "bitbucket":
+		vcs = "bb"
+	}
+	return vcs + "/" + name
+}
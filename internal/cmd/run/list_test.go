@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+)
+
+func TestParseCreatedBound(t *testing.T) {
+	now := time.Date(2026, 7, 6, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: "2026-01-31", want: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{in: "2026-01-31T09:30:00", want: time.Date(2026, 1, 31, 9, 30, 0, 0, time.UTC)},
+		{in: "2026-01-31T09:30:00+02:00", want: time.Date(2026, 1, 31, 7, 30, 0, 0, time.UTC)},
+		{in: "7d", want: now.AddDate(0, 0, -7)},
+		{in: "36h", want: now.Add(-36 * time.Hour)},
+		{in: "0d", want: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseCreatedBound("--created-after", tt.in, now)
+			assert.NilError(t, err)
+			assert.Check(t, is.Equal(got, tt.want))
+		})
+	}
+
+	for _, bad := range []string{"yesterday", "-3d", "-1h", "7w", "2026-13-01"} {
+		t.Run(bad, func(t *testing.T) {
+			_, err := parseCreatedBound("--created-after", bad, now)
+			assert.Check(t, is.ErrorContains(err, "unrecognised date, time or age"))
+		})
+	}
+}
+
+func TestNormalizeOrgSlug(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{in: "gh/acme", want: "gh/acme"},
+		{in: "github/Acme", want: "gh/acme"},
+		{in: "bitbucket/acme/", want: "bb/acme"},
+		{in: "circleci/0c6f7e3a", want: "circleci/0c6f7e3a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Check(t, is.Equal(normalizeOrgSlug(tt.in), tt.want))
+		})
+	}
+}
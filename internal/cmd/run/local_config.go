@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/config"
+)
+
+// maxLocalConfigRuns caps how many local-config runs are remembered; the
+// oldest are dropped first.
+const maxLocalConfigRuns = 500
+
+// localConfigRun records a run triggered with a local config file. The API
+// does not report that a run's config was supplied inline, so run list relies
+// on this record to mark such runs.
+type localConfigRun struct {
+	Project     string    `json:"project"`
+	ConfigFile  string    `json:"config_file"`
+	Revision    string    `json:"revision"`
+	TriggeredAt time.Time `json:"triggered_at"`
+}
+
+func localConfigRunsPath() (string, error) {
+	dir, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "local-config-runs.json"), nil
+}
+
+// loadLocalConfigRuns returns the recorded local-config runs keyed by run ID.
+// A missing or unreadable record is empty: it only decorates output.
+func loadLocalConfigRuns() map[string]localConfigRun {
+	runs := map[string]localConfigRun{}
+	path, err := localConfigRunsPath()
+	if err != nil {
+		return runs
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return runs
+	}
+	_ = json.Unmarshal(data, &runs)
+	return runs
+}
+
+// recordLocalConfigRun adds a run to the local-config record.
+func recordLocalConfigRun(id string, run localConfigRun) error {
+	path, err := localConfigRunsPath()
+	if err != nil {
+		return err
+	}
+	runs := loadLocalConfigRuns()
+	runs[id] = run
+	if len(runs) > maxLocalConfigRuns {
+		ids := slices.SortedFunc(maps.Keys(runs), func(a, b string) int {
+			return runs[a].TriggeredAt.Compare(runs[b].TriggeredAt)
+		})
+		for _, old := range ids[:len(runs)-maxLocalConfigRuns] {
+			delete(runs, old)
+		}
+	}
+
+	data, err := json.MarshalIndent(runs, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
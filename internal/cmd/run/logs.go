@@ -0,0 +1,380 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/clikit/ui/theme"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+)
+
+const (
+	// logsPollInterval is how often --follow re-reads the run and every step it
+	// is streaming — shorter than the watch interval, since output is the point.
+	logsPollInterval = 2 * time.Second
+	// logsParallelism bounds the concurrent job-detail and stdout fetches made
+	// by a single poll.
+	logsParallelism = 8
+)
+
+func newLogsCmd() *cobra.Command {
+	var (
+		projectSlug string
+		branch      string
+		jobFilter   string
+		sinceStep   string
+		follow      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "logs [<run-id>]",
+		Short: "Print the step output of every job in a run",
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Docf(`
+				%[1]s<run-id>%[1]s is optional: a run UUID (as shown by %[1]scircleci run list --json%[1]s).
+				When omitted, the latest run for the current branch is used.
+			`, "`"),
+		},
+		Long: heredoc.Doc(`
+			Print every started step's output in a run, each line prefixed with
+			[workflow/job#execution step]; --follow streams running jobs until the run ends.
+		`),
+		Example: heredoc.Doc(`
+			# Follow the latest run on the current branch
+			$ circleci run logs --follow
+
+			# Only the deploy jobs, from their "Deploy" step onwards
+			$ circleci run logs 5034460f-c7c4-4c43-9457-de07e2029e7b --job '^deploy-' --since-step Deploy
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			var jobRe *regexp.Regexp
+			if jobFilter != "" {
+				jobRe, err = regexp.Compile(jobFilter)
+				if err != nil {
+					return clierrors.New("run.invalid_job_filter", "Invalid --job filter",
+						fmt.Sprintf("%q is not a valid regular expression: %s", jobFilter, err)).
+						WithExitCode(clierrors.ExitBadArguments)
+				}
+			}
+			r, err := resolveRun(ctx, client, args, projectSlug, branch)
+			if err != nil {
+				return err
+			}
+			return runLogs(ctx, newLogFollower(ctx, client, r.ID, jobFilter, jobRe, sinceStep), follow)
+		},
+	}
+
+	cmd.Flags().StringVar(&projectSlug, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch of the latest run (defaults to current branch)")
+	cmd.Flags().BoolVar(&follow, "follow", false, "Keep streaming new output until the run finishes")
+	cmd.Flags().StringVar(&jobFilter, "job", "", "Only jobs with this name, or whose name matches this regex")
+	cmd.Flags().StringVar(&sinceStep, "since-step", "", "Skip each job's steps before the one with this name or number")
+
+	cmd.AddCommand(newLogsDownloadCmd())
+	return cmd
+}
+
+func runLogs(ctx context.Context, f *logFollower, follow bool) error {
+	for {
+		done, err := f.poll(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return watchInterrupted()
+			}
+			return err
+		}
+		if done || !follow {
+			f.flush(ctx)
+			return nil
+		}
+		if err := sleepOrCancel(ctx, logsPollInterval); err != nil {
+			f.flush(ctx)
+			return watchInterrupted()
+		}
+	}
+}
+
+// logStepKey identifies one step of one parallel execution of a job.
+type logStepKey struct {
+	jobID     uuid.UUID
+	execution int
+	num       int
+}
+
+// logStream is the tailing state of a single step: how much of its stdout has
+// been read, a trailing partial line held back until it is completed, and
+// whether the stream has ended.
+type logStream struct {
+	key     logStepKey
+	prefix  string
+	ended   bool // the step itself has finished, per the job detail
+	offset  int64
+	partial []byte
+	done    bool
+
+	// chunk is this poll's read. Reads fill it concurrently, one stream each;
+	// printing drains it in stream order.
+	chunk []byte
+}
+
+// logFollower tails the stdout of every step of a run's jobs. Each poll reads
+// all unfinished streams concurrently, then prints what arrived stream by
+// stream in the order the steps were found, so lines of one step are never
+// split up by another's within a poll.
+type logFollower struct {
+	client    *apiclient.Client
+	runID     uuid.UUID
+	jobName   string
+	jobRe     *regexp.Regexp
+	sinceStep string
+	color     bool
+
+	streams    map[logStepKey]*logStream
+	order      []logStepKey
+	jobStreams map[uuid.UUID][]*logStream
+	jobStyles  map[uuid.UUID]lipgloss.Style
+	jobsDone   map[uuid.UUID]bool
+}
+
+func newLogFollower(ctx context.Context, client *apiclient.Client, runID uuid.UUID, jobName string, jobRe *regexp.Regexp, sinceStep string) *logFollower {
+	return &logFollower{
+		client:     client,
+		runID:      runID,
+		jobName:    jobName,
+		jobRe:      jobRe,
+		sinceStep:  sinceStep,
+		color:      iostream.ColorEnabled(ctx),
+		streams:    map[logStepKey]*logStream{},
+		jobStreams: map[uuid.UUID][]*logStream{},
+		jobStyles:  map[uuid.UUID]lipgloss.Style{},
+		jobsDone:   map[uuid.UUID]bool{},
+	}
+}
+
+// logJob is a started job, with the name of its workflow, whose steps may still
+// have output to read.
+type logJob struct {
+	workflow string
+	job      apiclient.WorkflowJobV3
+}
+
+// poll reads and prints one round of output. It reports done once every
+// workflow of the run has ended and every stream has been read to its end.
+func (f *logFollower) poll(ctx context.Context) (bool, error) {
+	workflows, err := f.client.GetRunWorkflowsV3(ctx, f.runID)
+	if err != nil && !httpcl.HasStatusCode(err, http.StatusNotFound) {
+		return false, apiErr(err, f.runID.String())
+	}
+
+	runEnded := len(workflows) > 0
+	var jobs []logJob
+	for _, wf := range workflows {
+		if wf.Phase != apiclient.PhaseEnded {
+			runEnded = false
+		}
+		wfJobs, err := f.client.GetWorkflowJobsV3(ctx, wf.ID)
+		if err != nil {
+			return false, apiErr(err, wf.ID.String())
+		}
+		for _, j := range wfJobs {
+			if j.Type == apiclient.JobTypeApproval || apiclient.PhaseNotStarted(j.Phase) ||
+				f.jobsDone[j.ID] || !f.matchJob(j.Name) {
+				continue
+			}
+			jobs = append(jobs, logJob{workflow: wf.Name, job: j})
+		}
+	}
+
+	details := make([]*apiclient.JobV3, len(jobs))
+	err = bulkhead.Do(ctx, logsParallelism, jobs, func(j logJob, i int) error {
+		d, err := f.client.GetJobV3(ctx, j.job.ID)
+		if err != nil {
+			return cmdutil.APIErr(err, j.job.ID.String(), "job.not_found", "No job found for %q.")
+		}
+		details[i] = d
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	for i, j := range jobs {
+		f.openStreams(j.workflow, details[i])
+	}
+
+	var active []*logStream
+	for _, k := range f.order {
+		if s := f.streams[k]; !s.done {
+			active = append(active, s)
+		}
+	}
+	err = bulkhead.Do(ctx, logsParallelism, active, func(s *logStream, _ int) error {
+		return f.read(ctx, s)
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, s := range active {
+		f.print(ctx, s)
+	}
+
+	for _, d := range details {
+		if d.Phase == apiclient.PhaseEnded && allStreamsDone(f.jobStreams[d.ID]) {
+			f.jobsDone[d.ID] = true
+		}
+	}
+	return runEnded && allStreamsDone(active), nil
+}
+
+func (f *logFollower) matchJob(name string) bool {
+	if f.jobRe == nil {
+		return true
+	}
+	return name == f.jobName || f.jobRe.MatchString(name)
+}
+
+// openStreams registers a stream for each started step of a job not yet seen,
+// and refreshes whether each known step has ended. With --since-step, an
+// execution's steps before the first one named (or numbered) by it are skipped.
+func (f *logFollower) openStreams(workflow string, j *apiclient.JobV3) {
+	for _, exec := range j.Executions {
+		reached := f.sinceStep == ""
+		for _, st := range exec.Steps {
+			if !reached && (st.Name == f.sinceStep || strconv.Itoa(st.Num) == f.sinceStep) {
+				reached = true
+			}
+			if !reached || apiclient.PhaseNotStarted(st.Phase) {
+				continue
+			}
+			key := logStepKey{jobID: j.ID, execution: exec.Index, num: st.Num}
+			s, ok := f.streams[key]
+			if !ok {
+				s = &logStream{key: key, prefix: f.prefix(workflow, j, exec.Index, st.Name)}
+				f.streams[key] = s
+				f.order = append(f.order, key)
+				f.jobStreams[j.ID] = append(f.jobStreams[j.ID], s)
+			}
+			s.ended = st.Phase == apiclient.PhaseEnded
+		}
+	}
+}
+
+// prefix renders a step's line prefix, colored per job when color is enabled
+// so concurrent jobs are easy to tell apart.
+func (f *logFollower) prefix(workflow string, j *apiclient.JobV3, execution int, step string) string {
+	text := fmt.Sprintf("[%s/%s#%d %s]", workflow, j.Name, execution, step)
+	if !f.color {
+		return text
+	}
+	style, ok := f.jobStyles[j.ID]
+	if !ok {
+		style = theme.SeriesStyles[len(f.jobStyles)%len(theme.SeriesStyles)]
+		f.jobStyles[j.ID] = style
+	}
+	return style.Render(text)
+}
+
+// read fetches a stream's new stdout into its chunk. A step with no stdout yet
+// 404s; once the step has ended, that means it never had any.
+func (f *logFollower) read(ctx context.Context, s *logStream) error {
+	data, terminal, err := f.client.GetJobStdoutRange(ctx, s.key.jobID, s.key.execution, s.key.num, s.offset)
+	if err != nil {
+		if httpcl.HasStatusCode(err, http.StatusNotFound) {
+			s.done = s.ended
+			return nil
+		}
+		return cmdutil.APIErr(err, fmt.Sprintf("step %d of job %s", s.key.num, s.key.jobID),
+			"job.output_not_found", "No output found for %s.")
+	}
+	s.chunk = data
+	s.offset += int64(len(data))
+	s.done = terminal || (s.ended && len(data) == 0)
+	return nil
+}
+
+// print writes a stream's complete lines, holding back a trailing partial line
+// until the rest of it arrives or the stream ends.
+func (f *logFollower) print(ctx context.Context, s *logStream) {
+	buf := append(s.partial, s.chunk...)
+	s.chunk = nil
+	for {
+		i := bytes.IndexByte(buf, '\n')
+		if i < 0 {
+			break
+		}
+		printLogLine(ctx, s.prefix, buf[:i])
+		buf = buf[i+1:]
+	}
+	s.partial = bytes.Clone(buf)
+	if s.done && len(s.partial) > 0 {
+		printLogLine(ctx, s.prefix, s.partial)
+		s.partial = nil
+	}
+}
+
+// flush prints every held-back partial line, for when output stops before the
+// streams do (no --follow, or an interrupt).
+func (f *logFollower) flush(ctx context.Context) {
+	for _, k := range f.order {
+		if s := f.streams[k]; len(s.partial) > 0 {
+			printLogLine(ctx, s.prefix, s.partial)
+			s.partial = nil
+		}
+	}
+}
+
+func printLogLine(ctx context.Context, prefix string, line []byte) {
+	iostream.Printf(ctx, "%s %s\n", prefix, bytes.TrimRight(line, "\r"))
+}
+
+func allStreamsDone(streams []*logStream) bool {
+	for _, s := range streams {
+		if !s.done {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,484 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+)
+
+// logsManifestVersion is bumped whenever a field of manifest.json changes
+// meaning or is removed, so retention tooling can tell archives apart.
+const logsManifestVersion = 1
+
+func newLogsDownloadCmd() *cobra.Command {
+	var (
+		projectSlug string
+		branch      string
+		output      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "download [<run-id>]",
+		Short: "Save every step's output in a run to disk",
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Docf(`
+				%[1]s<run-id>%[1]s is optional: a run UUID. When omitted, the latest run for the
+				current branch is used.
+			`, "`"),
+		},
+		Long: heredoc.Doc(`
+			Write the raw stdout and stderr of every step, in every execution of
+			every job in a run, to workflow/job/exec-N/NN-step-name.log under the
+			output directory, with a manifest.json of outcomes, exit codes and
+			timings. An output path ending in .tar.gz or .tgz writes a single
+			archive instead.
+		`),
+		Example: heredoc.Doc(`
+			# Archive a run's logs for a post-mortem
+			$ circleci run logs download 5034460f-c7c4-4c43-9457-de07e2029e7b -o incident-1234/
+
+			# The latest run on main, as one archive
+			$ circleci run logs download --branch main -o main-logs.tar.gz
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output == "" {
+				return cmdutil.RequireFlag("output")
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			r, err := resolveRun(ctx, client, args, projectSlug, branch)
+			if err != nil {
+				return err
+			}
+			return runLogsDownload(ctx, client, r, output)
+		},
+	}
+
+	cmd.Flags().StringVar(&projectSlug, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch of the latest run (defaults to current branch)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Directory to write into, or a .tar.gz file (required)")
+
+	return cmd
+}
+
+// logsManifest is manifest.json: the run's structure with, for every step,
+// the file its output was saved to. Paths are relative to the manifest.
+type logsManifest struct {
+	SchemaVersion int                    `json:"schema_version"`
+	DownloadedAt  time.Time              `json:"downloaded_at"`
+	Run           logsManifestRun        `json:"run"`
+	Workflows     []logsManifestWorkflow `json:"workflows"`
+}
+
+type logsManifestRun struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"project_id"`
+	Status    string    `json:"status"`
+	Branch    string    `json:"branch,omitempty"`
+	Tag       string    `json:"tag,omitempty"`
+	Revision  string    `json:"revision,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type logsManifestWorkflow struct {
+	ID        uuid.UUID         `json:"id"`
+	Name      string            `json:"name"`
+	Status    string            `json:"status"`
+	CreatedAt time.Time         `json:"created_at"`
+	EndedAt   *time.Time        `json:"ended_at,omitempty"`
+	Jobs      []logsManifestJob `json:"jobs"`
+}
+
+type logsManifestJob struct {
+	ID            uuid.UUID               `json:"id"`
+	Name          string                  `json:"name"`
+	Type          string                  `json:"type,omitempty"`
+	Status        string                  `json:"status"`
+	ResourceClass string                  `json:"resource_class,omitempty"`
+	StartedAt     *time.Time              `json:"started_at,omitempty"`
+	EndedAt       *time.Time              `json:"ended_at,omitempty"`
+	Executions    []logsManifestExecution `json:"executions"`
+}
+
+type logsManifestExecution struct {
+	Index int                `json:"index"`
+	Steps []logsManifestStep `json:"steps"`
+}
+
+type logsManifestStep struct {
+	Num             int        `json:"num"`
+	Name            string     `json:"name"`
+	Status          string     `json:"status"`
+	ExitCode        *int       `json:"exit_code,omitempty"`
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	StoppedAt       *time.Time `json:"stopped_at,omitempty"`
+	DurationSeconds *float64   `json:"duration_seconds,omitempty"`
+	File            string     `json:"file,omitempty"`
+	StdoutBytes     int        `json:"stdout_bytes"`
+	StderrBytes     int        `json:"stderr_bytes"`
+}
+
+// logsDownload is one step to fetch, and where its manifest entry lives.
+type logsDownload struct {
+	jobID     uuid.UUID
+	execution int
+	num       int
+	path      string // relative to the output root
+	entry     *logsManifestStep
+}
+
+func runLogsDownload(ctx context.Context, client *apiclient.Client, r *apiclient.RunV3, output string) error {
+	archive := strings.HasSuffix(output, ".tar.gz") || strings.HasSuffix(output, ".tgz")
+
+	root := output
+	if archive {
+		if _, err := os.Stat(output); err == nil {
+			return logsOutputExists(output)
+		}
+		tmp, err := os.MkdirTemp("", "circleci-logs-")
+		if err != nil {
+			return logsWriteErr(err)
+		}
+		defer func() { _ = os.RemoveAll(tmp) }()
+		root = tmp
+	} else if err := ensureEmptyDir(output); err != nil {
+		return err
+	}
+
+	sp := iostream.Spinner(ctx, true, "Fetching workflows and jobs")
+	snap, err := fetchRunSnapshot(ctx, client, r)
+	sp.Stop()
+	if err != nil {
+		return err
+	}
+
+	manifest, downloads := planLogsDownload(snap)
+	manifest.DownloadedAt = time.Now().UTC()
+
+	sp = iostream.Spinner(ctx, true, fmt.Sprintf("Downloading output of %d steps", len(downloads)))
+	err = bulkhead.Do(ctx, logsParallelism, downloads, func(d logsDownload, _ int) error {
+		return downloadStepLog(ctx, client, root, d)
+	})
+	sp.Stop()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(root, "manifest.json"), append(data, '\n'), 0o644); err != nil { //#nosec:G306 // logs are meant to be shared
+		return logsWriteErr(err)
+	}
+
+	if archive {
+		if err := writeTarGz(output, root); err != nil {
+			_ = os.Remove(output)
+			return logsWriteErr(err)
+		}
+	}
+	iostream.ErrPrintf(ctx, "%s Saved the output of %d steps to %s\n", iostream.SymbolOK(ctx), len(downloads), output)
+	return nil
+}
+
+// planLogsDownload builds the manifest and lays out one file per started
+// step. Names that collide after sanitizing (a rerun workflow, two jobs
+// differing only in punctuation) get a numeric suffix.
+func planLogsDownload(snap runSnapshot) (logsManifest, []logsDownload) {
+	r := snap.run
+	m := logsManifest{
+		SchemaVersion: logsManifestVersion,
+		Run: logsManifestRun{
+			ID:        r.ID,
+			ProjectID: r.ProjectID,
+			Status:    apiclient.PhaseOutcomeText(r.Phase, r.Outcome, r.CurrentOutcome),
+			Branch:    r.Branch,
+			Tag:       r.Tag,
+			Revision:  r.Revision,
+			CreatedAt: r.CreatedAt,
+		},
+		Workflows: []logsManifestWorkflow{},
+	}
+
+	var downloads []logsDownload
+	wfDirs := map[string]int{}
+	for _, w := range snap.workflows {
+		wf := w.workflow
+		mw := logsManifestWorkflow{
+			ID:        wf.ID,
+			Name:      wf.Name,
+			Status:    apiclient.PhaseOutcomeText(wf.Phase, wf.Outcome, wf.CurrentOutcome),
+			CreatedAt: wf.CreatedAt,
+			EndedAt:   wf.EndedAt,
+			Jobs:      []logsManifestJob{},
+		}
+		wfDir := uniqueName(wfDirs, logFileName(wf.Name))
+		jobDirs := map[string]int{}
+		for _, j := range w.jobs {
+			mj := logsManifestJob{
+				ID:            j.job.ID,
+				Name:          j.job.Name,
+				Type:          j.job.Type,
+				Status:        apiclient.PhaseOutcomeText(j.job.Phase, j.job.Outcome, j.job.CurrentOutcome),
+				ResourceClass: j.resourceClass,
+				StartedAt:     j.job.StartedAt,
+				EndedAt:       j.job.EndedAt,
+				Executions:    []logsManifestExecution{},
+			}
+			jobDir := uniqueName(jobDirs, logFileName(j.job.Name))
+			if j.detail != nil {
+				for _, e := range j.detail.Executions {
+					me := logsManifestExecution{Index: e.Index, Steps: make([]logsManifestStep, len(e.Steps))}
+					width := len(fmt.Sprint(len(e.Steps)))
+					for i, s := range e.Steps {
+						ms := logsManifestStep{
+							Num:       s.Num,
+							Name:      s.Name,
+							Status:    apiclient.PhaseOutcomeText(s.Phase, s.Outcome, ""),
+							ExitCode:  s.ExitCode,
+							StoppedAt: s.StoppedAt,
+						}
+						if !s.StartedAt.IsZero() {
+							ms.StartedAt = new(s.StartedAt)
+							if s.StoppedAt != nil {
+								ms.DurationSeconds = new(s.StoppedAt.Sub(s.StartedAt).Seconds())
+							}
+							ms.File = filepath.ToSlash(filepath.Join(wfDir, jobDir, fmt.Sprintf("exec-%d", e.Index),
+								fmt.Sprintf("%0*d-%s.log", max(2, width), i+1, logFileName(s.Name))))
+						}
+						me.Steps[i] = ms
+					}
+					for i := range me.Steps {
+						if me.Steps[i].File == "" {
+							continue
+						}
+						downloads = append(downloads, logsDownload{
+							jobID:     j.job.ID,
+							execution: e.Index,
+							num:       me.Steps[i].Num,
+							path:      me.Steps[i].File,
+							entry:     &me.Steps[i],
+						})
+					}
+					mj.Executions = append(mj.Executions, me)
+				}
+			}
+			mw.Jobs = append(mw.Jobs, mj)
+		}
+		m.Workflows = append(m.Workflows, mw)
+	}
+	return m, downloads
+}
+
+// downloadStepLog writes a step's stdout followed by its stderr, raw, to its
+// file, and records their sizes. A stream that was never captured (404) is
+// simply empty.
+func downloadStepLog(ctx context.Context, client *apiclient.Client, root string, d logsDownload) error {
+	var stdout, stderr []byte
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		b, err := client.GetJobStdout(ctx, d.jobID, d.execution, d.num)
+		if err != nil && !httpcl.HasStatusCode(err, http.StatusNotFound) {
+			return err
+		}
+		stdout = b
+		return nil
+	})
+	g.Go(func() error {
+		b, err := client.GetJobStderr(ctx, d.jobID, d.execution, d.num)
+		if err != nil && !httpcl.HasStatusCode(err, http.StatusNotFound) {
+			return err
+		}
+		stderr = b
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return cmdutil.APIErr(err, fmt.Sprintf("step %d of job %s", d.num, d.jobID), "job.output_not_found",
+			"No output found for %s.")
+	}
+
+	d.entry.StdoutBytes, d.entry.StderrBytes = len(stdout), len(stderr)
+	path := filepath.Join(root, filepath.FromSlash(d.path))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return logsWriteErr(err)
+	}
+	if err := os.WriteFile(path, append(stdout, stderr...), 0o644); err != nil { //#nosec:G306 // logs are meant to be shared
+		return logsWriteErr(err)
+	}
+	return nil
+}
+
+// logFileName reduces a workflow, job or step name to a portable path
+// segment: lowercase letters, digits, dots and dashes, at most 60 long.
+func logFileName(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '_':
+			b.WriteRune(r)
+			dash = false
+		case !dash && b.Len() > 0:
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	s := strings.Trim(b.String(), "-.")
+	if len(s) > 60 {
+		s = strings.TrimRight(s[:60], "-.")
+	}
+	if s == "" {
+		s = "unnamed"
+	}
+	return s
+}
+
+// uniqueName returns name, or name-2, name-3… if it was already handed out.
+func uniqueName(seen map[string]int, name string) string {
+	seen[name]++
+	if n := seen[name]; n > 1 {
+		return fmt.Sprintf("%s-%d", name, n)
+	}
+	return name
+}
+
+// ensureEmptyDir creates dir, or accepts it if it exists and is empty, so
+// an archive is never mixed with an earlier one.
+func ensureEmptyDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return logsWriteErr(err)
+		}
+		return nil
+	case err != nil:
+		return logsWriteErr(err)
+	case len(entries) > 0:
+		return logsOutputExists(dir)
+	}
+	return nil
+}
+
+func logsOutputExists(path string) error {
+	return clierrors.New("run.logs_output_exists", "Output already exists",
+		fmt.Sprintf("%s already exists and is not empty.", path)).
+		WithSuggestions("Choose a new directory or archive name with -o").
+		WithExitCode(clierrors.ExitBadArguments)
+}
+
+func logsWriteErr(err error) error {
+	return clierrors.New("run.logs_write_failed", "Could not save logs", err.Error()).
+		WithExitCode(clierrors.ExitGeneralError)
+}
+
+// writeTarGz archives the tree under root into path, inside a top-level
+// directory named after the archive.
+func writeTarGz(path, root string) (err error) {
+	f, err := os.Create(path) //#nosec:G304 // path is user-supplied
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	base := filepath.Base(path)
+	for _, ext := range []string{".tar.gz", ".tgz"} {
+		base = strings.TrimSuffix(base, ext)
+	}
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || p == root {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(filepath.Join(base, rel))
+		if d.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		src, err := os.Open(p) //#nosec:G304 // p is inside our own temp dir
+		if err != nil {
+			return err
+		}
+		defer func() { _ = src.Close() }()
+		_, err = io.Copy(tw, src)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+)
+
+func TestLogFileName(t *testing.T) {
+	for in, want := range map[string]string{
+		"Run tests":                  "run-tests",
+		"Spin up environment":        "spin-up-environment",
+		"deploy/staging (us-east)":   "deploy-staging-us-east",
+		"  --weird--  ":              "weird",
+		"build_v1.2":                 "build_v1.2",
+		"🚀":                          "unnamed",
+		"Restoring Cache: go-mod-v1": "restoring-cache-go-mod-v1",
+	} {
+		assert.Check(t, is.Equal(logFileName(in), want), in)
+	}
+	assert.Check(t, is.Len(logFileName(strings.Repeat("a", 100)), 60))
+}
+
+// TestPlanLogsDownload verifies the layout: one file per started step under
+// workflow/job/exec-N, numbered by position, and a manifest entry for every
+// step including those that never started.
+func TestPlanLogsDownload(t *testing.T) {
+	snap := traceTestSnapshot()
+	exec1 := &snap.workflows[0].jobs[0].detail.Executions[1]
+	store := exec1.Steps[0]
+	store.Name = "Store results"
+	exec1.Steps = append(exec1.Steps, store)
+	// A rerun of the same workflow name must not share its directory.
+	snap.workflows = append(snap.workflows, snap.workflows[0])
+
+	m, downloads := planLogsDownload(snap)
+
+	assert.Check(t, is.Equal(m.SchemaVersion, logsManifestVersion))
+	assert.Assert(t, is.Len(m.Workflows, 2))
+	var paths []string
+	for _, d := range downloads {
+		paths = append(paths, d.path)
+	}
+	assert.Check(t, is.DeepEqual(paths, []string{
+		"build/test/exec-0/01-run-tests.log",
+		"build/test/exec-1/01-run-tests.log",
+		"build/test/exec-1/02-store-results.log",
+		"build-2/test/exec-0/01-run-tests.log",
+		"build-2/test/exec-1/01-run-tests.log",
+		"build-2/test/exec-1/02-store-results.log",
+	}))
+
+	hold := m.Workflows[0].Jobs[1]
+	assert.Check(t, is.Equal(hold.Name, "hold"))
+	assert.Check(t, is.Len(hold.Executions, 0))
+
+	step := m.Workflows[0].Jobs[0].Executions[1].Steps[0]
+	assert.Check(t, is.Equal(step.Status, "failed"))
+	assert.Check(t, is.Equal(*step.ExitCode, 1))
+	assert.Check(t, is.Equal(*step.DurationSeconds, 74.0))
+
+	// Sizes recorded by the download land in the manifest.
+	downloads[0].entry.StdoutBytes = 42
+	assert.Check(t, is.Equal(m.Workflows[0].Jobs[0].Executions[0].Steps[0].StdoutBytes, 42))
+}
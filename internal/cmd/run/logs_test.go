@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+)
+
+// TestLogFollowerPrint verifies a step's output is printed a whole line at a
+// time: a line split across two reads is held back until it is completed, and
+// an unterminated last line is only printed once the stream has ended.
+func TestLogFollowerPrint(t *testing.T) {
+	var out bytes.Buffer
+	ctx := iostream.New(context.Background(), iostream.Options{Out: &out, Err: &out})
+	f := &logFollower{}
+	s := &logStream{prefix: "[build/test#0 Run tests]"}
+
+	s.chunk = []byte("ok  pkg/a\nok  pk")
+	f.print(ctx, s)
+	assert.Check(t, is.Equal(out.String(), "[build/test#0 Run tests] ok  pkg/a\n"))
+
+	s.chunk = []byte("g/b\r\nPASS")
+	f.print(ctx, s)
+	assert.Check(t, is.Equal(out.String(), "[build/test#0 Run tests] ok  pkg/a\n"+
+		"[build/test#0 Run tests] ok  pkg/b\n"))
+
+	s.done = true
+	f.print(ctx, s)
+	assert.Check(t, is.Equal(out.String(), "[build/test#0 Run tests] ok  pkg/a\n"+
+		"[build/test#0 Run tests] ok  pkg/b\n"+
+		"[build/test#0 Run tests] PASS\n"))
+}
@@ -24,8 +24,6 @@ package run
 
 import (
 	"context"
-	"fmt"
-	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/google/uuid"
@@ -35,7 +33,6 @@ import (
 	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
 	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
-	"github.com/CircleCI-Public/circleci-cli/internal/gitremote"
 )
 
 func newOpenCmd() *cobra.Command {
@@ -90,53 +87,21 @@ func newOpenCmd() *cobra.Command {
 }
 
 func runOpen(ctx context.Context, client *apiclient.Client, args []string, projectSlug, branch string) error {
-
-	var (
-		id  uuid.UUID
-		err error
-	)
-
+	var id uuid.UUID
 	if len(args) == 1 {
+		// The page is addressed by UUID alone, so there is no need to fetch
+		// the run first.
+		var err error
 		id, err = uuid.Parse(args[0])
 		if err != nil {
 			return apiErr(err, args[0])
 		}
 	} else {
-		info, err := gitremote.Detect()
+		r, err := resolveRun(ctx, client, nil, projectSlug, branch)
 		if err != nil {
-			return cmdutil.GitDetectErr(err, "Or provide a run UUID: circleci run get <uuid>")
-		}
-		if projectSlug == "" {
-			projectSlug = info.Slug
-		}
-
-		effectiveBranch := branch
-		if effectiveBranch == "" {
-			effectiveBranch = info.Branch
-		}
-
-		proj, err := client.GetProjectBySlug(ctx, projectSlug)
-		if err != nil {
-			return apiErr(err, projectSlug)
-		}
-
-		sp := iostream.Spinner(ctx, true, fmt.Sprintf("Fetching latest run for %s on branch %s", projectSlug, effectiveBranch))
-		now := time.Now().UTC()
-		runs, searchErr := client.SearchRunsV3(ctx, apiclient.RunSearchParams{
-			ProjectIDs: []string{proj.ID.String()},
-			From:       now.AddDate(0, 0, -90),
-			To:         now,
-			Filter:     apiclient.BuildRunFilter(effectiveBranch, ""),
-			Limit:      1,
-		})
-		sp.Stop()
-		if searchErr != nil {
-			return apiErr(searchErr, fmt.Sprintf("%s@%s", projectSlug, effectiveBranch))
-		}
-		if len(runs) == 0 {
-			return apiErr(fmt.Errorf("no runs found"), fmt.Sprintf("%s@%s", projectSlug, effectiveBranch))
+			return err
 		}
-		id = runs[0].ID
+		id = r.ID
 	}
 
 	appURL, err := cmdutil.AppURL(ctx)
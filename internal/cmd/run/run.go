@@ -23,13 +23,20 @@
 package run
 
 import (
+	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 
 	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/gitremote"
 )
 
 // NewRunCmd returns the "circleci run" command group.
@@ -51,9 +58,14 @@ func NewRunCmd() *cobra.Command {
 		newListCmd(),
 	)
 	cmdutil.AddGroup(cmd, "Targeted commands",
+		newBisectCmd(),
 		newCancelCmd(),
+		newCompareCmd(),
 		newOpenCmd(),
 		newGetCmd(),
+		newGrepCmd(),
+		newLogsCmd(),
+		newTraceCmd(),
 		newTriggerCmd(),
 		newWatchCmd(),
 	)
@@ -72,3 +84,58 @@ func apiErr(err error, subject string) *clierrors.CLIError {
 func looksLikeNumber(s string) bool {
 	return !strings.Contains(s, "-") && len(s) > 0
 }
+
+// resolveRun finds the run a targeted command acts on: the run UUID in args
+// when one is given, otherwise the latest run on a branch. Without --project
+// both the project and (when not supplied) the branch come from the current git
+// repository; with an explicit --project the local branch means nothing, so the
+// branch defaults to defaultBranchGuess instead.
+func resolveRun(ctx context.Context, client *apiclient.Client, args []string, projectSlug, branch string) (*apiclient.RunV3, error) {
+	if len(args) == 1 {
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return nil, apiErr(err, args[0])
+		}
+		r, err := client.GetRunV3(ctx, id)
+		if err != nil {
+			return nil, apiErr(err, id.String())
+		}
+		return r, nil
+	}
+
+	if projectSlug == "" {
+		info, err := gitremote.Detect()
+		if err != nil {
+			return nil, cmdutil.GitDetectErr(err, "Or provide a run UUID, or --project and --branch")
+		}
+		projectSlug = info.Slug
+		if branch == "" {
+			branch = info.Branch
+		}
+	} else if branch == "" {
+		branch = defaultBranchGuess
+	}
+
+	proj, err := client.GetProjectBySlug(ctx, projectSlug)
+	if err != nil {
+		return nil, apiErr(err, projectSlug)
+	}
+
+	sp := iostream.Spinner(ctx, true, fmt.Sprintf("Fetching latest run for %s on branch %s", projectSlug, branch))
+	now := time.Now().UTC()
+	runs, err := client.SearchRunsV3(ctx, apiclient.RunSearchParams{
+		ProjectIDs: []string{proj.ID.String()},
+		From:       now.AddDate(0, 0, -90),
+		To:         now,
+		Filter:     apiclient.BuildRunFilter(branch, ""),
+		Limit:      1,
+	})
+	sp.Stop()
+	if err != nil {
+		return nil, apiErr(err, fmt.Sprintf("%s@%s", projectSlug, branch))
+	}
+	if len(runs) == 0 {
+		return nil, apiErr(fmt.Errorf("no runs found"), fmt.Sprintf("%s@%s", projectSlug, branch))
+	}
+	return &runs[0], nil
+}
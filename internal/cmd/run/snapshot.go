@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+)
+
+const snapshotParallelism = 8
+
+// runSnapshot is a run with every workflow, job, job detail and resource
+// class fetched up front, so that compare and trace are pure functions of it.
+type runSnapshot struct {
+	run       *apiclient.RunV3
+	workflows []snapshotWorkflow
+}
+
+type snapshotWorkflow struct {
+	workflow apiclient.WorkflowV3
+	jobs     []*snapshotJob
+}
+
+type snapshotJob struct {
+	job apiclient.WorkflowJobV3
+	// detail is nil for a job that never ran: an approval, or one that was
+	// blocked or cancelled before starting.
+	detail        *apiclient.JobV3
+	resourceClass string
+}
+
+func fetchRunSnapshot(ctx context.Context, client *apiclient.Client, r *apiclient.RunV3) (runSnapshot, error) {
+	snap := runSnapshot{run: r}
+
+	workflows, err := client.GetRunWorkflowsV3(ctx, r.ID)
+	if err != nil {
+		if httpcl.HasStatusCode(err, http.StatusNotFound) {
+			return snap, nil
+		}
+		return snap, apiErr(err, r.ID.String())
+	}
+
+	var all []*snapshotJob
+	for _, wf := range workflows {
+		jobs, err := client.GetWorkflowJobsV3(ctx, wf.ID)
+		if err != nil {
+			return snap, apiErr(err, wf.ID.String())
+		}
+		cw := snapshotWorkflow{workflow: wf}
+		for _, j := range jobs {
+			cj := &snapshotJob{job: j}
+			cw.jobs = append(cw.jobs, cj)
+			all = append(all, cj)
+		}
+		snap.workflows = append(snap.workflows, cw)
+	}
+
+	err = bulkhead.Do(ctx, snapshotParallelism, all, func(cj *snapshotJob, _ int) error {
+		if cj.job.Type == apiclient.JobTypeApproval || cj.job.StartedAt == nil {
+			return nil
+		}
+		d, err := client.GetJobV3(ctx, cj.job.ID)
+		if err != nil && !httpcl.HasStatusCode(err, http.StatusNotFound) {
+			return cmdutil.APIErr(err, cj.job.ID.String(), "job.not_found", "No job found for %q.")
+		}
+		cj.detail = d
+
+		// Resource usage 404s for jobs that never ran an executor; the class
+		// is simply unknown then.
+		usage, err := client.GetJobResourceUsage(ctx, cj.job.ID)
+		if err != nil && !httpcl.HasStatusCode(err, http.StatusNotFound) {
+			return cmdutil.APIErr(err, cj.job.ID.String(), "job.not_found", "No job found for %q.")
+		}
+		if usage != nil {
+			cj.resourceClass = usage.ResourceClass.Name
+		}
+		return nil
+	})
+	return snap, err
+}
@@ -0,0 +1,625 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+)
+
+const (
+	traceFormatOTLP   = "otlp-json"
+	traceFormatChrome = "chrome"
+
+	// otlpTracesPath is appended to a collector's base endpoint, as the OTLP
+	// exporter specification does for OTEL_EXPORTER_OTLP_ENDPOINT.
+	otlpTracesPath = "/v1/traces"
+)
+
+func newTraceCmd() *cobra.Command {
+	var (
+		projectSlug  string
+		branch       string
+		format       string
+		outputPath   string
+		otlpEndpoint string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "trace [<run-id>]",
+		Short: "Export a run as a trace file",
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Docf(`
+				%[1]s<run-id>%[1]s is the run UUID. Omit it to trace the latest run on the current
+				branch (or %[1]s--branch%[1]s) of the current project (or %[1]s--project%[1]s).
+			`, "`"),
+		},
+		Long: heredoc.Doc(`
+			Write a run as a trace: the run is the root span, workflows and jobs
+			are child spans, and steps are leaf spans with status, exit code and
+			resource class. otlp-json loads in Jaeger; chrome loads in Perfetto,
+			with a track per parallel execution.
+
+			--otlp-endpoint also sends the trace to an OTLP/HTTP collector, with
+			headers from OTEL_EXPORTER_OTLP_HEADERS (key=value,...).
+		`),
+		Example: heredoc.Doc(`
+			# Open the latest run on this branch in Perfetto
+			$ circleci run trace --format chrome -o run.json
+
+			# Send a run to a local collector
+			$ circleci run trace 5034460f-c7c4-4c43-9457-de07e2029e7b --otlp-endpoint http://localhost:4318
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if format != traceFormatOTLP && format != traceFormatChrome {
+				return clierrors.New("run.invalid_trace_format", "Invalid trace format",
+					fmt.Sprintf("Unknown --format %q.", format)).
+					WithSuggestions("Use --format otlp-json or --format chrome").
+					WithExitCode(clierrors.ExitBadArguments)
+			}
+			if otlpEndpoint != "" {
+				if u, err := url.Parse(otlpEndpoint); err != nil || u.Scheme == "" || u.Host == "" {
+					return clierrors.New("run.invalid_otlp_endpoint", "Invalid OTLP endpoint",
+						fmt.Sprintf("%q is not an absolute URL.", otlpEndpoint)).
+						WithSuggestions("Pass the collector's base URL, e.g. --otlp-endpoint http://localhost:4318").
+						WithExitCode(clierrors.ExitBadArguments)
+				}
+			}
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+			return runTrace(ctx, client, args, projectSlug, branch, traceOptions{
+				Format:       format,
+				OutputPath:   outputPath,
+				OTLPEndpoint: otlpEndpoint,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&projectSlug, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Trace the latest run on this branch (defaults to current branch)")
+	cmd.Flags().StringVar(&format, "format", traceFormatOTLP, "Trace format: otlp-json or chrome")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write the trace to this file instead of stdout")
+	cmd.Flags().StringVar(&otlpEndpoint, "otlp-endpoint", "", "Also send the trace to this OTLP/HTTP collector")
+
+	return cmd
+}
+
+type traceOptions struct {
+	Format       string
+	OutputPath   string
+	OTLPEndpoint string
+}
+
+func runTrace(ctx context.Context, client *apiclient.Client, args []string, projectSlug, branch string, opts traceOptions) error {
+	r, err := resolveRun(ctx, client, args, projectSlug, branch)
+	if err != nil {
+		return err
+	}
+
+	sp := iostream.Spinner(ctx, true, "Fetching workflows and jobs")
+	snap, err := fetchRunSnapshot(ctx, client, r)
+	sp.Stop()
+	if err != nil {
+		return err
+	}
+
+	tr := buildTrace(snap, time.Now())
+
+	if opts.OTLPEndpoint != "" {
+		if err := sendOTLP(ctx, opts.OTLPEndpoint, otlpTrace(tr)); err != nil {
+			return err
+		}
+		iostream.ErrPrintf(ctx, "%s Sent %d spans to %s\n", iostream.SymbolOK(ctx), len(tr.spans), opts.OTLPEndpoint)
+		if opts.OutputPath == "" {
+			return nil
+		}
+	}
+
+	var doc any = otlpTrace(tr)
+	if opts.Format == traceFormatChrome {
+		doc = chromeTrace(tr)
+	}
+
+	w := iostream.Out(ctx)
+	if opts.OutputPath != "" {
+		f, err := os.Create(opts.OutputPath) //#nosec:G304 // path is user-supplied
+		if err != nil {
+			return clierrors.New("run.trace_write_failed", "Could not write trace file",
+				err.Error()).
+				WithExitCode(clierrors.ExitGeneralError)
+		}
+		defer func() { _ = f.Close() }()
+		w = f
+	}
+	if err := writeTrace(w, doc); err != nil {
+		return clierrors.New("run.trace_write_failed", "Could not write trace file",
+			err.Error()).
+			WithExitCode(clierrors.ExitGeneralError)
+	}
+	if opts.OutputPath != "" {
+		iostream.ErrPrintf(ctx, "%s Wrote %d spans to %s\n", iostream.SymbolOK(ctx), len(tr.spans), opts.OutputPath)
+	}
+	return nil
+}
+
+func writeTrace(w io.Writer, doc any) error {
+	return json.NewEncoder(w).Encode(doc)
+}
+
+// traceStatus is a span's outcome, in OTLP's terms.
+type traceStatus int
+
+const (
+	traceStatusUnset traceStatus = iota
+	traceStatusOK
+	traceStatusError
+)
+
+// runTraceData is a run flattened into spans, parents before children,
+// independent of output format.
+type runTraceData struct {
+	// traceID is the run's UUID as 32 hex digits: a UUID is 16 bytes,
+	// exactly the size of a trace ID.
+	traceID string
+	spans   []traceSpan
+}
+
+// traceSpan is one span of the run. kind is run, workflow, job or step.
+type traceSpan struct {
+	id, parentID string
+	kind         string
+	name         string
+	start, end   time.Time
+	status       traceStatus
+	// statusText is the CircleCI outcome, kept as the error message for
+	// failed spans.
+	statusText string
+	attrs      []traceAttr
+
+	// group and lane place the span on the chrome format's process and
+	// thread tracks, which have no notion of parents.
+	group, lane string
+}
+
+type traceAttr struct {
+	key   string
+	value any // string, int or bool
+}
+
+// traceSpanID derives a stable 8-byte span ID from the entity it represents,
+// so re-exporting a run yields the same IDs.
+func traceSpanID(parts ...any) string {
+	sum := sha256.Sum256([]byte(fmt.Sprint(parts...)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// traceOutcome maps a phase and outcome to a span status. Only an ended
+// entity has a status; anything still in progress is left unset.
+func traceOutcome(phase, outcome, currentOutcome string) (traceStatus, string) {
+	if phase != apiclient.PhaseEnded {
+		return traceStatusUnset, phase
+	}
+	if outcome == "" {
+		outcome = currentOutcome
+	}
+	switch outcome {
+	case "succeeded":
+		return traceStatusOK, outcome
+	case "failed", "errored", "timedout", "infrastructure_fail":
+		return traceStatusError, outcome
+	default:
+		return traceStatusUnset, outcome
+	}
+}
+
+// buildTrace flattens a run into spans. Jobs that never started have no
+// interval and are left out; anything still running ends at now.
+func buildTrace(snap runSnapshot, now time.Time) runTraceData {
+	r := snap.run
+	runSpan := traceSpan{
+		id:    traceSpanID("run", r.ID),
+		kind:  "run",
+		name:  "run " + traceRunLabel(r),
+		start: r.CreatedAt,
+		end:   r.CreatedAt,
+		group: "run",
+		lane:  "run",
+		attrs: []traceAttr{
+			{"cicd.pipeline.run.id", r.ID.String()},
+			{"circleci.project.id", r.ProjectID.String()},
+		},
+	}
+	runSpan.status, runSpan.statusText = traceOutcome(r.Phase, r.Outcome, r.CurrentOutcome)
+	if r.Branch != "" {
+		runSpan.attrs = append(runSpan.attrs, traceAttr{"vcs.ref.head.name", r.Branch})
+	}
+	if r.Tag != "" {
+		runSpan.attrs = append(runSpan.attrs, traceAttr{"vcs.ref.head.tag", r.Tag})
+	}
+	if r.Revision != "" {
+		runSpan.attrs = append(runSpan.attrs, traceAttr{"vcs.ref.head.revision", r.Revision})
+	}
+	if r.Phase != apiclient.PhaseEnded {
+		runSpan.end = now
+	}
+
+	spans := []traceSpan{runSpan}
+	for _, w := range snap.workflows {
+		wf := w.workflow
+		wfSpan := traceSpan{
+			id:       traceSpanID("workflow", wf.ID),
+			parentID: runSpan.id,
+			kind:     "workflow",
+			name:     wf.Name,
+			start:    wf.CreatedAt,
+			end:      now,
+			group:    wf.Name,
+			lane:     "workflow",
+			attrs: []traceAttr{
+				{"cicd.pipeline.name", wf.Name},
+				{"circleci.workflow.id", wf.ID.String()},
+			},
+		}
+		if wf.EndedAt != nil {
+			wfSpan.end = *wf.EndedAt
+		}
+		wfSpan.status, wfSpan.statusText = traceOutcome(wf.Phase, wf.Outcome, wf.CurrentOutcome)
+		spans = append(spans, wfSpan)
+		if wfSpan.end.After(spans[0].end) {
+			spans[0].end = wfSpan.end
+		}
+
+		for _, j := range w.jobs {
+			spans = append(spans, traceJobSpans(j, wfSpan, now)...)
+		}
+	}
+	return runTraceData{traceID: strings.ReplaceAll(r.ID.String(), "-", ""), spans: spans}
+}
+
+func traceRunLabel(r *apiclient.RunV3) string {
+	switch {
+	case r.Branch != "" && r.Revision != "":
+		return r.Branch + "@" + shortSHA(r.Revision)
+	case r.Tag != "":
+		return r.Tag
+	case r.Branch != "":
+		return r.Branch
+	default:
+		return r.ID.String()
+	}
+}
+
+func traceJobSpans(j *snapshotJob, wf traceSpan, now time.Time) []traceSpan {
+	if j.job.StartedAt == nil {
+		return nil
+	}
+	jobSpan := traceSpan{
+		id:       traceSpanID("job", j.job.ID),
+		parentID: wf.id,
+		kind:     "job",
+		name:     j.job.Name,
+		start:    *j.job.StartedAt,
+		end:      now,
+		group:    wf.group,
+		lane:     j.job.Name,
+		attrs: []traceAttr{
+			{"cicd.pipeline.task.name", j.job.Name},
+			{"cicd.pipeline.task.run.id", j.job.ID.String()},
+			{"circleci.job.type", j.job.Type},
+		},
+	}
+	if j.job.EndedAt != nil {
+		jobSpan.end = *j.job.EndedAt
+	}
+	jobSpan.status, jobSpan.statusText = traceOutcome(j.job.Phase, j.job.Outcome, j.job.CurrentOutcome)
+	if j.resourceClass != "" {
+		jobSpan.attrs = append(jobSpan.attrs, traceAttr{"circleci.resource_class", j.resourceClass})
+	}
+	if j.detail == nil {
+		return []traceSpan{jobSpan}
+	}
+
+	execs := j.detail.Executions
+	jobSpan.attrs = append(jobSpan.attrs, traceAttr{"circleci.job.parallelism", len(execs)})
+	spans := []traceSpan{jobSpan}
+	for i, e := range execs {
+		lane := j.job.Name
+		if i > 0 {
+			lane = fmt.Sprintf("%s [%d]", j.job.Name, i)
+		}
+		for _, s := range e.Steps {
+			if s.StartedAt.IsZero() {
+				continue
+			}
+			st := traceSpan{
+				id:       traceSpanID("step", j.job.ID, i, s.Num),
+				parentID: jobSpan.id,
+				kind:     "step",
+				name:     s.Name,
+				start:    s.StartedAt,
+				end:      now,
+				group:    wf.group,
+				lane:     lane,
+				attrs: []traceAttr{
+					{"circleci.step.num", s.Num},
+					{"circleci.step.type", s.Type},
+					{"circleci.job.execution.index", i},
+				},
+			}
+			if s.StoppedAt != nil {
+				st.end = *s.StoppedAt
+			}
+			st.status, st.statusText = traceOutcome(s.Phase, s.Outcome, "")
+			if s.ExitCode != nil {
+				st.attrs = append(st.attrs, traceAttr{"circleci.step.exit_code", *s.ExitCode})
+			}
+			if j.resourceClass != "" {
+				st.attrs = append(st.attrs, traceAttr{"circleci.resource_class", j.resourceClass})
+			}
+			spans = append(spans, st)
+		}
+	}
+	return spans
+}
+
+// --- OTLP/JSON ---
+
+// The otlp* types follow the OTLP/HTTP JSON encoding of
+// ExportTraceServiceRequest: lowerCamelCase fields, hex trace and span IDs,
+// and 64-bit integers as decimal strings.
+type otlpExport struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string         `json:"key"`
+	Value map[string]any `json:"value"`
+}
+
+// otlpSpanKindInternal is SPAN_KIND_INTERNAL; CI work is neither a server
+// nor a client call.
+const otlpSpanKindInternal = 1
+
+func otlpTrace(tr runTraceData) otlpExport {
+	out := make([]otlpSpan, 0, len(tr.spans))
+	for _, s := range tr.spans {
+		span := otlpSpan{
+			TraceID:           tr.traceID,
+			SpanID:            s.id,
+			ParentSpanID:      s.parentID,
+			Name:              s.name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+			Attributes:        otlpAttributes(append(s.attrs, traceAttr{"circleci.status", s.statusText})),
+			Status:            otlpStatus{Code: int(s.status)},
+		}
+		if s.status == traceStatusError {
+			span.Status.Message = s.statusText
+		}
+		out = append(out, span)
+	}
+
+	return otlpExport{ResourceSpans: []otlpResourceSpans{{
+		Resource: otlpResource{Attributes: otlpAttributes([]traceAttr{{"service.name", "circleci"}})},
+		ScopeSpans: []otlpScopeSpans{{
+			Scope: otlpScope{Name: "circleci-cli"},
+			Spans: out,
+		}},
+	}}}
+}
+
+func otlpAttributes(attrs []traceAttr) []otlpKeyValue {
+	kvs := make([]otlpKeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		var v map[string]any
+		switch t := a.value.(type) {
+		case int:
+			v = map[string]any{"intValue": strconv.Itoa(t)}
+		case bool:
+			v = map[string]any{"boolValue": t}
+		default:
+			s := fmt.Sprint(t)
+			if s == "" {
+				continue
+			}
+			v = map[string]any{"stringValue": s}
+		}
+		kvs = append(kvs, otlpKeyValue{Key: a.key, Value: v})
+	}
+	return kvs
+}
+
+// sendOTLP posts the trace to a collector's OTLP/HTTP traces endpoint.
+func sendOTLP(ctx context.Context, endpoint string, doc otlpExport) error {
+	target := strings.TrimSuffix(endpoint, "/")
+	if !strings.HasSuffix(target, otlpTracesPath) {
+		target += otlpTracesPath
+	}
+
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	// OTLP/HTTP specifies a bare application/json content type.
+	opts := []func(*httpcl.Request){httpcl.RawBody(body, "application/json")}
+	for _, kv := range strings.Split(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"), ",") {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			continue
+		}
+		// Values may be percent-encoded per the exporter specification.
+		if dec, err := url.QueryUnescape(strings.TrimSpace(v)); err == nil {
+			v = dec
+		}
+		opts = append(opts, httpcl.Header(strings.TrimSpace(k), v))
+	}
+
+	hc := httpcl.New(httpcl.Config{UserAgent: "circleci-cli run-trace"})
+	if _, err := hc.Call(ctx, httpcl.NewRequest(http.MethodPost, target, opts...)); err != nil {
+		return clierrors.New("run.otlp_send_failed", "Could not send trace",
+			fmt.Sprintf("Sending to %s failed: %s", target, err)).
+			WithSuggestions("Check the collector is reachable and accepts OTLP/HTTP JSON").
+			WithExitCode(clierrors.ExitAPIError)
+	}
+	return nil
+}
+
+// --- Chrome Trace Event format ---
+
+type chromeTraceDoc struct {
+	TraceEvents     []chromeEvent `json:"traceEvents"`
+	DisplayTimeUnit string        `json:"displayTimeUnit"`
+}
+
+type chromeEvent struct {
+	Name string         `json:"name"`
+	Cat  string         `json:"cat,omitempty"`
+	Ph   string         `json:"ph"`
+	Ts   int64          `json:"ts"`
+	Dur  *int64         `json:"dur,omitempty"`
+	Pid  int            `json:"pid"`
+	Tid  int            `json:"tid"`
+	Args map[string]any `json:"args,omitempty"`
+}
+
+// chromeTrace lays spans out as complete ("X") events. Each workflow is a
+// process and each job execution a thread, so a job's steps nest under it
+// and parallel executions get their own tracks. Timestamps are microseconds
+// from the run's creation.
+func chromeTrace(tr runTraceData) chromeTraceDoc {
+	doc := chromeTraceDoc{TraceEvents: []chromeEvent{}, DisplayTimeUnit: "ms"}
+	if len(tr.spans) == 0 {
+		return doc
+	}
+	origin := tr.spans[0].start
+
+	type track struct{ pid, tid int }
+	pids := map[string]int{}
+	tids := map[int]int{} // last thread ID handed out per process
+	lanes := map[string]track{}
+	var meta []chromeEvent
+
+	for _, s := range tr.spans {
+		pid, ok := pids[s.group]
+		if !ok {
+			pid = len(pids) + 1
+			pids[s.group] = pid
+			meta = append(meta,
+				chromeEvent{Name: "process_name", Ph: "M", Pid: pid, Args: map[string]any{"name": s.group}},
+				chromeEvent{Name: "process_sort_index", Ph: "M", Pid: pid, Args: map[string]any{"sort_index": pid}})
+		}
+		key := s.group + "\x00" + s.lane
+		t, ok := lanes[key]
+		if !ok {
+			tids[pid]++
+			t = track{pid: pid, tid: tids[pid]}
+			lanes[key] = t
+			meta = append(meta,
+				chromeEvent{Name: "thread_name", Ph: "M", Pid: pid, Tid: t.tid, Args: map[string]any{"name": s.lane}},
+				chromeEvent{Name: "thread_sort_index", Ph: "M", Pid: pid, Tid: t.tid, Args: map[string]any{"sort_index": t.tid}})
+		}
+
+		args := map[string]any{"status": s.statusText}
+		for _, a := range s.attrs {
+			args[a.key] = a.value
+		}
+		doc.TraceEvents = append(doc.TraceEvents, chromeEvent{
+			Name: s.name,
+			Cat:  s.kind,
+			Ph:   "X",
+			Ts:   s.start.Sub(origin).Microseconds(),
+			Dur:  new(max(0, s.end.Sub(s.start).Microseconds())),
+			Pid:  t.pid,
+			Tid:  t.tid,
+			Args: args,
+		})
+	}
+
+	// Viewers nest events on a thread by start time; a stable order keeps
+	// a step that starts with its job inside it.
+	sort.SliceStable(doc.TraceEvents, func(i, j int) bool {
+		return doc.TraceEvents[i].Ts < doc.TraceEvents[j].Ts
+	})
+	doc.TraceEvents = append(meta, doc.TraceEvents...)
+	return doc
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+)
+
+// traceTestSnapshot is an ended run with one workflow holding a two-way
+// parallel test job whose second execution failed, and an approval that
+// never started.
+func traceTestSnapshot() runSnapshot {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(secs int) time.Time { return start.Add(time.Duration(secs) * time.Second) }
+	step := func(num int, outcome string, from, to int) apiclient.JobV3Step {
+		s := apiclient.JobV3Step{
+			Name: "Run tests", Num: num, Phase: apiclient.PhaseEnded, Outcome: outcome,
+			StartedAt: at(from), StoppedAt: new(at(to)),
+		}
+		if outcome == "failed" {
+			s.ExitCode = new(1)
+		}
+		return s
+	}
+
+	jobID := uuid.MustParse("d0000000-0000-4000-8000-000000000001")
+	return runSnapshot{
+		run: &apiclient.RunV3{
+			ID: uuid.MustParse("f0000000-0000-4000-8000-000000000001"), Phase: apiclient.PhaseEnded,
+			CurrentOutcome: "failed", Branch: "main", Revision: "abcdef1234567", CreatedAt: start,
+		},
+		workflows: []snapshotWorkflow{{
+			workflow: apiclient.WorkflowV3{
+				ID: uuid.MustParse("b0000000-0000-4000-8000-000000000001"), Name: "build",
+				Phase: apiclient.PhaseEnded, Outcome: "failed", CreatedAt: at(1), EndedAt: new(at(90)),
+			},
+			jobs: []*snapshotJob{
+				{
+					job: apiclient.WorkflowJobV3{
+						ID: jobID, Name: "test", Type: "build", Phase: apiclient.PhaseEnded, Outcome: "failed",
+						StartedAt: new(at(5)), EndedAt: new(at(80)),
+					},
+					detail: &apiclient.JobV3{Executions: []apiclient.JobV3Execution{
+						{Index: 0, Steps: []apiclient.JobV3Step{step(101, "succeeded", 5, 60)}},
+						{Index: 1, Steps: []apiclient.JobV3Step{step(101, "failed", 6, 80)}},
+					}},
+					resourceClass: "large",
+				},
+				{job: apiclient.WorkflowJobV3{Name: "hold", Type: apiclient.JobTypeApproval, Phase: apiclient.PhaseCreated}},
+			},
+		}},
+	}
+}
+
+func TestBuildTrace(t *testing.T) {
+	tr := buildTrace(traceTestSnapshot(), time.Now())
+
+	assert.Check(t, is.Equal(tr.traceID, "f0000000000040008000000000000001"))
+	assert.Assert(t, is.Len(tr.spans, 5)) // run, workflow, job, two steps; hold never started
+
+	run, wf, job, exec0, exec1 := tr.spans[0], tr.spans[1], tr.spans[2], tr.spans[3], tr.spans[4]
+	assert.Check(t, is.Equal(run.name, "run main@abcdef1"))
+	assert.Check(t, is.Equal(run.parentID, ""))
+	assert.Check(t, is.Equal(run.status, traceStatusError))
+	assert.Check(t, is.Equal(run.end, wf.end), "the run ends with its last workflow")
+	assert.Check(t, is.Equal(wf.parentID, run.id))
+	assert.Check(t, is.Equal(job.parentID, wf.id))
+	assert.Check(t, is.Equal(exec0.parentID, job.id))
+	assert.Check(t, is.Equal(exec1.parentID, job.id))
+
+	assert.Check(t, is.Equal(exec0.status, traceStatusOK))
+	assert.Check(t, is.Equal(exec1.status, traceStatusError))
+	assert.Check(t, exec0.id != exec1.id, "steps in different executions need distinct IDs")
+	assert.Check(t, is.Contains(exec1.attrs, traceAttr{"circleci.step.exit_code", 1}))
+	assert.Check(t, is.Contains(exec1.attrs, traceAttr{"circleci.resource_class", "large"}))
+	assert.Check(t, is.Contains(exec1.attrs, traceAttr{"circleci.job.execution.index", 1}))
+
+	again := buildTrace(traceTestSnapshot(), time.Now())
+	assert.Check(t, is.Equal(again.spans[4].id, exec1.id), "span IDs are stable across exports")
+}
+
+// TestChromeTrace verifies the chrome layout: a process per workflow and a
+// thread per execution, with the job's steps on the job's own track.
+func TestChromeTrace(t *testing.T) {
+	doc := chromeTrace(buildTrace(traceTestSnapshot(), time.Now()))
+
+	threads := map[string][2]int{}
+	var events []chromeEvent
+	for _, e := range doc.TraceEvents {
+		switch {
+		case e.Ph == "M" && e.Name == "thread_name":
+			threads[e.Args["name"].(string)] = [2]int{e.Pid, e.Tid}
+		case e.Ph == "X":
+			events = append(events, e)
+		}
+	}
+	assert.Check(t, is.DeepEqual(threads, map[string][2]int{
+		"run":      {1, 1},
+		"workflow": {2, 1},
+		"test":     {2, 2},
+		"test [1]": {2, 3},
+	}))
+
+	assert.Assert(t, is.Len(events, 5))
+	assert.Check(t, is.Equal(events[0].Ts, int64(0)))
+	assert.Check(t, is.Equal(*events[0].Dur, int64(90_000_000)))
+	// The job and its first execution's step start together; the job must
+	// come first so viewers nest the step inside it.
+	assert.Check(t, is.Equal(events[2].Cat, "job"))
+	assert.Check(t, is.Equal(events[3].Cat, "step"))
+}
@@ -24,41 +24,67 @@ package run
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
 	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
 	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
 	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/configcmd"
 	"github.com/CircleCI-Public/circleci-cli/internal/gitremote"
 )
 
+// defaultLocalConfig is the config --local-config submits, relative to the
+// repository root.
+const defaultLocalConfig = ".circleci/config.yml"
+
+// triggerOptions carries run trigger's flags.
+type triggerOptions struct {
+	ProjectSlug string
+	// Branch, SHA, Tag and PR pick what the run checks out; at most one is set.
+	Branch     string
+	SHA        string
+	Tag        string
+	PR         int
+	Params     []string
+	ParamsFile string
+	// ConfigFile is a local config to compile and run in place of the one
+	// committed on Branch.
+	ConfigFile    string
+	AllowUnpushed bool
+	Watch         bool
+	JSON          bool
+}
+
 func newTriggerCmd() *cobra.Command {
 	var (
-		projectSlug string
-		branch      string
-		params      []string
-		jsonOut     bool
+		opts        triggerOptions
+		localConfig bool
 	)
 
 	cmd := &cobra.Command{
 		Use:   "trigger",
 		Short: "Trigger a new run",
 		Long: heredoc.Doc(`
-			Trigger a new run for a CircleCI project.
-
-			The project and branch are inferred from the current git repository
-			unless overridden with --project or --branch.
+			Trigger a new run. Project and branch default to the git remote and checked-out
+			branch; --sha and --pr trigger the branch origin has at that commit now.
+			Parameter values are typed: true/false as booleans, integers as numbers, the
+			rest as strings. --local-config first asks origin whether HEAD is pushed to the
+			branch (--allow-unpushed skips this); run list marks such runs "(local config)".
 
-			Pass run parameters with --parameter. Values are parsed as
-			booleans (true/false), integers, or strings.
-
-			JSON fields: id, number, state, created_at
+			JSON fields: id, number, state, created_at, branch, tag, local_config
 		`),
 		Example: heredoc.Doc(`
 			# Trigger a run on the current branch
@@ -67,42 +93,135 @@ func newTriggerCmd() *cobra.Command {
 			# Trigger on a specific branch
 			$ circleci run trigger --branch main
 
+			# Trigger a tag
+			$ circleci run trigger --tag v1.2.0
+
+			# Trigger the origin branch whose tip is a commit
+			$ circleci run trigger --sha 5f2b9c1
+
+			# Trigger a pull request's head
+			$ circleci run trigger --pr 123
+
 			# Trigger with run parameters
 			$ circleci run trigger --parameter deploy_env=staging --parameter run_e2e=true
 
+			# Take parameters from a file, overriding one
+			$ circleci run trigger --parameters-file params.yml --parameter run_e2e=false
+
+			# Try an uncommitted change to .circleci/config.yml
+			$ circleci run trigger --local-config
+
+			# Trigger and watch the run until it completes
+			$ circleci run trigger --watch
+
 			# Output the triggered run as JSON
 			$ circleci run trigger --json
 		`),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
+			if err := validateTriggerFlags(&opts, localConfig); err != nil {
+				return err
+			}
 			client, err := cmdutil.LoadClient(ctx)
 			if err != nil {
 				return err
 			}
-			return runTrigger(ctx, client, projectSlug, branch, params, jsonOut)
+			return runTrigger(ctx, client, opts)
 		},
 	}
 
-	cmd.Flags().StringVar(&projectSlug, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
-	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch to trigger (defaults to current branch)")
-	cmd.Flags().StringArrayVar(&params, "parameter", nil, "Run parameter as key=value (repeatable)")
-	cmdutil.AddJSONFlag(cmd, &jsonOut)
+	cmd.Flags().StringVar(&opts.ProjectSlug, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Branch to trigger (defaults to current branch)")
+	cmd.Flags().StringVar(&opts.SHA, "sha", "", "Trigger the origin branch whose tip is this commit")
+	cmd.Flags().StringVar(&opts.Tag, "tag", "", "Tag to trigger")
+	cmd.Flags().IntVar(&opts.PR, "pr", 0, "Trigger this pull request's head, found in your fetched remotes")
+	cmd.Flags().StringArrayVar(&opts.Params, "parameter", nil, "Run parameter as key=value; true/false and integers are typed (repeatable)")
+	cmd.Flags().StringVar(&opts.ParamsFile, "parameters-file", "", "YAML or JSON file of run parameters; --parameter overrides its keys")
+	cmd.Flags().StringVar(&opts.ConfigFile, "config-file", "", "Compile this local config and run it instead of the committed one")
+	cmd.Flags().BoolVar(&localConfig, "local-config", false, "Run the working tree's "+defaultLocalConfig+" (see --config-file)")
+	cmd.Flags().BoolVar(&opts.AllowUnpushed, "allow-unpushed", false, "Run a local config even though HEAD is not pushed to the branch")
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Watch the run until it completes; exits as run watch does")
+	cmdutil.AddJSONFlag(cmd, &opts.JSON)
 	cmdutil.AddJQFlag(cmd)
 
 	return cmd
 }
 
+// validateTriggerFlags rejects flag combinations before any git or API work,
+// and expands --local-config into the config file it stands for.
+func validateTriggerFlags(opts *triggerOptions, localConfig bool) error {
+	var refs []string
+	for flag, set := range map[string]bool{
+		"--branch": opts.Branch != "",
+		"--sha":    opts.SHA != "",
+		"--tag":    opts.Tag != "",
+		"--pr":     opts.PR != 0,
+	} {
+		if set {
+			refs = append(refs, flag)
+		}
+	}
+	slices.Sort(refs)
+	if len(refs) > 1 {
+		return clierrors.New("run.conflicting_flags", "Conflicting flags",
+			fmt.Sprintf("%s cannot be used together; pick one ref to trigger.", strings.Join(refs, " and "))).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if opts.PR < 0 {
+		return clierrors.New("run.invalid_pr", "Invalid pull request",
+			fmt.Sprintf("--pr takes a pull request number, got %d.", opts.PR)).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+
+	if localConfig {
+		if opts.ConfigFile != "" {
+			return clierrors.New("run.conflicting_flags", "Conflicting flags",
+				"--local-config and --config-file cannot be used together.").
+				WithSuggestions("--local-config is short for --config-file " + defaultLocalConfig + " at the repository root").
+				WithExitCode(clierrors.ExitBadArguments)
+		}
+		root, err := gitremote.RepoRootIn("")
+		if err != nil {
+			return cmdutil.GitDetectErr(err, "Or name the file: circleci run trigger --config-file <path>")
+		}
+		opts.ConfigFile = filepath.Join(root, defaultLocalConfig)
+	}
+	if opts.ConfigFile != "" && len(refs) == 1 && refs[0] != "--branch" {
+		return clierrors.New("run.conflicting_flags", "Conflicting flags",
+			fmt.Sprintf("A local config runs on a branch's pushed HEAD, so it cannot be used with %s.", refs[0])).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if opts.AllowUnpushed && opts.ConfigFile == "" {
+		return clierrors.New("run.allow_unpushed_requires_config", "Flag requires a local config",
+			"--allow-unpushed only applies with --config-file or --local-config.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	return nil
+}
+
 type triggerJSONOutput struct {
-	ID        string `json:"id"`
-	Number    int64  `json:"number"`
-	State     string `json:"state"`
-	CreatedAt string `json:"created_at"`
+	ID          string `json:"id"`
+	Number      int64  `json:"number"`
+	State       string `json:"state"`
+	CreatedAt   string `json:"created_at"`
+	Branch      string `json:"branch,omitempty"`
+	Tag         string `json:"tag,omitempty"`
+	LocalConfig bool   `json:"local_config,omitempty"`
 }
 
-func runTrigger(ctx context.Context, client *apiclient.Client, projectSlug, branch string, params []string, jsonOut bool) error {
-	effectiveBranch := branch
-	if projectSlug == "" || effectiveBranch == "" {
+// triggeredRun is a triggered run as reported by either trigger endpoint.
+type triggeredRun struct {
+	ID        string
+	Number    int64
+	State     string
+	CreatedAt time.Time
+}
+
+func runTrigger(ctx context.Context, client *apiclient.Client, opts triggerOptions) error {
+	projectSlug, branch := opts.ProjectSlug, opts.Branch
+	needsBranch := branch == "" && opts.Tag == ""
+	if projectSlug == "" || needsBranch {
 		info, err := gitremote.Detect()
 		if err != nil {
 			return cmdutil.GitDetectErr(err, "Or specify the project and branch explicitly")
@@ -110,36 +229,281 @@ func runTrigger(ctx context.Context, client *apiclient.Client, projectSlug, bran
 		if projectSlug == "" {
 			projectSlug = info.Slug
 		}
-		if effectiveBranch == "" {
-			effectiveBranch = info.Branch
+		if needsBranch {
+			branch = info.Branch
+		}
+	}
+
+	params, err := triggerParams(opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.ConfigFile != "" {
+		return runTriggerLocalConfig(ctx, client, projectSlug, branch, params, opts)
+	}
+
+	// --sha and --pr name a commit; the run checks out the branch at it. branch
+	// still holds the checked-out branch, which wins when several match.
+	switch {
+	case opts.SHA != "":
+		c, err := gitremote.ResolveRevisionIn("", opts.SHA)
+		if err != nil {
+			return clierrors.New("run.unknown_commit", "Unknown commit",
+				fmt.Sprintf("Commit %q is not in the local repository: %s", opts.SHA, err)).
+				WithSuggestions("Fetch it first: git fetch origin").
+				WithExitCode(clierrors.ExitNotFound)
+		}
+		if branch, err = commitBranch(ctx, c, branch, ""); err != nil {
+			return err
+		}
+	case opts.PR != 0:
+		c, err := gitremote.PullRequestHeadIn("", opts.PR)
+		if errors.Is(err, gitremote.ErrPullRequestNotFetched) {
+			return clierrors.New("run.pull_request_not_fetched", "Pull request not fetched",
+				fmt.Sprintf("No local ref holds the head of pull request #%d.", opts.PR)).
+				WithSuggestions(fmt.Sprintf("Fetch it: git fetch origin pull/%[1]d/head:refs/remotes/origin/pr/%[1]d", opts.PR)).
+				WithExitCode(clierrors.ExitNotFound)
+		}
+		if err != nil {
+			return cmdutil.GitDetectErr(err, "Or trigger the pull request's branch: --branch <name>")
+		}
+		// A pull request from a fork has no origin branch, but GitHub exposes
+		// every pull request's head as pull/<n>/head, which CircleCI can build.
+		fallback := ""
+		if strings.HasPrefix(projectSlug, "gh/") || strings.HasPrefix(projectSlug, "github/") {
+			fallback = fmt.Sprintf("pull/%d/head", opts.PR)
+		}
+		if branch, err = commitBranch(ctx, c, branch, fallback); err != nil {
+			return err
+		}
+	}
+
+	var run triggeredRun
+	if opts.Tag != "" {
+		branch = ""
+		res, err := client.TriggerPipelineRun(ctx, projectSlug, apiclient.TriggerPipelineRunInput{
+			ConfigTag:   opts.Tag,
+			CheckoutTag: opts.Tag,
+			Parameters:  params,
+		})
+		if err != nil {
+			return apiErr(err, projectSlug)
+		}
+		if !res.Triggered {
+			return notTriggeredErr(res.Message)
+		}
+		run = triggeredRun{ID: res.ID, Number: int64(res.Number), State: res.State, CreatedAt: res.CreatedAt}
+	} else {
+		resp, err := client.TriggerPipeline(ctx, projectSlug, branch, params)
+		if err != nil {
+			return apiErr(err, projectSlug)
+		}
+		run = triggeredRun{ID: resp.ID, Number: resp.Number, State: resp.State, CreatedAt: resp.CreatedAt}
+	}
+
+	if opts.JSON {
+		err := iostream.PrintJSON(ctx, triggerJSONOutput{
+			ID:        run.ID,
+			Number:    run.Number,
+			State:     run.State,
+			CreatedAt: run.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			Branch:    branch,
+			Tag:       opts.Tag,
+		})
+		if err != nil {
+			return err
 		}
+	} else {
+		iostream.Printf(ctx, "Triggered run #%d (%s) on %s\n", run.Number, run.ID, refDisplay(branch, opts.Tag))
+	}
+	return watchTriggered(ctx, client, run, projectSlug, opts)
+}
+
+// commitBranch picks the origin branch whose tip is c for a run to check out,
+// preferring current. Origin is asked directly, since a run checks out the
+// branch as it is there now and fetched refs may be stale. With no such branch
+// it returns fallback, or an error when fallback is empty.
+func commitBranch(ctx context.Context, c gitremote.Commit, current, fallback string) (string, error) {
+	branches, err := gitremote.OriginBranchesAtIn(ctx, "", c.SHA)
+	if err != nil {
+		return "", clierrors.New("run.origin_unreachable", "Cannot reach origin",
+			fmt.Sprintf("Could not look up which origin branch is at %s: %s", shortSHA(c.SHA), err)).
+			WithSuggestions("Or trigger a branch: --branch <name>").
+			WithExitCode(clierrors.ExitGeneralError)
+	}
+	slices.Sort(branches)
+	switch {
+	case slices.Contains(branches, current):
+		return current, nil
+	case len(branches) > 0:
+		return branches[0], nil
+	case fallback != "":
+		return fallback, nil
 	}
+	short := shortSHA(c.SHA)
+	return "", clierrors.New("run.commit_not_on_branch", "Commit is not a branch tip",
+		fmt.Sprintf("No origin branch has %s at its tip, and a run can only check out a branch or tag.", short)).
+		WithSuggestions(
+			fmt.Sprintf("Push a branch at it: git push origin %s:refs/heads/ci/%s", c.SHA, short),
+			"Then trigger it: circleci run trigger --branch ci/"+short,
+		).
+		WithExitCode(clierrors.ExitNotFound)
+}
 
-	parsedParams, err := parseParams(params)
+// triggerParams merges --parameters-file with --parameter; a --parameter
+// replaces the file's value for the same key.
+func triggerParams(opts triggerOptions) (map[string]any, error) {
+	params, err := parseParamsFile(opts.ParamsFile)
+	if err != nil {
+		return nil, clierrors.New("args.invalid_parameters_file", "Invalid parameters file",
+			err.Error()).
+			WithSuggestions("The file must hold a YAML or JSON map of parameter names to values").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	flagParams, err := parseParams(opts.Params)
 	if err != nil {
-		return clierrors.New("args.invalid_parameter", "Invalid run parameter",
+		return nil, clierrors.New("args.invalid_parameter", "Invalid run parameter",
 			err.Error()).
 			WithSuggestions("Parameters must be in key=value form, e.g. --parameter deploy_env=staging").
 			WithExitCode(clierrors.ExitBadArguments)
 	}
+	if params == nil {
+		return flagParams, nil
+	}
+	maps.Copy(params, flagParams)
+	return params, nil
+}
 
-	resp, err := client.TriggerPipeline(ctx, projectSlug, effectiveBranch, parsedParams)
+// parseParamsFile reads a YAML or JSON map of run parameters. Values keep
+// their types, so nested maps, lists and numbers pass through unchanged.
+func parseParamsFile(path string) (map[string]any, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path) //#nosec:G304 // path is a user-supplied flag value
 	if err != nil {
-		return apiErr(err, projectSlug)
+		return nil, fmt.Errorf("could not read %s: %w", path, errors.Unwrap(err))
+	}
+	var params map[string]any
+	if err := yaml.Unmarshal(data, &params); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", path, err)
+	}
+	if params == nil {
+		return nil, fmt.Errorf("%s does not hold a map of parameters", path)
 	}
+	return params, nil
+}
+
+// watchTriggered chains into run watch when --watch is given, so the command
+// exits with the run's result just as run watch would.
+func watchTriggered(ctx context.Context, client *apiclient.Client, run triggeredRun, projectSlug string, opts triggerOptions) error {
+	if !opts.Watch {
+		return nil
+	}
+	return runWatch(ctx, client, []string{run.ID}, projectSlug, "", "", watchOptions{Timeout: defaultWatchTimeout})
+}
 
-	if jsonOut {
-		out := triggerJSONOutput{
-			ID:        resp.ID,
-			Number:    resp.Number,
-			State:     resp.State,
-			CreatedAt: resp.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+func notTriggeredErr(message string) error {
+	return clierrors.New("run.not_triggered", "Run not triggered",
+		fmt.Sprintf("The run was skipped: %s", message)).
+		WithExitCode(clierrors.ExitGeneralError)
+}
+
+// runTriggerLocalConfig compiles opts.ConfigFile and triggers a run on branch
+// with the result as its config. The run checks out the branch as pushed, so
+// unless --allow-unpushed is given HEAD must be the tip of branch as origin
+// reports it now; otherwise the config would be tested against code it was not
+// written for.
+func runTriggerLocalConfig(ctx context.Context, client *apiclient.Client, projectSlug, branch string, params map[string]any, opts triggerOptions) error {
+	head, err := gitremote.ResolveRevisionIn("", "HEAD")
+	if err != nil {
+		return cmdutil.GitDetectErr(err, "A local config runs against your pushed HEAD, so run this inside the repository")
+	}
+	if !opts.AllowUnpushed {
+		pushed, err := gitremote.OriginBranchesAtIn(ctx, "", head.SHA)
+		if err != nil {
+			return clierrors.New("run.origin_unreachable", "Cannot reach origin",
+				fmt.Sprintf("Could not check that HEAD is pushed: %s", err)).
+				WithSuggestions("Or skip the check: --allow-unpushed").
+				WithExitCode(clierrors.ExitGeneralError)
+		}
+		if !slices.Contains(pushed, branch) {
+			return clierrors.New("run.unpushed_head", "HEAD is not pushed",
+				fmt.Sprintf("HEAD (%s) is not the tip of origin/%s, so the run would check out different code than your config was written against.", shortSHA(head.SHA), branch)).
+				WithSuggestions(
+					"Push it: git push origin HEAD:"+branch,
+					"Or run against origin/"+branch+" anyway: --allow-unpushed",
+				).
+				WithExitCode(clierrors.ExitBadArguments)
 		}
-		return iostream.PrintJSON(ctx, out)
 	}
 
-	iostream.Printf(ctx, "Triggered run #%d (%s) on %s\n", resp.Number, resp.ID, effectiveBranch)
-	return nil
+	configYAML, err := os.ReadFile(opts.ConfigFile)
+	if err != nil {
+		return clierrors.New("run.config_file_unreadable", "Cannot read config file",
+			fmt.Sprintf("Could not read %s: %s", opts.ConfigFile, errors.Unwrap(err))).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+
+	proj, err := client.GetProjectBySlug(ctx, projectSlug)
+	if err != nil {
+		return apiErr(err, projectSlug)
+	}
+	compiled, err := configcmd.Process(ctx, client, string(configYAML), proj.OrgID.String(), false, params)
+	if err != nil {
+		return apiErr(err, opts.ConfigFile)
+	}
+	if !compiled.Valid {
+		for _, e := range compiled.Errors {
+			iostream.ErrPrintf(ctx, "  • %s\n", e)
+		}
+		return clierrors.New("config.invalid", "Config is invalid",
+			fmt.Sprintf("Config file %q contains compilation errors.", opts.ConfigFile)).
+			WithSuggestions("Check it locally: circleci config validate " + opts.ConfigFile).
+			WithExitCode(clierrors.ExitValidationFail)
+	}
+
+	res, err := client.TriggerPipelineRun(ctx, projectSlug, apiclient.TriggerPipelineRunInput{
+		ConfigBranch:   branch,
+		CheckoutBranch: branch,
+		ConfigContent:  compiled.CompiledYAML,
+		Parameters:     params,
+	})
+	if err != nil {
+		return apiErr(err, projectSlug)
+	}
+	if !res.Triggered {
+		return notTriggeredErr(res.Message)
+	}
+
+	err = recordLocalConfigRun(res.ID, localConfigRun{
+		Project:     projectSlug,
+		ConfigFile:  opts.ConfigFile,
+		Revision:    head.SHA,
+		TriggeredAt: time.Now().UTC(),
+	})
+	if err != nil {
+		iostream.ErrPrintf(ctx, "warning: run list will not mark this run as local config: %s\n", err)
+	}
+
+	run := triggeredRun{ID: res.ID, Number: int64(res.Number), State: res.State, CreatedAt: res.CreatedAt}
+	if opts.JSON {
+		err := iostream.PrintJSON(ctx, triggerJSONOutput{
+			ID:          run.ID,
+			Number:      run.Number,
+			State:       run.State,
+			CreatedAt:   run.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			Branch:      branch,
+			LocalConfig: true,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		iostream.Printf(ctx, "Triggered run #%d (%s) on %s with local config %s\n", run.Number, run.ID, branch, opts.ConfigFile)
+	}
+	return watchTriggered(ctx, client, run, projectSlug, opts)
 }
 
 // parseParams converts ["key=value", ...] into a map, coercing values to bool
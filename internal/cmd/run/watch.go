@@ -48,6 +48,10 @@ import (
 // given SHA to appear. CIRCLE_SHA_WAIT_MS overrides this for testing.
 const defaultSHAWaitDuration = 2 * time.Minute
 
+// defaultWatchTimeout is how long run watch, and run trigger --watch, wait
+// for a run to finish.
+const defaultWatchTimeout = 30 * time.Minute
+
 func shaWaitDuration() time.Duration {
 	if ms := os.Getenv("CIRCLE_SHA_WAIT_MS"); ms != "" {
 		if n, err := strconv.Atoi(ms); err == nil {
@@ -62,8 +66,7 @@ func newWatchCmd() *cobra.Command {
 		projectSlug string
 		branch      string
 		sha         string
-		timeout     time.Duration
-		failFast    bool
+		opts        watchOptions
 	)
 
 	cmd := &cobra.Command{
@@ -78,13 +81,13 @@ func newWatchCmd() *cobra.Command {
 			`, "`"),
 		},
 		Long: heredoc.Doc(`
-			Monitor a CircleCI run and block until it reaches a terminal state. Without
-			arguments, watches the latest run for the current branch. A terminal gets a
-			live table of workflows and jobs; piped or in CI, each change prints a line.
+			Block until a run finishes: a live table in a terminal, otherwise (or with
+			--events, --exec or --retry-failed) a line per change. --sha polls up to 2
+			minutes for its run to appear, for use straight after git push. Exit code:
+			0 succeeded, 1 failed, 6 cancelled, 8 timed out (--retry-failed: last attempt).
 
-			Exit code reflects the result: 0 all workflows succeeded, 1 one or more failed,
-			6 cancelled, 8 timed out. With --sha, polls for up to 2 minutes for a run
-			matching that commit to appear — useful immediately after git push.
+			--events jsonl fields: time, type (run, workflow or job), id, name, run_id, workflow_id, status, previous_status (absent on the first poll), url
+			--exec env: CIRCLE_WATCH_EVENT (first_failure or completed), CIRCLE_WATCH_RUN_ID, CIRCLE_WATCH_RUN_STATUS, CIRCLE_WATCH_RUN_URL, CIRCLE_WATCH_BRANCH, CIRCLE_WATCH_TAG, CIRCLE_WATCH_REVISION, CIRCLE_WATCH_FAILED_JOBS (comma-separated names)
 		`),
 		Example: heredoc.Doc(`
 			# Watch the latest run on the current branch
@@ -101,28 +104,40 @@ func newWatchCmd() *cobra.Command {
 
 			# Exit as soon as any job fails
 			$ circleci run watch --failfast
+
+			# Stream state transitions as JSON and notify when done
+			$ circleci run watch --events jsonl --exec ./notify.sh
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
+			if err := validateWatchEvents(opts.Events); err != nil {
+				return err
+			}
+			if err := validateWatchRetry(opts); err != nil {
+				return err
+			}
 			client, err := cmdutil.LoadClient(ctx)
 			if err != nil {
 				return err
 			}
-			return runWatch(ctx, client, args, projectSlug, branch, sha, timeout, failFast)
+			return runWatch(ctx, client, args, projectSlug, branch, sha, opts)
 		},
 	}
 
 	cmd.Flags().StringVar(&projectSlug, "project", "", "Project slug (e.g. gh/org/repo); defaults to git remote")
 	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch to watch (defaults to current branch)")
 	cmd.Flags().StringVar(&sha, "sha", "", "Watch run for this commit SHA; polls up to 2m if not yet created")
-	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Maximum time to wait for run completion")
-	cmd.Flags().BoolVar(&failFast, "failfast", false, "Exit as soon as any job fails, without waiting for the rest of the run")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", defaultWatchTimeout, "Maximum time to wait for run completion")
+	cmd.Flags().BoolVar(&opts.FailFast, "failfast", false, "Exit as soon as any job fails, without waiting for the rest of the run")
+	cmd.Flags().StringVar(&opts.Events, "events", "", "Stream state transitions to stdout (jsonl)")
+	cmd.Flags().IntVar(&opts.RetryFailed, "retry-failed", 0, "Rerun failed workflows from failed up to N times, reporting jobs that only passed on retry as flaky")
+	cmd.Flags().StringVar(&opts.Exec, "exec", "", "Shell command to run on first failure and on completion (gets CIRCLE_WATCH_* env vars)")
 
 	return cmd
 }
 
-func runWatch(ctx context.Context, client *apiclient.Client, args []string, projectSlug, branch, sha string, timeout time.Duration, failFast bool) error {
+func runWatch(ctx context.Context, client *apiclient.Client, args []string, projectSlug, branch, sha string, opts watchOptions) error {
 	var (
 		id  uuid.UUID
 		err error
@@ -203,14 +218,16 @@ func runWatch(ctx context.Context, client *apiclient.Client, args []string, proj
 
 	// An interactive terminal gets the live table, which names the run in its own
 	// header. Everywhere else — piped, redirected, or CI — the run is announced on
-	// one line and progress is reported a line at a time.
-	if iostream.IsInteractive(ctx) {
-		return watchInteractive(ctx, client, r.ID, displayBranch, timeout, failFast)
+	// one line and progress is reported a line at a time. An event stream or
+	// hook needs every poll, and retries need the run between attempts, so any
+	// of them takes the line-based path too.
+	if iostream.IsInteractive(ctx) && opts.Events == "" && opts.Exec == "" && opts.RetryFailed == 0 {
+		return watchInteractive(ctx, client, r.ID, displayBranch, opts.Timeout, opts.FailFast)
 	}
 
 	iostream.ErrPrintf(ctx, "Watching run %s (%s)\n\n", r.ID, displayBranch)
 
-	return watchUntilDone(ctx, client, r.ID, timeout, failFast)
+	return watchUntilDone(ctx, client, r.ID, opts)
 }
 
 // waitForRunBySHA searches for a run matching the given commit SHA via V3 search,
@@ -226,28 +243,16 @@ func waitForRunBySHA(ctx context.Context, client *apiclient.Client, projectSlug,
 	interval := 5 * time.Second
 	printed := false
 
-	filter := fmt.Sprintf("pipeline.git.revision == %q", sha)
-	if branch != "" {
-		filter += fmt.Sprintf(" and pipeline.git.branch == %q", branch)
-	}
-
 	for {
-		now := time.Now().UTC()
-		runs, searchErr := client.SearchRunsV3(ctx, apiclient.RunSearchParams{
-			ProjectIDs: []string{proj.ID.String()},
-			From:       now.AddDate(0, 0, -1),
-			To:         now,
-			Filter:     filter,
-			Limit:      1,
-		})
+		r, searchErr := findRunBySHA(ctx, client, proj.ID.String(), branch, sha)
 		if searchErr != nil {
 			if errors.Is(searchErr, context.Canceled) {
 				return nil, watchInterrupted()
 			}
 			return nil, apiErr(searchErr, projectSlug)
 		}
-		if len(runs) > 0 {
-			return &runs[0], nil
+		if r != nil {
+			return r, nil
 		}
 
 		if time.Now().After(deadline) {
@@ -270,6 +275,36 @@ func waitForRunBySHA(ctx context.Context, client *apiclient.Client, projectSlug,
 	}
 }
 
+// findRunBySHA returns the newest run from the last day for commit sha, or nil
+// if there is none yet. The search API only matches whole revisions, so an
+// abbreviated SHA is matched against each run's revision here instead.
+func findRunBySHA(ctx context.Context, client *apiclient.Client, projectID, branch, sha string) (*apiclient.RunV3, error) {
+	now := time.Now().UTC()
+	params := apiclient.RunSearchParams{
+		ProjectIDs: []string{projectID},
+		From:       now.AddDate(0, 0, -1),
+		To:         now,
+	}
+	filter := apiclient.RunFilter{Branch: branch}
+	if len(sha) == fullSHALength {
+		filter.Revision = sha
+		params.Limit = 1
+	}
+	params.Filter = filter.Expr()
+
+	var found *apiclient.RunV3
+	err := client.SearchRunsV3Pages(ctx, params, func(runs []apiclient.RunV3) (bool, error) {
+		for i := range runs {
+			if strings.HasPrefix(runs[i].Revision, sha) {
+				found = &runs[i]
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	return found, err
+}
+
 // watchInteractive runs the watch as a bubbletea program (see
 // ui.RunWatchFlowModel): a live table of workflows and jobs on stderr, redrawn
 // in place, that ends itself when the run does. The program only collects the
@@ -333,16 +368,27 @@ func watchInteractive(ctx context.Context, client *apiclient.Client, runID uuid.
 // watchUntilDone polls the given run until all workflows reach a terminal state
 // or the timeout elapses, printing one line per observed change. This is the
 // non-interactive path: no cursor movement, so the output survives being piped
-// to a file or a CI log.
-func watchUntilDone(ctx context.Context, client *apiclient.Client, runID uuid.UUID, timeout time.Duration, failFast bool) error {
-	deadline := time.Now().Add(timeout)
+// to a file or a CI log. It is also where --events and --exec are served.
+func watchUntilDone(ctx context.Context, client *apiclient.Client, runID uuid.UUID, opts watchOptions) error {
+	deadline := time.Now().Add(opts.Timeout)
 	start := time.Now()
 
+	// Without an app URL the events and hooks still work; they just carry no
+	// links.
+	appURL, _ := cmdutil.AppURL(ctx)
+	var tracker *watchEventTracker
+	if opts.Events != "" {
+		tracker = newWatchEventTracker(appURL)
+	}
+	hooks := &watchHooks{cmdline: opts.Exec, appURL: appURL}
+
+	retries := newWatchRetries(opts.RetryFailed)
+
 	var prevFingerprint string
 	pollInterval := ui.RunWatchPollInterval
 
 	for {
-		raw, err := fetchWatchState(ctx, client, runID)
+		raw, err := fetchWatchStateFollowing(ctx, client, runID, retries.follow)
 		if err != nil {
 			if errors.Is(err, context.Canceled) {
 				return watchInterrupted()
@@ -358,14 +404,34 @@ func watchUntilDone(ctx context.Context, client *apiclient.Client, runID uuid.UU
 			printWatchLine(ctx, state, elapsed)
 			prevFingerprint = fingerprint
 		}
+		if tracker != nil {
+			if err := writeWatchEvents(ctx, tracker.diff(raw, time.Now())); err != nil {
+				return err
+			}
+		}
+		retry := state.Done && state.Outcome == "failed" && retries.remaining()
+		if retry {
+			// The run is only over once its retries are spent, so the
+			// completion hook waits for the last attempt.
+			state.Done = false
+		}
+		hooks.observe(ctx, raw, state)
 
 		switch {
+		case retry:
+			if err := retries.rerun(ctx, client, raw); err != nil {
+				return err
+			}
+			prevFingerprint = ""
+			pollInterval = ui.RunWatchPollInterval
+			continue
 		case state.Done:
+			printFlakyJobs(ctx, retries.flaky(raw))
 			return watchFinalResult(ctx, state, runID, elapsed)
-		case failFast && len(state.FailedJobs()) > 0:
+		case opts.FailFast && len(state.FailedJobs()) > 0:
 			return watchFailFastResult(ctx, state, runID, elapsed)
 		case time.Now().After(deadline):
-			return watchTimedOut(runID, timeout)
+			return watchTimedOut(runID, opts.Timeout)
 		}
 
 		if err := sleepOrCancel(ctx, pollInterval); err != nil {
@@ -437,6 +503,30 @@ func watchTimedOut(runID uuid.UUID, timeout time.Duration) *clierrors.CLIError {
 // fetchWatchState retrieves the current run state including all workflows
 // and their jobs, reusing buildOutput from get.go.
 func fetchWatchState(ctx context.Context, client *apiclient.Client, runID uuid.UUID) (runGetOutput, error) {
+	return fetchWatchStateFollowing(ctx, client, runID, nil)
+}
+
+// fetchWatchStateFollowing is fetchWatchState with each rerun workflow in
+// follow standing in for the workflow it replaced (see followReruns).
+func fetchWatchStateFollowing(ctx context.Context, client *apiclient.Client, runID uuid.UUID, follow map[uuid.UUID]uuid.UUID) (runGetOutput, error) {
+	return fetchRunState(ctx, client, runID, func(workflows []apiclient.WorkflowV3) ([]apiclient.WorkflowV3, error) {
+		if len(follow) == 0 {
+			return workflows, nil
+		}
+		return followReruns(ctx, client, workflows, follow)
+	})
+}
+
+// fetchLatestAttemptState is fetchWatchState with only the latest attempt of
+// each workflow (see latestAttempts).
+func fetchLatestAttemptState(ctx context.Context, client *apiclient.Client, runID uuid.UUID) (runGetOutput, error) {
+	return fetchRunState(ctx, client, runID, func(workflows []apiclient.WorkflowV3) ([]apiclient.WorkflowV3, error) {
+		return latestAttempts(workflows), nil
+	})
+}
+
+// fetchRunState fetches a run, the workflows pick keeps of it, and their jobs.
+func fetchRunState(ctx context.Context, client *apiclient.Client, runID uuid.UUID, pick func([]apiclient.WorkflowV3) ([]apiclient.WorkflowV3, error)) (runGetOutput, error) {
 	r, err := client.GetRunV3(ctx, runID)
 	if err != nil {
 		return runGetOutput{}, err
@@ -445,6 +535,10 @@ func fetchWatchState(ctx context.Context, client *apiclient.Client, runID uuid.U
 	if err != nil {
 		return runGetOutput{}, err
 	}
+	workflows, err = pick(workflows)
+	if err != nil {
+		return runGetOutput{}, err
+	}
 	wfJobs := make([][]apiclient.WorkflowJobV3, len(workflows))
 	for i, wf := range workflows {
 		jobs, err := client.GetWorkflowJobsV3(ctx, wf.ID)
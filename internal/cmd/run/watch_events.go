@@ -0,0 +1,243 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/ui"
+)
+
+// watchEventsJSONL is the only --events format. The flag takes a value rather
+// than being a bool so another encoding can be added without a new flag.
+const watchEventsJSONL = "jsonl"
+
+// Values of CIRCLE_WATCH_EVENT, telling an --exec hook why it was run.
+const (
+	watchHookFirstFailure = "first_failure"
+	watchHookCompleted    = "completed"
+)
+
+// watchOptions carries the knobs that shape a watch once the run has been
+// resolved.
+type watchOptions struct {
+	Timeout  time.Duration
+	FailFast bool
+	Events   string
+	Exec     string
+
+	// RetryFailed is how many times a failed workflow is rerun from failed
+	// before the watch gives up on it.
+	RetryFailed int
+}
+
+func validateWatchEvents(events string) error {
+	if events == "" || events == watchEventsJSONL {
+		return nil
+	}
+	return clierrors.New("run.invalid_events_format", "Invalid events format",
+		fmt.Sprintf("Unsupported --events format %q.", events)).
+		WithSuggestions("Use --events jsonl").
+		WithExitCode(clierrors.ExitBadArguments)
+}
+
+// watchEvent is one line of the --events jsonl stream: a single run, workflow
+// or job whose status differs from the previous poll. The first poll reports
+// every entity with no previous_status, so a consumer starts from a complete
+// picture.
+type watchEvent struct {
+	Time           string `json:"time"`
+	Type           string `json:"type"`
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	RunID          string `json:"run_id"`
+	WorkflowID     string `json:"workflow_id,omitempty"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+	URL            string `json:"url,omitempty"`
+}
+
+// watchEventTracker diffs successive polls of a run into watchEvents. Entities
+// are keyed by type and ID, so a job that is renamed or reordered between
+// polls is still recognised as the same job.
+type watchEventTracker struct {
+	appURL string
+	seen   map[string]string
+}
+
+func newWatchEventTracker(appURL string) *watchEventTracker {
+	return &watchEventTracker{appURL: appURL, seen: map[string]string{}}
+}
+
+// diff returns the events for everything whose status changed since the last
+// call, in run → workflow → job order.
+func (t *watchEventTracker) diff(state runGetOutput, now time.Time) []watchEvent {
+	ts := now.UTC().Format(time.RFC3339)
+	runID := state.ID.String()
+
+	var events []watchEvent
+	add := func(ev watchEvent) {
+		key := ev.Type + ":" + ev.ID
+		prev, ok := t.seen[key]
+		if ok && prev == ev.Status {
+			return
+		}
+		t.seen[key] = ev.Status
+		ev.Time = ts
+		ev.RunID = runID
+		ev.PreviousStatus = prev
+		events = append(events, ev)
+	}
+
+	add(watchEvent{
+		Type:   "run",
+		ID:     runID,
+		Status: watchRunStatus(state),
+		URL:    t.url(cmdutil.RunURL, state.ID),
+	})
+	for _, wf := range state.Workflows {
+		add(watchEvent{
+			Type:       "workflow",
+			ID:         wf.ID.String(),
+			Name:       wf.Name,
+			WorkflowID: wf.ID.String(),
+			Status:     apiclient.PhaseOutcomeText(wf.Phase, wf.Outcome, wf.CurrentOutcome),
+			URL:        t.url(cmdutil.WorkflowURL, wf.ID),
+		})
+		for _, j := range wf.Jobs {
+			ev := watchEvent{
+				Type:       "job",
+				ID:         j.ID.String(),
+				Name:       j.Name,
+				WorkflowID: wf.ID.String(),
+				Status:     apiclient.PhaseOutcomeText(j.Phase, j.Outcome, j.CurrentOutcome),
+			}
+			if t.appURL != "" {
+				ev.URL = cmdutil.JobURL(t.appURL, wf.ID, j.ID)
+			}
+			add(ev)
+		}
+	}
+	return events
+}
+
+func (t *watchEventTracker) url(fn func(string, uuid.UUID) string, id uuid.UUID) string {
+	if t.appURL == "" {
+		return ""
+	}
+	return fn(t.appURL, id)
+}
+
+// watchRunStatus is the run-level status word. Once workflows exist it is the
+// same roll-up the summary line uses; before then the run's own phase is all
+// there is, rendered as plain text rather than PhaseOutcomeStatus's emoji.
+func watchRunStatus(state runGetOutput) string {
+	if len(state.Workflows) == 0 {
+		return apiclient.PhaseOutcomeText(state.Phase, state.Outcome, state.CurrentOutcome)
+	}
+	return deriveDisplayStatus(state)
+}
+
+// writeWatchEvents writes events to stdout one compact object per line.
+// WriteJSON indents, which would break line-oriented consumers.
+func writeWatchEvents(ctx context.Context, events []watchEvent) error {
+	enc := json.NewEncoder(iostream.Out(ctx))
+	enc.SetEscapeHTML(false)
+	for _, ev := range events {
+		if err := enc.Encode(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// watchHooks runs the --exec command at most once per trigger: when the first
+// job fails, and when the run completes. A run that fails on its last poll gets
+// both, first_failure then completed.
+type watchHooks struct {
+	cmdline string
+	appURL  string
+	failed  bool
+}
+
+func (h *watchHooks) observe(ctx context.Context, raw runGetOutput, state ui.RunWatchState) {
+	if h == nil || h.cmdline == "" {
+		return
+	}
+	if !h.failed && len(state.FailedJobs()) > 0 {
+		h.failed = true
+		h.run(ctx, watchHookFirstFailure, raw, state)
+	}
+	if state.Done {
+		h.run(ctx, watchHookCompleted, raw, state)
+	}
+}
+
+// run executes the hook through the shell, with the run's details in
+// CIRCLE_WATCH_* variables on top of the inherited environment. Its output
+// goes to stderr so it never interleaves with an --events stream on stdout.
+// A failing hook is reported but does not change the watch's exit code: that
+// belongs to the run.
+func (h *watchHooks) run(ctx context.Context, event string, raw runGetOutput, state ui.RunWatchState) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/c", h.cmdline) //nolint:gosec // the user supplied the command
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", h.cmdline) //nolint:gosec // the user supplied the command
+	}
+	cmd.Env = append(os.Environ(), watchHookEnv(event, h.appURL, raw, state)...)
+	cmd.Stdout = iostream.Err(ctx)
+	cmd.Stderr = iostream.Err(ctx)
+	if err := cmd.Run(); err != nil {
+		iostream.ErrPrintf(ctx, "warning: --exec hook for %s failed: %v\n", event, err)
+	}
+}
+
+func watchHookEnv(event, appURL string, raw runGetOutput, state ui.RunWatchState) []string {
+	env := []string{
+		"CIRCLE_WATCH_EVENT=" + event,
+		"CIRCLE_WATCH_RUN_ID=" + raw.ID.String(),
+		"CIRCLE_WATCH_RUN_STATUS=" + watchRunStatus(raw),
+		"CIRCLE_WATCH_BRANCH=" + raw.Branch,
+		"CIRCLE_WATCH_TAG=" + raw.Tag,
+		"CIRCLE_WATCH_REVISION=" + raw.Revision,
+		"CIRCLE_WATCH_FAILED_JOBS=" + strings.Join(failedJobNames(state), ","),
+	}
+	if appURL != "" {
+		env = append(env, "CIRCLE_WATCH_RUN_URL="+cmdutil.RunURL(appURL, raw.ID))
+	}
+	return env
+}
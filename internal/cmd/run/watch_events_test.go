@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+)
+
+// TestWatchEventTracker verifies the first poll reports every entity and later
+// polls report only what moved, with the status it moved from.
+func TestWatchEventTracker(t *testing.T) {
+	runID := uuid.MustParse("f0000000-0000-4000-8000-000000000001")
+	wfID := uuid.MustParse("b0000000-0000-4000-8000-0000000f0001")
+	lintID := uuid.MustParse("d0000000-0000-4000-8000-00000000f001")
+	testID := uuid.MustParse("d0000000-0000-4000-8000-00000000f002")
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	poll := func(lint, test string) runGetOutput {
+		return runGetOutput{
+			ID:    runID,
+			Phase: "running",
+			Workflows: []workflowOutput{{
+				ID: wfID, Name: "build", Phase: "running",
+				Jobs: []jobOutput{
+					{ID: lintID, Name: "lint", Phase: "ended", Outcome: lint},
+					{ID: testID, Name: "test", Phase: test},
+				},
+			}},
+		}
+	}
+
+	tr := newWatchEventTracker("https://app.circleci.com")
+
+	first := tr.diff(poll("succeeded", "running"), now)
+	assert.Assert(t, is.Len(first, 4))
+	assert.Check(t, is.DeepEqual(first[0], watchEvent{
+		Time:   "2026-03-01T12:00:00Z",
+		Type:   "run",
+		ID:     runID.String(),
+		RunID:  runID.String(),
+		Status: "running",
+		URL:    "https://app.circleci.com/pipeline/" + runID.String(),
+	}))
+	assert.Check(t, is.Equal(first[1].Type, "workflow"))
+	assert.Check(t, is.Equal(first[3].Name, "test"))
+	assert.Check(t, is.Equal(first[3].WorkflowID, wfID.String()))
+	assert.Check(t, is.Equal(first[3].PreviousStatus, ""))
+
+	assert.Check(t, is.Len(tr.diff(poll("succeeded", "running"), now), 0))
+
+	moved := poll("succeeded", "ended")
+	moved.Workflows[0].Jobs[1].Outcome = "failed"
+	moved.Workflows[0].CurrentOutcome = "failed"
+	next := tr.diff(moved, now.Add(time.Minute))
+	assert.Assert(t, is.Len(next, 2))
+	assert.Check(t, is.Equal(next[0].Type, "run"))
+	assert.Check(t, is.Equal(next[0].Status, "failed"))
+	assert.Check(t, is.Equal(next[0].PreviousStatus, "running"))
+	assert.Check(t, is.Equal(next[1].ID, testID.String()))
+	assert.Check(t, is.Equal(next[1].Status, "failed"))
+	assert.Check(t, is.Equal(next[1].PreviousStatus, "running"))
+	assert.Check(t, is.Equal(next[1].Time, "2026-03-01T12:01:00Z"))
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+)
+
+// watchRetries is the state behind run watch --retry-failed: how many attempts
+// have been made, which rerun workflow now stands in for which original, and
+// which jobs failed on the way. It is also what spots a flaky job — one that
+// failed on an earlier attempt and passed on a later one.
+type watchRetries struct {
+	limit   int
+	attempt int
+
+	// follow maps a workflow that was rerun to the workflow its rerun created.
+	follow map[uuid.UUID]uuid.UUID
+
+	// failedOn records, per "workflow/job", the attempts on which it failed.
+	failedOn map[string][]int
+
+	// passedOn records, per "workflow/job" in failedOn, the attempt on which it
+	// first succeeded. A job that passes is not rerun, so it keeps passing
+	// while other jobs force further attempts.
+	passedOn map[string]int
+}
+
+// flakyJob is a job that only passed on retry.
+type flakyJob struct {
+	Workflow string
+	Job      string
+	FailedOn []int
+	PassedOn int
+}
+
+func newWatchRetries(limit int) *watchRetries {
+	return &watchRetries{
+		limit:    limit,
+		attempt:  1,
+		follow:   map[uuid.UUID]uuid.UUID{},
+		failedOn: map[string][]int{},
+		passedOn: map[string]int{},
+	}
+}
+
+func validateWatchRetry(opts watchOptions) error {
+	if opts.RetryFailed < 0 {
+		return clierrors.New("args.invalid_retry_failed", "Invalid --retry-failed",
+			fmt.Sprintf("--retry-failed must be zero or more, got %d.", opts.RetryFailed)).
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	if opts.RetryFailed > 0 && opts.FailFast {
+		return clierrors.New("args.conflicting_flags", "Conflicting flags",
+			"--retry-failed cannot be used with --failfast; a retry waits for the workflow to finish.").
+			WithExitCode(clierrors.ExitBadArguments)
+	}
+	return nil
+}
+
+func (r *watchRetries) remaining() bool {
+	return r.attempt <= r.limit
+}
+
+// rerun reruns every failed workflow in the run from failed, records the jobs
+// that failed on this attempt, and starts following the new workflows.
+func (r *watchRetries) rerun(ctx context.Context, client *apiclient.Client, raw runGetOutput) error {
+	r.recordPasses(raw)
+	var failed []workflowOutput
+	for _, wf := range raw.Workflows {
+		if workflowFailed(wf) {
+			failed = append(failed, wf)
+		}
+	}
+
+	r.attempt++
+	iostream.ErrPrintf(ctx, "\nRetrying %d failed workflow(s) from failed (attempt %d of %d)\n\n",
+		len(failed), r.attempt, r.limit+1)
+
+	for _, wf := range failed {
+		for _, j := range wf.Jobs {
+			if j.Outcome == "failed" {
+				key := wf.Name + "/" + j.Name
+				r.failedOn[key] = append(r.failedOn[key], r.attempt-1)
+			}
+		}
+		newID, err := client.RerunWorkflow(ctx, wf.ID.String(), true)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return watchInterrupted()
+			}
+			return clierrors.New("api.error", "API error while retrying workflow",
+				fmt.Sprintf("Could not rerun workflow %q (%s): %v", wf.Name, wf.ID, err)).
+				WithExitCode(clierrors.ExitAPIError)
+		}
+		id, err := uuid.Parse(newID)
+		if err != nil {
+			return clierrors.New("api.error", "API error while retrying workflow",
+				fmt.Sprintf("Rerunning workflow %q returned an invalid workflow ID %q.", wf.Name, newID)).
+				WithExitCode(clierrors.ExitAPIError)
+		}
+		r.follow[wf.ID] = id
+	}
+	return nil
+}
+
+// recordPasses notes, for each job that failed on an earlier attempt and has
+// now succeeded, that it first passed on the current attempt.
+func (r *watchRetries) recordPasses(raw runGetOutput) {
+	for _, wf := range raw.Workflows {
+		for _, j := range wf.Jobs {
+			key := wf.Name + "/" + j.Name
+			if _, failed := r.failedOn[key]; !failed || j.Outcome != "succeeded" {
+				continue
+			}
+			if _, passed := r.passedOn[key]; !passed {
+				r.passedOn[key] = r.attempt
+			}
+		}
+	}
+}
+
+// flaky returns the jobs in the final state that passed after failing on an
+// earlier attempt, in the order the run lists them.
+func (r *watchRetries) flaky(raw runGetOutput) []flakyJob {
+	if len(r.failedOn) == 0 {
+		return nil
+	}
+	r.recordPasses(raw)
+	var out []flakyJob
+	for _, wf := range raw.Workflows {
+		for _, j := range wf.Jobs {
+			key := wf.Name + "/" + j.Name
+			passedOn, ok := r.passedOn[key]
+			if !ok || j.Outcome != "succeeded" {
+				continue
+			}
+			out = append(out, flakyJob{Workflow: wf.Name, Job: j.Name, FailedOn: r.failedOn[key], PassedOn: passedOn})
+		}
+	}
+	return out
+}
+
+// followReruns swaps each workflow that was rerun for the latest workflow in
+// its rerun chain, so the watch keeps one row per workflow and judges the run
+// on the last attempt. A rerun workflow the run lists in its own right is
+// dropped, since it already sits in its predecessor's slot.
+func followReruns(ctx context.Context, client *apiclient.Client, workflows []apiclient.WorkflowV3, follow map[uuid.UUID]uuid.UUID) ([]apiclient.WorkflowV3, error) {
+	reruns := make(map[uuid.UUID]bool, len(follow))
+	for _, id := range follow {
+		reruns[id] = true
+	}
+
+	out := make([]apiclient.WorkflowV3, 0, len(workflows))
+	for _, wf := range workflows {
+		if reruns[wf.ID] {
+			continue
+		}
+		id := wf.ID
+		for next, ok := follow[id]; ok; next, ok = follow[id] {
+			id = next
+		}
+		if id == wf.ID {
+			out = append(out, wf)
+			continue
+		}
+		latest, err := client.GetWorkflowV3(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *latest)
+	}
+	return out, nil
+}
+
+// latestAttempts keeps one workflow per name, the one created last, in the
+// place where the name first appears. Rerunning a workflow adds another of the
+// same name to the run, and only the newest attempt says how the run stands.
+func latestAttempts(workflows []apiclient.WorkflowV3) []apiclient.WorkflowV3 {
+	return latestByName(workflows,
+		func(wf apiclient.WorkflowV3) string { return wf.Name },
+		func(a, b apiclient.WorkflowV3) bool { return a.CreatedAt.After(b.CreatedAt) })
+}
+
+// latestByName collapses the items sharing a name to the newest of them, where
+// newer reports whether a is newer than b; of two equally new, the later in
+// items wins. Each survivor takes the position of its name's first appearance.
+func latestByName[T any](items []T, name func(T) string, newer func(a, b T) bool) []T {
+	at := map[string]int{}
+	out := make([]T, 0, len(items))
+	for _, it := range items {
+		n := name(it)
+		i, seen := at[n]
+		switch {
+		case !seen:
+			at[n] = len(out)
+			out = append(out, it)
+		case !newer(out[i], it):
+			out[i] = it
+		}
+	}
+	return out
+}
+
+func workflowFailed(wf workflowOutput) bool {
+	return wf.Outcome == "failed" || wf.Outcome == "errored" || wf.CurrentOutcome == "failed"
+}
+
+// printFlakyJobs reports the jobs that only passed on retry, each marked
+// "flaky" so the line is easy to grep for in a CI log.
+func printFlakyJobs(ctx context.Context, jobs []flakyJob) {
+	if len(jobs) == 0 {
+		return
+	}
+	iostream.ErrPrintf(ctx, "\nJobs that only passed on retry:\n")
+	for _, j := range jobs {
+		iostream.ErrPrintf(ctx, "  %s flaky  %s/%s  failed on attempt %s, passed on attempt %d\n",
+			iostream.SymbolWarn(ctx), j.Workflow, j.Job, joinAttempts(j.FailedOn), j.PassedOn)
+	}
+	iostream.ErrPrintf(ctx, "\n")
+}
+
+func joinAttempts(attempts []int) string {
+	parts := make([]string, len(attempts))
+	for i, a := range attempts {
+		parts[i] = strconv.Itoa(a)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -24,11 +24,13 @@ package run
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"gotest.tools/v3/assert"
 	is "gotest.tools/v3/assert/cmp"
 
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
 	"github.com/CircleCI-Public/circleci-cli/internal/ui"
 )
 
@@ -138,3 +140,26 @@ func TestWatchState(t *testing.T) {
 		assert.Check(t, !state.Done)
 	})
 }
+
+// TestLatestAttempts verifies a rerun workflow replaces its earlier attempt in
+// the earlier attempt's place, whichever order the run lists them in.
+func TestLatestAttempts(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2026, 1, 1, hour, 0, 0, 0, time.UTC) }
+	wf := func(name, outcome string, hour int) apiclient.WorkflowV3 {
+		return apiclient.WorkflowV3{Name: name, Outcome: outcome, CreatedAt: at(hour)}
+	}
+
+	got := latestAttempts([]apiclient.WorkflowV3{
+		wf("build", "failed", 1),
+		wf("lint", "succeeded", 1),
+		wf("deploy", "succeeded", 3),
+		wf("build", "succeeded", 2),
+		wf("deploy", "failed", 2),
+	})
+
+	assert.Check(t, is.DeepEqual(got, []apiclient.WorkflowV3{
+		wf("build", "succeeded", 2),
+		wf("lint", "succeeded", 1),
+		wf("deploy", "succeeded", 3),
+	}))
+}
@@ -0,0 +1,717 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	clierrors "github.com/CircleCI-Public/circleci-cli/clikit/errors"
+	"github.com/CircleCI-Public/circleci-cli/clikit/iostream"
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+	"github.com/CircleCI-Public/circleci-cli/internal/cmdutil"
+	"github.com/CircleCI-Public/circleci-cli/internal/httpcl"
+	"github.com/CircleCI-Public/circleci-cli/internal/ui"
+)
+
+const (
+	timelineParallelism = 8
+
+	// timelineWidth is the number of columns the chart's time axis spans.
+	timelineWidth = 60
+
+	// timelineNameWidth caps the job-name column so long names don't push
+	// the chart off the terminal.
+	timelineNameWidth = 28
+)
+
+// Bar glyphs. Each cell of a row shows what the job was doing at that point in
+// the workflow: waiting for an executor, running, or held for approval.
+const (
+	glyphQueued  = "░"
+	glyphRunning = "█"
+	glyphHold    = "┄"
+	glyphIdle    = " "
+)
+
+func newTimelineCmd() *cobra.Command {
+	var jsonOut bool
+
+	cmd := &cobra.Command{
+		Use:   "timeline <workflow-id>",
+		Short: "Chart when each job queued and ran",
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Docf(`
+				%[1]s<workflow-id>%[1]s is the UUID of the workflow to chart. Workflow IDs are
+				shown in the output of %[1]scircleci run get%[1]s.
+			`, "`"),
+		},
+		Long: heredoc.Doc(`
+			Draw a Gantt-style chart of a workflow: when each job was queued
+			(░), running (█) or held for approval (┄), with a row per parallel
+			execution. Jobs on the critical path — the chain of requires that
+			decided when the workflow finished — are marked with ★.
+
+			The summary splits the workflow's wall-clock time into time when
+			any job was running and time spent only waiting for executors.
+
+			JSON fields: id, name, status, duration_seconds, wall_clock, critical_path, jobs[]
+		`),
+		Example: heredoc.Doc(`
+			# Chart a workflow
+			$ circleci workflow timeline 5034460f-c7c4-4c43-9457-de07e2029e7b
+
+			# Print the critical path's job names
+			$ circleci workflow timeline 5034460f-c7c4-4c43-9457-de07e2029e7b --json --jq '.critical_path.jobs'
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cliErr := cmdutil.RequireArgs(args, "workflow-id"); cliErr != nil {
+				return cliErr
+			}
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return clierrors.New("args.invalid_workflow_id", "Invalid workflow ID",
+					fmt.Sprintf("%q is not a valid workflow UUID.", args[0])).
+					WithExitCode(clierrors.ExitBadArguments)
+			}
+			ctx := cmd.Context()
+			client, err := cmdutil.LoadClient(ctx)
+			if err != nil {
+				return err
+			}
+
+			return runTimeline(ctx, client, id, jsonOut)
+		},
+	}
+
+	cmdutil.AddJSONFlag(cmd, &jsonOut)
+	cmdutil.AddJQFlag(cmd)
+	return cmd
+}
+
+func runTimeline(ctx context.Context, client *apiclient.Client, id uuid.UUID, jsonOut bool) error {
+	wf, err := client.GetWorkflowV3(ctx, id)
+	if err != nil {
+		return apiErr(err, id.String())
+	}
+	jobs, err := client.GetWorkflowJobsV3(ctx, id)
+	if err != nil {
+		return apiErr(err, id.String())
+	}
+
+	// Only the job detail carries per-execution step times, which is what
+	// splits a parallel job into one row per executor.
+	details := make([]*apiclient.JobV3, len(jobs))
+	err = bulkhead.Do(ctx, timelineParallelism, jobs, func(j apiclient.WorkflowJobV3, i int) error {
+		if j.Type == apiclient.JobTypeApproval || j.StartedAt == nil {
+			return nil
+		}
+		d, err := client.GetJobV3(ctx, j.ID)
+		if err != nil && !httpcl.HasStatusCode(err, http.StatusNotFound) {
+			return cmdutil.APIErr(err, j.ID.String(), "job.not_found", "No job found for %q.")
+		}
+		details[i] = d
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	tl := buildTimeline(*wf, jobs, details, time.Now())
+	out := tl.output()
+	if jsonOut {
+		return iostream.PrintJSON(ctx, out)
+	}
+	iostream.Print(ctx, renderTimeline(ctx, tl, out))
+	return nil
+}
+
+// timelineSpan is a closed interval of wall-clock time.
+type timelineSpan struct {
+	start, end time.Time
+}
+
+func (s timelineSpan) duration() time.Duration { return s.end.Sub(s.start) }
+
+type timelineJob struct {
+	job      apiclient.WorkflowJobV3
+	requires []*timelineJob
+
+	// queuedAt is when the job's requires were all satisfied. It is zero for
+	// a job still blocked on an unfinished dependency.
+	queuedAt time.Time
+	// run is the job's running (or, for an approval, held) interval; zero
+	// for a job that has not started. A job still running ends at now.
+	run        timelineSpan
+	executions []timelineSpan
+	critical   bool
+}
+
+func (j *timelineJob) started() bool { return !j.run.start.IsZero() }
+
+func (j *timelineJob) approval() bool { return j.job.Type == apiclient.JobTypeApproval }
+
+// queued is the interval the job spent waiting for an executor: from its
+// requires being met until it started, or until now if it still waits.
+func (j *timelineJob) queued(now time.Time) timelineSpan {
+	if j.queuedAt.IsZero() || j.approval() {
+		return timelineSpan{}
+	}
+	end := now
+	if j.started() {
+		end = j.run.start
+	}
+	if end.Before(j.queuedAt) {
+		return timelineSpan{}
+	}
+	return timelineSpan{start: j.queuedAt, end: end}
+}
+
+type timeline struct {
+	workflow apiclient.WorkflowV3
+	span     timelineSpan
+	now      time.Time
+	jobs     []*timelineJob
+	critical []*timelineJob
+
+	// inferred is set when the API reported no requires for any job and
+	// they were reconstructed from the jobs' start and end times instead.
+	inferred bool
+}
+
+// buildTimeline lays the workflow's jobs out on a shared time axis. details
+// is parallel to jobs; a nil entry means the job's executions are unknown and
+// it is drawn as a single bar.
+func buildTimeline(wf apiclient.WorkflowV3, jobs []apiclient.WorkflowJobV3, details []*apiclient.JobV3, now time.Time) timeline {
+	tl := timeline{workflow: wf, now: now, span: timelineSpan{start: wf.CreatedAt, end: now}}
+	if wf.EndedAt != nil {
+		tl.span.end = *wf.EndedAt
+	}
+
+	byID := map[uuid.UUID]*timelineJob{}
+	for i, j := range jobs {
+		tj := &timelineJob{job: j}
+		if j.StartedAt != nil {
+			tj.run = timelineSpan{start: *j.StartedAt, end: now}
+			if j.EndedAt != nil {
+				tj.run.end = *j.EndedAt
+			}
+		}
+		if i < len(details) && details[i] != nil {
+			tj.executions = executionSpans(*details[i], now)
+		}
+		tl.jobs = append(tl.jobs, tj)
+		byID[j.ID] = tj
+		if tj.run.end.After(tl.span.end) {
+			tl.span.end = tj.run.end
+		}
+	}
+
+	reported := false
+	for _, tj := range tl.jobs {
+		for _, dep := range tj.job.Dependencies {
+			if d, ok := byID[dep]; ok {
+				tj.requires = append(tj.requires, d)
+				reported = true
+			}
+		}
+	}
+	if !reported && len(tl.jobs) > 1 {
+		tl.inferred = inferRequires(tl.jobs)
+	}
+
+	for _, tj := range tl.jobs {
+		tj.queuedAt = queuedAt(tj, wf.CreatedAt)
+	}
+
+	tl.critical = criticalPath(tl.jobs)
+	for _, tj := range tl.critical {
+		tj.critical = true
+	}
+	return tl
+}
+
+// executionSpans derives each parallel execution's interval from its steps.
+func executionSpans(d apiclient.JobV3, now time.Time) []timelineSpan {
+	var spans []timelineSpan
+	for _, e := range d.Executions {
+		var s timelineSpan
+		for _, st := range e.Steps {
+			if st.StartedAt.IsZero() {
+				continue
+			}
+			if s.start.IsZero() || st.StartedAt.Before(s.start) {
+				s.start = st.StartedAt
+			}
+			end := now
+			if st.StoppedAt != nil {
+				end = *st.StoppedAt
+			}
+			if end.After(s.end) {
+				s.end = end
+			}
+		}
+		if !s.start.IsZero() {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
+
+// inferRequires guesses each started job's dependency as the job that
+// finished most recently before it started. It is only a guess — two jobs
+// that happen to start just after an unrelated one ends look the same — so
+// the output says when it was used. It reports whether any edge was added.
+func inferRequires(jobs []*timelineJob) bool {
+	added := false
+	for _, tj := range jobs {
+		if !tj.started() {
+			continue
+		}
+		var best *timelineJob
+		for _, o := range jobs {
+			if o == tj || o.job.EndedAt == nil || o.run.end.After(tj.run.start) {
+				continue
+			}
+			if best == nil || o.run.end.After(best.run.end) {
+				best = o
+			}
+		}
+		if best != nil {
+			tj.requires = []*timelineJob{best}
+			added = true
+		}
+	}
+	return added
+}
+
+// queuedAt is when every one of tj's requires had finished: the workflow's
+// creation for a root job, and zero while any requirement is still pending.
+// It never falls after the job's own start, which clock skew can otherwise
+// produce.
+func queuedAt(tj *timelineJob, created time.Time) time.Time {
+	at := created
+	for _, d := range tj.requires {
+		if d.job.EndedAt == nil {
+			return time.Time{}
+		}
+		if d.run.end.After(at) {
+			at = d.run.end
+		}
+	}
+	if tj.started() && tj.run.start.Before(at) {
+		at = tj.run.start
+	}
+	return at
+}
+
+// criticalPath walks back from the job that finished last, each time to the
+// requirement that finished last, since that is the one the job waited on.
+// The result is in execution order.
+func criticalPath(jobs []*timelineJob) []*timelineJob {
+	var last *timelineJob
+	for _, tj := range jobs {
+		if tj.started() && (last == nil || tj.run.end.After(last.run.end)) {
+			last = tj
+		}
+	}
+
+	var path []*timelineJob
+	seen := map[*timelineJob]bool{}
+	for tj := last; tj != nil && !seen[tj]; {
+		seen[tj] = true
+		path = append(path, tj)
+		var next *timelineJob
+		for _, d := range tj.requires {
+			if d.started() && (next == nil || d.run.end.After(next.run.end)) {
+				next = d
+			}
+		}
+		tj = next
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// wallClock splits the workflow's span by what was happening: any job
+// running, otherwise any job queued, otherwise any approval pending, and
+// otherwise nothing the jobs account for (workflow setup, gaps between jobs).
+func (tl timeline) wallClock() timelineBreakdown {
+	type edge struct {
+		at    time.Time
+		kind  int
+		delta int
+	}
+	const (
+		running = iota
+		queued
+		held
+	)
+	var edges []edge
+	add := func(s timelineSpan, kind int) {
+		if s.duration() > 0 {
+			edges = append(edges, edge{s.start, kind, 1}, edge{s.end, kind, -1})
+		}
+	}
+	for _, tj := range tl.jobs {
+		switch {
+		case tj.approval():
+			add(tj.run, held)
+		case len(tj.executions) > 0:
+			// Executions can start later than the job itself while their
+			// executors spin up; that gap counts as queueing.
+			for _, e := range tj.executions {
+				add(e, running)
+			}
+			add(timelineSpan{start: tj.run.start, end: earliestStart(tj.executions)}, queued)
+		default:
+			add(tj.run, running)
+		}
+		add(tj.queued(tl.now), queued)
+	}
+	sort.SliceStable(edges, func(i, j int) bool { return edges[i].at.Before(edges[j].at) })
+
+	var b timelineBreakdown
+	var active [3]int
+	cursor := tl.span.start
+	accrue := func(until time.Time) {
+		if !until.After(cursor) {
+			return
+		}
+		d := until.Sub(cursor).Seconds()
+		switch {
+		case active[running] > 0:
+			b.RunningSeconds += d
+		case active[queued] > 0:
+			b.QueuedSeconds += d
+		case active[held] > 0:
+			b.OnHoldSeconds += d
+		default:
+			b.OtherSeconds += d
+		}
+		cursor = until
+	}
+	for _, e := range edges {
+		accrue(e.at)
+		active[e.kind] += e.delta
+	}
+	accrue(tl.span.end)
+	return b
+}
+
+func earliestStart(spans []timelineSpan) time.Time {
+	first := spans[0].start
+	for _, s := range spans[1:] {
+		if s.start.Before(first) {
+			first = s.start
+		}
+	}
+	return first
+}
+
+// timelineOutput is the JSON shape of workflow timeline. Seconds are
+// wall-clock; a job still queued or running is measured up to now.
+type timelineOutput struct {
+	ID               uuid.UUID           `json:"id"`
+	Name             string              `json:"name"`
+	Status           string              `json:"status"`
+	CreatedAt        time.Time           `json:"created_at"`
+	EndedAt          *time.Time          `json:"ended_at,omitempty"`
+	DurationSeconds  float64             `json:"duration_seconds"`
+	RequiresInferred bool                `json:"requires_inferred"`
+	WallClock        timelineBreakdown   `json:"wall_clock"`
+	CriticalPath     timelineCritical    `json:"critical_path"`
+	Jobs             []timelineJobOutput `json:"jobs"`
+}
+
+type timelineBreakdown struct {
+	RunningSeconds float64 `json:"running_seconds"`
+	QueuedSeconds  float64 `json:"queued_seconds"`
+	OnHoldSeconds  float64 `json:"on_hold_seconds"`
+	OtherSeconds   float64 `json:"other_seconds"`
+}
+
+type timelineCritical struct {
+	Jobs           []string `json:"jobs"`
+	QueuedSeconds  float64  `json:"queued_seconds"`
+	RunningSeconds float64  `json:"running_seconds"`
+	OnHoldSeconds  float64  `json:"on_hold_seconds"`
+}
+
+type timelineJobOutput struct {
+	ID             uuid.UUID                 `json:"id"`
+	Name           string                    `json:"name"`
+	Type           string                    `json:"type,omitempty"`
+	Status         string                    `json:"status"`
+	Requires       []string                  `json:"requires"`
+	Critical       bool                      `json:"critical"`
+	QueuedAt       *time.Time                `json:"queued_at,omitempty"`
+	StartedAt      *time.Time                `json:"started_at,omitempty"`
+	EndedAt        *time.Time                `json:"ended_at,omitempty"`
+	QueuedSeconds  float64                   `json:"queued_seconds"`
+	RunningSeconds float64                   `json:"running_seconds"`
+	Executions     []timelineExecutionOutput `json:"executions,omitempty"`
+}
+
+type timelineExecutionOutput struct {
+	Index           int       `json:"index"`
+	StartedAt       time.Time `json:"started_at"`
+	EndedAt         time.Time `json:"ended_at"`
+	DurationSeconds float64   `json:"duration_seconds"`
+}
+
+func (tl timeline) output() timelineOutput {
+	wf := tl.workflow
+	out := timelineOutput{
+		ID:               wf.ID,
+		Name:             wf.Name,
+		Status:           apiclient.PhaseOutcomeText(wf.Phase, wf.Outcome, wf.CurrentOutcome),
+		CreatedAt:        wf.CreatedAt,
+		EndedAt:          wf.EndedAt,
+		DurationSeconds:  tl.span.duration().Seconds(),
+		RequiresInferred: tl.inferred,
+		WallClock:        tl.wallClock(),
+		CriticalPath:     timelineCritical{Jobs: []string{}},
+		Jobs:             []timelineJobOutput{},
+	}
+
+	for _, tj := range tl.critical {
+		out.CriticalPath.Jobs = append(out.CriticalPath.Jobs, tj.job.Name)
+		out.CriticalPath.QueuedSeconds += tj.queued(tl.now).duration().Seconds()
+		if tj.approval() {
+			out.CriticalPath.OnHoldSeconds += tj.run.duration().Seconds()
+		} else {
+			out.CriticalPath.RunningSeconds += tj.run.duration().Seconds()
+		}
+	}
+
+	for _, tj := range tl.jobs {
+		j := tj.job
+		jo := timelineJobOutput{
+			ID:            j.ID,
+			Name:          j.Name,
+			Type:          j.Type,
+			Status:        apiclient.PhaseOutcomeText(j.Phase, j.Outcome, j.CurrentOutcome),
+			Requires:      []string{},
+			Critical:      tj.critical,
+			StartedAt:     j.StartedAt,
+			EndedAt:       j.EndedAt,
+			QueuedSeconds: tj.queued(tl.now).duration().Seconds(),
+		}
+		for _, d := range tj.requires {
+			jo.Requires = append(jo.Requires, d.job.Name)
+		}
+		if !tj.queuedAt.IsZero() {
+			jo.QueuedAt = new(tj.queuedAt)
+		}
+		if tj.started() && !tj.approval() {
+			jo.RunningSeconds = tj.run.duration().Seconds()
+		}
+		for i, e := range tj.executions {
+			jo.Executions = append(jo.Executions, timelineExecutionOutput{
+				Index:           i,
+				StartedAt:       e.start,
+				EndedAt:         e.end,
+				DurationSeconds: e.duration().Seconds(),
+			})
+		}
+		out.Jobs = append(out.Jobs, jo)
+	}
+	return out
+}
+
+// renderTimeline draws the chart and its summary as plain fixed-width text;
+// markdown rendering would reflow the bars.
+func renderTimeline(ctx context.Context, tl timeline, out timelineOutput) string {
+	s := iostream.Get(ctx)
+	var b strings.Builder
+
+	_, _ = fmt.Fprintf(&b, "%s  %s  %s\n\n", s.Title(out.Name), out.Status, ui.FormatElapsed(tl.span.duration()))
+
+	nameW := 0
+	for _, tj := range tl.jobs {
+		nameW = max(nameW, len([]rune(tj.job.Name)))
+	}
+	nameW = min(max(nameW, len("exec 0")+2), timelineNameWidth)
+	// Two columns for the critical-path marker, one before the bars.
+	pad := strings.Repeat(" ", nameW+3)
+
+	b.WriteString(pad + timelineAxis(tl.span.duration()) + "\n")
+
+	for _, tj := range tl.jobs {
+		marker := "  "
+		name := truncateName(tj.job.Name, nameW)
+		if tj.critical {
+			marker = s.Warning("★ ")
+		}
+		_, _ = fmt.Fprintf(&b, "%s%-*s %s  %s\n", marker, nameW, name,
+			timelineBar(s, tl, tj, tj.run), timelineRowSuffix(tl, tj))
+		if len(tj.executions) < 2 {
+			continue
+		}
+		for i, e := range tj.executions {
+			label := fmt.Sprintf("  exec %d", i)
+			bar := timelineBar(s, tl, &timelineJob{job: tj.job, run: e, critical: tj.critical}, e)
+			_, _ = fmt.Fprintf(&b, "  %-*s %s  %s\n", nameW, label, bar, s.Muted(ui.FormatElapsed(e.duration())))
+		}
+	}
+
+	b.WriteString("\n")
+	_, _ = fmt.Fprintf(&b, "%s queued  %s running  %s on hold  %s critical path\n\n",
+		s.Muted(glyphQueued), glyphRunning, glyphHold, s.Warning("★"))
+
+	total := out.DurationSeconds
+	wc := out.WallClock
+	_, _ = fmt.Fprintf(&b, "Wall clock: %s — running %s, only queued %s",
+		formatSeconds(total), withShare(wc.RunningSeconds, total), withShare(wc.QueuedSeconds, total))
+	if wc.OnHoldSeconds > 0 {
+		_, _ = fmt.Fprintf(&b, ", on hold %s", withShare(wc.OnHoldSeconds, total))
+	}
+	if wc.OtherSeconds >= 1 {
+		_, _ = fmt.Fprintf(&b, ", other %s", withShare(wc.OtherSeconds, total))
+	}
+	b.WriteString("\n")
+
+	cp := out.CriticalPath
+	if len(cp.Jobs) > 0 {
+		_, _ = fmt.Fprintf(&b, "Critical path: %s\n", strings.Join(cp.Jobs, " → "))
+		_, _ = fmt.Fprintf(&b, "  queued %s, running %s", formatSeconds(cp.QueuedSeconds), formatSeconds(cp.RunningSeconds))
+		if cp.OnHoldSeconds > 0 {
+			_, _ = fmt.Fprintf(&b, ", on hold %s", formatSeconds(cp.OnHoldSeconds))
+		}
+		b.WriteString("\n")
+	}
+	if tl.inferred {
+		b.WriteString(s.Muted("Job requires were not reported by the API; the critical path is inferred from start and end times.") + "\n")
+	}
+	return b.String()
+}
+
+// timelineAxis labels the chart's start, middle and end.
+func timelineAxis(d time.Duration) string {
+	left := "0s"
+	mid := ui.FormatElapsed(d / 2)
+	right := ui.FormatElapsed(d)
+	row := []rune(strings.Repeat(" ", timelineWidth))
+	place := func(s string, col int) {
+		col = max(0, min(col, timelineWidth-len(s)))
+		copy(row[col:], []rune(s))
+	}
+	place(left, 0)
+	place(mid, timelineWidth/2-len(mid)/2)
+	place(right, timelineWidth-len(right))
+	return string(row)
+}
+
+// timelineBar draws one row: queued cells, then run cells, on the shared
+// axis. Any interval that is non-empty gets at least one cell so a short job
+// is still visible. Cells are styled in runs rather than one by one to keep
+// the escape sequences down.
+func timelineBar(s iostream.Streams, tl timeline, tj *timelineJob, run timelineSpan) string {
+	cells := []rune(strings.Repeat(glyphIdle, timelineWidth))
+	fill := func(span timelineSpan, glyph string) {
+		if span.start.IsZero() || span.duration() < 0 {
+			return
+		}
+		from, to := timelineCol(tl.span, span.start), timelineCol(tl.span, span.end)
+		for c := from; c <= to; c++ {
+			cells[c] = []rune(glyph)[0]
+		}
+	}
+
+	fill(tj.queued(tl.now), glyphQueued)
+	runGlyph := glyphRunning
+	if tj.approval() {
+		runGlyph = glyphHold
+	}
+	fill(run, runGlyph)
+
+	style := func(seg string) string {
+		switch {
+		case strings.HasPrefix(seg, glyphQueued):
+			return s.Muted(seg)
+		case tj.critical && !strings.HasPrefix(seg, glyphIdle):
+			return s.Warning(seg)
+		}
+		return seg
+	}
+	var b strings.Builder
+	for i := 0; i < len(cells); {
+		j := i
+		for j < len(cells) && cells[j] == cells[i] {
+			j++
+		}
+		b.WriteString(style(string(cells[i:j])))
+		i = j
+	}
+	return b.String()
+}
+
+func timelineCol(axis timelineSpan, t time.Time) int {
+	total := axis.duration()
+	if total <= 0 {
+		return 0
+	}
+	frac := float64(t.Sub(axis.start)) / float64(total)
+	return max(0, min(timelineWidth-1, int(frac*float64(timelineWidth-1)+0.5)))
+}
+
+func timelineRowSuffix(tl timeline, tj *timelineJob) string {
+	status := apiclient.PhaseOutcomeText(tj.job.Phase, tj.job.Outcome, tj.job.CurrentOutcome)
+	if !tj.started() {
+		return status
+	}
+	return fmt.Sprintf("%s %s", ui.FormatElapsed(tj.run.duration()), status)
+}
+
+func truncateName(name string, w int) string {
+	r := []rune(name)
+	if len(r) <= w {
+		return name
+	}
+	return string(r[:w-1]) + "…"
+}
+
+func formatSeconds(sec float64) string {
+	return ui.FormatElapsed(time.Duration(sec * float64(time.Second)))
+}
+
+func withShare(sec, total float64) string {
+	if total <= 0 {
+		return formatSeconds(sec)
+	}
+	return fmt.Sprintf("%s (%.0f%%)", formatSeconds(sec), sec/total*100)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/apiclient"
+)
+
+// t0 is when the test workflows are created; the helpers below take offsets
+// from it in seconds.
+var t0 = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func at(sec int) time.Time { return t0.Add(time.Duration(sec) * time.Second) }
+
+// testJob is a timelineJob that ran from start to end seconds. A negative
+// start means it never started, a negative end that it is still running.
+func testJob(name string, start, end int, requires ...*timelineJob) *timelineJob {
+	tj := &timelineJob{job: apiclient.WorkflowJobV3{ID: uuid.New(), Name: name}, requires: requires}
+	if start >= 0 {
+		tj.job.StartedAt = new(at(start))
+		tj.run = timelineSpan{start: at(start), end: at(1000)}
+	}
+	if end >= 0 {
+		tj.job.EndedAt = new(at(end))
+		tj.run.end = at(end)
+	}
+	return tj
+}
+
+func jobNames(jobs []*timelineJob) []string {
+	names := []string{}
+	for _, tj := range jobs {
+		names = append(names, tj.job.Name)
+	}
+	return names
+}
+
+func Test_criticalPath(t *testing.T) {
+	tests := []struct {
+		name string
+		jobs func() []*timelineJob
+		want []string
+	}{
+		{
+			name: "no jobs",
+			jobs: func() []*timelineJob { return nil },
+			want: []string{},
+		},
+		{
+			name: "chain",
+			jobs: func() []*timelineJob {
+				build := testJob("build", 0, 10)
+				test := testJob("test", 10, 30, build)
+				deploy := testJob("deploy", 30, 40, test)
+				return []*timelineJob{build, test, deploy}
+			},
+			want: []string{"build", "test", "deploy"},
+		},
+		{
+			name: "fan-in follows the requirement that finished last",
+			jobs: func() []*timelineJob {
+				lint := testJob("lint", 0, 5)
+				test := testJob("test", 0, 20)
+				deploy := testJob("deploy", 20, 30, lint, test)
+				return []*timelineJob{lint, test, deploy}
+			},
+			want: []string{"test", "deploy"},
+		},
+		{
+			name: "ends at the last job to finish, not the last listed",
+			jobs: func() []*timelineJob {
+				slow := testJob("slow", 0, 50)
+				fast := testJob("fast", 0, 10)
+				blocked := testJob("blocked", -1, -1, fast)
+				return []*timelineJob{slow, fast, blocked}
+			},
+			want: []string{"slow"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Check(t, cmp.DeepEqual(jobNames(criticalPath(tt.jobs())), tt.want))
+		})
+	}
+}
+
+func Test_inferRequires(t *testing.T) {
+	tests := []struct {
+		name      string
+		jobs      func() []*timelineJob
+		wantAdded bool
+		want      map[string][]string
+	}{
+		{
+			name: "sequential jobs form a chain",
+			jobs: func() []*timelineJob {
+				return []*timelineJob{testJob("build", 0, 10), testJob("test", 12, 20), testJob("deploy", 25, 30)}
+			},
+			wantAdded: true,
+			want:      map[string][]string{"build": nil, "test": {"build"}, "deploy": {"test"}},
+		},
+		{
+			name: "overlapping jobs require nothing",
+			jobs: func() []*timelineJob {
+				return []*timelineJob{testJob("lint", 0, 10), testJob("test", 5, 20)}
+			},
+			want: map[string][]string{"lint": nil, "test": nil},
+		},
+		{
+			name: "running and unstarted jobs are never a requirement",
+			jobs: func() []*timelineJob {
+				return []*timelineJob{testJob("build", 0, -1), testJob("test", 20, 30), testJob("deploy", -1, -1)}
+			},
+			want: map[string][]string{"build": nil, "test": nil, "deploy": nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs := tt.jobs()
+			assert.Check(t, cmp.Equal(inferRequires(jobs), tt.wantAdded))
+			for _, tj := range jobs {
+				want := tt.want[tj.job.Name]
+				if want == nil {
+					want = []string{}
+				}
+				assert.Check(t, cmp.DeepEqual(jobNames(tj.requires), want), tj.job.Name)
+			}
+		})
+	}
+}
+
+func Test_queuedAt(t *testing.T) {
+	build := testJob("build", 0, 10)
+	lint := testJob("lint", 0, 15)
+	running := testJob("running", 0, -1)
+
+	tests := []struct {
+		name string
+		job  *timelineJob
+		want time.Time
+	}{
+		{name: "root job queues at workflow creation", job: testJob("build", 5, 10), want: at(0)},
+		{name: "queues once its last requirement ends", job: testJob("test", 20, 30, build, lint), want: at(15)},
+		{name: "blocked on a running requirement", job: testJob("test", -1, -1, build, running), want: time.Time{}},
+		{name: "never after its own start", job: testJob("test", 12, 30, lint), want: at(12)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Check(t, cmp.Equal(queuedAt(tt.job, at(0)), tt.want))
+		})
+	}
+}
+
+func Test_wallClock(t *testing.T) {
+	jobV3 := func(id uuid.UUID, name, typ string, start, end int, deps ...uuid.UUID) apiclient.WorkflowJobV3 {
+		return apiclient.WorkflowJobV3{
+			ID: id, Name: name, Type: typ, StartedAt: new(at(start)), EndedAt: new(at(end)), Dependencies: deps,
+		}
+	}
+	executions := func(spans ...[2]int) *apiclient.JobV3 {
+		d := &apiclient.JobV3{}
+		for i, s := range spans {
+			d.Executions = append(d.Executions, apiclient.JobV3Execution{Index: i, Steps: []apiclient.JobV3Step{
+				{StartedAt: at(s[0]), StoppedAt: new(at(s[1]))},
+			}})
+		}
+		return d
+	}
+	approvalID, buildID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		ended   int
+		jobs    []apiclient.WorkflowJobV3
+		details []*apiclient.JobV3
+		want    timelineBreakdown
+	}{
+		{
+			name:  "setup, queue, run and teardown",
+			ended: 20,
+			jobs:  []apiclient.WorkflowJobV3{jobV3(buildID, "build", "build", 5, 15)},
+			want:  timelineBreakdown{QueuedSeconds: 5, RunningSeconds: 10, OtherSeconds: 5},
+		},
+		{
+			name:  "an approval is on hold until approved",
+			ended: 40,
+			jobs: []apiclient.WorkflowJobV3{
+				jobV3(approvalID, "hold", apiclient.JobTypeApproval, 0, 30),
+				jobV3(buildID, "deploy", "build", 30, 40, approvalID),
+			},
+			want: timelineBreakdown{OnHoldSeconds: 30, RunningSeconds: 10},
+		},
+		{
+			name:    "waiting for executions to start counts as queued",
+			ended:   20,
+			jobs:    []apiclient.WorkflowJobV3{jobV3(buildID, "build", "build", 0, 20)},
+			details: []*apiclient.JobV3{executions([2]int{5, 20}, [2]int{8, 18})},
+			want:    timelineBreakdown{QueuedSeconds: 5, RunningSeconds: 15},
+		},
+		{
+			name:  "running outranks queued",
+			ended: 30,
+			jobs: []apiclient.WorkflowJobV3{
+				jobV3(buildID, "build", "build", 0, 30),
+				jobV3(uuid.New(), "lint", "build", 10, 20),
+			},
+			want: timelineBreakdown{RunningSeconds: 30},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := apiclient.WorkflowV3{CreatedAt: at(0), EndedAt: new(at(tt.ended))}
+			tl := buildTimeline(wf, tt.jobs, tt.details, at(1000))
+			assert.Check(t, cmp.DeepEqual(tl.wallClock(), tt.want))
+		})
+	}
+}
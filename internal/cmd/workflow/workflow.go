@@ -55,6 +55,7 @@ func NewWorkflowCmd() *cobra.Command {
 		newGetCmd(),
 		newOpenCmd(),
 		newRerunCmd(),
+		newTimelineCmd(),
 	)
 
 	return cmd
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package cmdutil
+
+import "strings"
+
+// ColorizeDiff colors a unified diff for the terminal: file headers yellow, hunk
+// headers cyan, removals red and additions green. With color off it returns the
+// diff unchanged.
+func ColorizeDiff(diff string, color bool) string {
+	if !color {
+		return diff
+	}
+	lines := strings.Split(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "--- ") || strings.HasPrefix(line, "+++ "):
+			lines[i] = "\033[33m" + line + "\033[0m" // yellow
+		case strings.HasPrefix(line, "@@ "):
+			lines[i] = "\033[36m" + line + "\033[0m" // cyan
+		case strings.HasPrefix(line, "-"):
+			lines[i] = "\033[31m" + line + "\033[0m" // red
+		case strings.HasPrefix(line, "+"):
+			lines[i] = "\033[32m" + line + "\033[0m" // green
+		}
+	}
+	return strings.Join(lines, "\n")
+}
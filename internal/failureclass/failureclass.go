@@ -0,0 +1,318 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package failureclass sorts failed CI steps into a small set of well-known
+// failure classes — out of memory, no-output timeout, network flake and so on —
+// each paired with a suggested next action.
+//
+// Classes are driven by an ordered rule library: the built-in rules cover the
+// common cases, and a user file can add new classes, replace a built-in rule, or
+// disable one outright. The first rule that matches a step wins.
+package failureclass
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the user rule file, looked up next to the CLI config file.
+const FileName = "failure-patterns.yml"
+
+// Unclassified is the class reported for a step no rule matched.
+const Unclassified = "unclassified"
+
+// memoryAtLimitRatio is how close to the resource class's memory limit a step's
+// peak must come to count as "at the limit". Samples are taken every few
+// seconds, so a process killed on the way up rarely records the limit itself.
+const memoryAtLimitRatio = 0.95
+
+// Rule describes one failure class and how to recognise it. A rule matches a
+// step when any of its patterns matches the step's output, when the step exited
+// with one of its exit codes, or — for rules with MemoryAtLimit — when the
+// step's memory peaked at the resource class limit.
+type Rule struct {
+	Class         string   `yaml:"class"`
+	Title         string   `yaml:"title"`
+	Action        string   `yaml:"action"`
+	Patterns      []string `yaml:"patterns,omitempty"`
+	ExitCodes     []int    `yaml:"exit_codes,omitempty"`
+	MemoryAtLimit bool     `yaml:"memory_at_limit,omitempty"`
+	// Disable removes a built-in rule of the same class. It is only meaningful
+	// in a user file.
+	Disable bool `yaml:"disable,omitempty"`
+
+	compiled []*regexp.Regexp
+}
+
+// Step is what the classifier knows about one failed step. The memory fields
+// are zero when the job's resource usage was unavailable.
+type Step struct {
+	Output           []byte
+	ExitCode         *int
+	MemoryPeakBytes  int64
+	MemoryLimitBytes int64
+}
+
+// Classification is the verdict for one step. Evidence says which signal
+// matched, so a reader can judge how much to trust it.
+type Classification struct {
+	Class    string `json:"class"`
+	Title    string `json:"title"`
+	Action   string `json:"suggested_action"`
+	Evidence string `json:"evidence,omitempty"`
+}
+
+// Library is an ordered, compiled rule set.
+type Library struct {
+	rules []Rule
+}
+
+// builtin is the default rule set, most specific first: an OOM kill often
+// surfaces as a test runner crash, and a rate-limited docker pull as a network
+// error, so those classes must be tried before the broader ones.
+var builtin = []Rule{
+	{
+		Class:  "oom",
+		Title:  "Out of memory",
+		Action: "Raise the job's resource_class, or cap the memory the step's tools may use (test worker count, JVM -Xmx, NODE_OPTIONS=--max-old-space-size).",
+		Patterns: []string{
+			`(?i)out of memory`,
+			`OOMKilled`,
+			`(?i)cannot allocate memory`,
+			`(?i)heap out of memory`,
+			`java\.lang\.OutOfMemoryError`,
+			`(?m)^Killed$`,
+		},
+		ExitCodes:     []int{137},
+		MemoryAtLimit: true,
+	},
+	{
+		Class:  "no_output_timeout",
+		Title:  "No-output timeout",
+		Action: "Find what the step was waiting on; if it is legitimately quiet, raise no_output_timeout on the step or make it print progress.",
+		Patterns: []string{
+			`(?i)too long with no output`,
+			`(?i)no_output_timeout`,
+		},
+	},
+	{
+		Class:  "docker_rate_limit",
+		Title:  "Docker pull rate limit",
+		Action: "Authenticate image pulls with Docker Hub credentials, or pull from a mirror or registry without anonymous limits.",
+		Patterns: []string{
+			`toomanyrequests`,
+			`(?i)reached your pull rate limit`,
+		},
+	},
+	{
+		Class:  "network",
+		Title:  "Network or DNS failure",
+		Action: "Likely transient; rerun the workflow from failed. If it recurs, add retries around the network call.",
+		Patterns: []string{
+			`(?i)could not resolve host`,
+			`(?i)temporary failure in name resolution`,
+			`(?i)no such host`,
+			`(?i)connection (reset by peer|refused|timed out)`,
+			`(?i)tls handshake timeout`,
+			`\b(ECONNRESET|ETIMEDOUT|EAI_AGAIN|ENOTFOUND)\b`,
+			`(?i)i/o timeout`,
+		},
+	},
+	{
+		Class:  "dependency_download",
+		Title:  "Dependency download failure",
+		Action: "Check the dependency exists at the requested version and the registry credentials are valid; cache dependencies to reduce registry round-trips.",
+		Patterns: []string{
+			`npm ERR! (404|code E404|code ETARGET)`,
+			`ERR_PNPM_FETCH`,
+			`(?i)no matching distribution found`,
+			`(?i)could not find a version that satisfies`,
+			`(?i)could not resolve dependencies`,
+			`(?i)could not (resolve|transfer) artifact`,
+			`(?i)failed to download`,
+			`go: .*: (reading|verifying) .*: (403|404|410)`,
+		},
+	},
+	{
+		Class:  "test_assertion",
+		Title:  "Test assertion failure",
+		Action: "Reproduce the failing tests locally; if they pass on rerun, mark them as flaky and investigate.",
+		Patterns: []string{
+			`(?m)^\s*--- FAIL: `,
+			`AssertionError`,
+			`(?i)\bexpected\b.*\b(but (got|was)|to (equal|be))\b`,
+			`(?m)^FAILED .*::`,
+			`(?i)\b[1-9]\d* (tests?|examples?|specs?) failed\b`,
+			`(?i)\b[1-9]\d* failures?\b`,
+		},
+	},
+}
+
+// Builtin returns the built-in library.
+func Builtin() *Library {
+	lib, err := compile(builtin)
+	if err != nil {
+		panic(err) // built-in patterns are constants; a bad one is a programming error
+	}
+	return lib
+}
+
+// Path returns the user rule file's location alongside the given config file.
+func Path(configPath string) string {
+	return filepath.Join(filepath.Dir(configPath), FileName)
+}
+
+// Load returns the built-in library merged with the user rules at path. A
+// missing file is not an error and yields the built-in library unchanged.
+//
+// User rules take precedence: a rule whose class matches a built-in one replaces
+// it in place (or removes it, with disable: true), and rules for new classes are
+// tried before all built-in rules.
+func Load(path string) (*Library, error) {
+	data, err := os.ReadFile(path) //#nosec:G304 // path is the user's own rule file next to their config
+	if errors.Is(err, os.ErrNotExist) {
+		return Builtin(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var file struct {
+		Rules []Rule `yaml:"rules"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return merge(builtin, file.Rules, path)
+}
+
+func merge(base, user []Rule, path string) (*Library, error) {
+	byClass := make(map[string]int, len(base))
+	for i, r := range base {
+		byClass[r.Class] = i
+	}
+
+	rules := append([]Rule(nil), base...)
+	removed := make(map[int]bool)
+	var added []Rule
+	for i, r := range user {
+		if r.Class == "" {
+			return nil, fmt.Errorf("%s: rule %d is missing 'class'", path, i+1)
+		}
+		if r.Class == Unclassified {
+			return nil, fmt.Errorf("%s: rule %d: class %q is reserved", path, i+1, Unclassified)
+		}
+		j, ok := byClass[r.Class]
+		switch {
+		case ok && r.Disable:
+			removed[j] = true
+		case ok:
+			rules[j] = r
+		case !r.Disable:
+			added = append(added, r)
+		}
+	}
+
+	merged := added
+	for i, r := range rules {
+		if !removed[i] {
+			merged = append(merged, r)
+		}
+	}
+	lib, err := compile(merged)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return lib, nil
+}
+
+func compile(rules []Rule) (*Library, error) {
+	out := make([]Rule, len(rules))
+	for i, r := range rules {
+		r.compiled = make([]*regexp.Regexp, len(r.Patterns))
+		for k, p := range r.Patterns {
+			re, err := regexp.Compile(p)
+			if err != nil {
+				return nil, fmt.Errorf("class %s: invalid pattern %q: %w", r.Class, p, err)
+			}
+			r.compiled[k] = re
+		}
+		if r.Title == "" {
+			r.Title = r.Class
+		}
+		out[i] = r
+	}
+	return &Library{rules: out}, nil
+}
+
+// Classify returns the first matching rule's class for s, or the Unclassified
+// class when nothing matches. It never returns nil.
+func (l *Library) Classify(s Step) *Classification {
+	for _, r := range l.rules {
+		if evidence, ok := r.match(s); ok {
+			return &Classification{Class: r.Class, Title: r.Title, Action: r.Action, Evidence: evidence}
+		}
+	}
+	return &Classification{
+		Class:  Unclassified,
+		Title:  "Unclassified",
+		Action: "No known failure pattern matched; read the step output.",
+	}
+}
+
+func (r Rule) match(s Step) (string, bool) {
+	for _, re := range r.compiled {
+		if loc := re.FindIndex(s.Output); loc != nil {
+			return fmt.Sprintf("output matched %q", excerpt(s.Output[loc[0]:loc[1]])), true
+		}
+	}
+	if s.ExitCode != nil {
+		for _, code := range r.ExitCodes {
+			if *s.ExitCode == code {
+				return fmt.Sprintf("exit code %d", code), true
+			}
+		}
+	}
+	if r.MemoryAtLimit && s.MemoryLimitBytes > 0 &&
+		float64(s.MemoryPeakBytes) >= memoryAtLimitRatio*float64(s.MemoryLimitBytes) {
+		return fmt.Sprintf("memory peaked at %d%% of the %d MiB limit",
+			s.MemoryPeakBytes*100/s.MemoryLimitBytes, s.MemoryLimitBytes>>20), true
+	}
+	return "", false
+}
+
+// maxEvidence bounds how much matched output is quoted back as evidence; a
+// pattern spanning a long line would otherwise repeat the whole line.
+const maxEvidence = 80
+
+func excerpt(b []byte) string {
+	r := []rune(string(b))
+	if len(r) > maxEvidence {
+		return string(r[:maxEvidence]) + "…"
+	}
+	return string(r)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package failureclass_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/failureclass"
+)
+
+func TestClassify_Builtin(t *testing.T) {
+	lib := failureclass.Builtin()
+	tests := []struct {
+		name string
+		step failureclass.Step
+		want string
+	}{
+		{
+			name: "oom by exit code",
+			step: failureclass.Step{Output: []byte("running suite\n"), ExitCode: new(137)},
+			want: "oom",
+		},
+		{
+			name: "oom by memory at the limit",
+			step: failureclass.Step{Output: []byte("Segmentation fault\n"), ExitCode: new(1), MemoryPeakBytes: 3990 << 20, MemoryLimitBytes: 4096 << 20},
+			want: "oom",
+		},
+		{
+			name: "memory well under the limit is not oom",
+			step: failureclass.Step{Output: []byte("exit status 2\n"), ExitCode: new(2), MemoryPeakBytes: 1 << 30, MemoryLimitBytes: 4 << 30},
+			want: failureclass.Unclassified,
+		},
+		{
+			name: "no output timeout",
+			step: failureclass.Step{Output: []byte("Too long with no output (exceeded 10m0s): context deadline exceeded\n")},
+			want: "no_output_timeout",
+		},
+		{
+			// A rate-limited pull also mentions the registry host; the more
+			// specific class must win over the network one.
+			name: "docker rate limit",
+			step: failureclass.Step{Output: []byte("Error response from daemon: toomanyrequests: You have reached your pull rate limit.\n")},
+			want: "docker_rate_limit",
+		},
+		{
+			name: "dns failure",
+			step: failureclass.Step{Output: []byte("curl: (6) Could not resolve host: example.com\n")},
+			want: "network",
+		},
+		{
+			name: "dependency download",
+			step: failureclass.Step{Output: []byte("ERROR: No matching distribution found for requests==99.0\n")},
+			want: "dependency_download",
+		},
+		{
+			name: "go test failure",
+			step: failureclass.Step{Output: []byte("--- FAIL: TestThing (0.00s)\nFAIL\n")},
+			want: "test_assertion",
+		},
+		{
+			name: "zero failures is not a test failure",
+			step: failureclass.Step{Output: []byte("10 examples, 0 failures\nrake aborted!\n")},
+			want: failureclass.Unclassified,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lib.Classify(tt.step)
+			assert.Check(t, cmp.Equal(got.Class, tt.want))
+			assert.Check(t, got.Action != "")
+		})
+	}
+}
+
+func TestClassify_Evidence(t *testing.T) {
+	got := failureclass.Builtin().Classify(failureclass.Step{
+		ExitCode: new(1), MemoryPeakBytes: 4000 << 20, MemoryLimitBytes: 4096 << 20,
+	})
+	assert.Check(t, cmp.Equal(got.Evidence, "memory peaked at 97% of the 4096 MiB limit"))
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	lib, err := failureclass.Load(filepath.Join(t.TempDir(), failureclass.FileName))
+	assert.NilError(t, err)
+	assert.Check(t, cmp.Equal(lib.Classify(failureclass.Step{ExitCode: new(137)}).Class, "oom"))
+}
+
+func TestLoad_UserRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), failureclass.FileName)
+	assert.NilError(t, os.WriteFile(path, []byte(`rules:
+  - class: flaky_selenium
+    title: Selenium session lost
+    action: Rerun from failed.
+    patterns: ["SessionNotCreatedException"]
+  - class: network
+    action: Page the network team.
+    patterns: ["ECONNRESET"]
+  - class: oom
+    disable: true
+`), 0o600))
+
+	lib, err := failureclass.Load(path)
+	assert.NilError(t, err)
+
+	// New classes are tried before the built-in ones.
+	got := lib.Classify(failureclass.Step{Output: []byte("SessionNotCreatedException: 1 test failed")})
+	assert.Check(t, cmp.Equal(got.Class, "flaky_selenium"))
+	assert.Check(t, cmp.Equal(got.Title, "Selenium session lost"))
+
+	// A replaced built-in keeps only the user's patterns, and defaults its title.
+	got = lib.Classify(failureclass.Step{Output: []byte("read ECONNRESET")})
+	assert.Check(t, cmp.Equal(got.Action, "Page the network team."))
+	assert.Check(t, cmp.Equal(got.Title, "network"))
+	got = lib.Classify(failureclass.Step{Output: []byte("Could not resolve host: example.com")})
+	assert.Check(t, cmp.Equal(got.Class, failureclass.Unclassified))
+
+	// A disabled built-in no longer matches.
+	got = lib.Classify(failureclass.Step{ExitCode: new(137)})
+	assert.Check(t, cmp.Equal(got.Class, failureclass.Unclassified))
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "bad yaml", content: "rules: [", wantErr: "parsing"},
+		{name: "missing class", content: "rules:\n  - patterns: [x]\n", wantErr: "rule 1 is missing 'class'"},
+		{name: "reserved class", content: "rules:\n  - class: unclassified\n", wantErr: `class "unclassified" is reserved`},
+		{name: "bad pattern", content: "rules:\n  - class: x\n    patterns: ['(']\n", wantErr: `class x: invalid pattern "("`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), failureclass.FileName)
+			assert.NilError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+			_, err := failureclass.Load(path)
+			assert.Check(t, cmp.ErrorContains(err, tt.wantErr))
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package gitremote
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/go-git/go-git/v6/plumbing"
+	"github.com/go-git/go-git/v6/plumbing/object"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/closer"
+)
+
+// ErrNotAncestor is returned by CommitRangeIn when the good revision is not on
+// the first-parent history of the bad one.
+var ErrNotAncestor = errors.New("not an ancestor")
+
+// Commit is one commit in a walked history: its full SHA and the first line of
+// its message.
+type Commit struct {
+	SHA     string
+	Subject string
+}
+
+// ResolveRevisionIn resolves a revision — a full or abbreviated SHA, a branch,
+// a tag, or an expression like HEAD~3 — to a commit in the repository
+// containing dir. An empty dir means the process working directory.
+func ResolveRevisionIn(dir, rev string) (_ Commit, err error) {
+	repo, err := openRepoIn(dir)
+	if err != nil {
+		return Commit{}, err
+	}
+	defer closer.ErrorHandler(repo, &err)
+
+	h, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return Commit{}, fmt.Errorf("resolving %q: %w", rev, err)
+	}
+	c, err := repo.CommitObject(*h)
+	if err != nil {
+		return Commit{}, err
+	}
+	return commitOf(c), nil
+}
+
+// CommitRangeIn returns the commits after good up to and including bad,
+// oldest first, following first parents back from bad. This is the linear
+// history a merge-based main branch presents: each merge is one candidate,
+// and the commits inside a merged branch are not visited individually.
+//
+// It returns ErrNotAncestor when good is not reached, which includes good
+// and bad being the same commit in the wrong order.
+func CommitRangeIn(dir, good, bad string) (_ []Commit, err error) {
+	repo, err := openRepoIn(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.ErrorHandler(repo, &err)
+
+	goodHash, err := repo.ResolveRevision(plumbing.Revision(good))
+	if err != nil {
+		return nil, fmt.Errorf("resolving %q: %w", good, err)
+	}
+	badHash, err := repo.ResolveRevision(plumbing.Revision(bad))
+	if err != nil {
+		return nil, fmt.Errorf("resolving %q: %w", bad, err)
+	}
+
+	var newestFirst []Commit
+	h := *badHash
+	for h != *goodHash {
+		c, err := repo.CommitObject(h)
+		if err != nil {
+			return nil, err
+		}
+		newestFirst = append(newestFirst, commitOf(c))
+		if len(c.ParentHashes) == 0 {
+			return nil, fmt.Errorf("%s is not a first-parent ancestor of %s: %w", good, bad, ErrNotAncestor)
+		}
+		h = c.ParentHashes[0]
+	}
+	if len(newestFirst) == 0 {
+		return nil, fmt.Errorf("%s and %s are the same commit: %w", good, bad, ErrNotAncestor)
+	}
+
+	commits := make([]Commit, len(newestFirst))
+	for i, c := range newestFirst {
+		commits[len(newestFirst)-1-i] = c
+	}
+	return commits, nil
+}
+
+// OriginBranchesAtIn returns the names of origin's branches whose tip is sha,
+// as origin reports them now. It asks origin with git ls-remote rather than
+// reading refs/remotes/origin, so the answer does not depend on when the
+// repository was last fetched, and the user's credential helpers and url rewrites apply.
+func OriginBranchesAtIn(ctx context.Context, dir, sha string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", "ls-remote", "--heads", "origin")
+	cmd.Dir = dir
+	// Fail rather than wait on a credential prompt the user may never see.
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git ls-remote origin: %w: %s", err, msg)
+		}
+		return nil, fmt.Errorf("git ls-remote origin: %w", err)
+	}
+
+	var branches []string
+	for line := range strings.Lines(string(out)) {
+		hash, ref, ok := strings.Cut(strings.TrimSpace(line), "\t")
+		if ok && hash == sha {
+			branches = append(branches, strings.TrimPrefix(ref, "refs/heads/"))
+		}
+	}
+	return branches, nil
+}
+
+// ErrPullRequestNotFetched is returned by PullRequestHeadIn when no local ref
+// holds the pull request's head.
+var ErrPullRequestNotFetched = errors.New("pull request not fetched")
+
+// PullRequestHeadIn returns the head commit of pull request n as fetched into
+// the repository containing dir. Fetch refspecs name these refs differently, so
+// refs/pull/<n>/head and the remote-tracking layouts <remote>/pr/<n>,
+// <remote>/pull/<n> and <remote>/pull/<n>/head are all recognised; when several
+// exist the first by name wins.
+func PullRequestHeadIn(dir string, n int) (_ Commit, err error) {
+	repo, err := openRepoIn(dir)
+	if err != nil {
+		return Commit{}, err
+	}
+	defer closer.ErrorHandler(repo, &err)
+
+	refs, err := repo.References()
+	if err != nil {
+		return Commit{}, err
+	}
+	defer refs.Close()
+
+	suffixes := []string{fmt.Sprintf("/pr/%d", n), fmt.Sprintf("/pull/%d", n), fmt.Sprintf("/pull/%d/head", n)}
+	var best *plumbing.Reference
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().String()
+		if ref.Type() != plumbing.HashReference {
+			return nil
+		}
+		match := name == fmt.Sprintf("refs/pull/%d/head", n)
+		if strings.HasPrefix(name, "refs/remotes/") {
+			for _, suffix := range suffixes {
+				match = match || strings.HasSuffix(name, suffix)
+			}
+		}
+		if match && (best == nil || name < best.Name().String()) {
+			best = ref
+		}
+		return nil
+	})
+	if err != nil {
+		return Commit{}, err
+	}
+	if best == nil {
+		return Commit{}, fmt.Errorf("pull request #%d: %w", n, ErrPullRequestNotFetched)
+	}
+
+	c, err := repo.CommitObject(best.Hash())
+	if err != nil {
+		return Commit{}, err
+	}
+	return commitOf(c), nil
+}
+
+func commitOf(c *object.Commit) Commit {
+	subject, _, _ := strings.Cut(c.Message, "\n")
+	return Commit{SHA: c.Hash.String(), Subject: strings.TrimSpace(subject)}
+}
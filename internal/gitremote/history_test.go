@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package gitremote
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v6"
+	"github.com/go-git/go-git/v6/config"
+	"github.com/go-git/go-git/v6/plumbing"
+	"github.com/go-git/go-git/v6/plumbing/object"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+// commitChain creates n empty commits "c0".."c<n-1>" and returns their SHAs.
+func commitChain(t *testing.T, dir string, n int) []string {
+	t.Helper()
+	repo, err := git.PlainInit(dir, false)
+	assert.NilError(t, err)
+	wt, err := repo.Worktree()
+	assert.NilError(t, err)
+
+	shas := make([]string, n)
+	for i := range n {
+		h, err := wt.Commit(fmt.Sprintf("c%d\n\nbody", i), &git.CommitOptions{
+			AllowEmptyCommits: true,
+			Author: &object.Signature{
+				Name: "test", Email: "test@test.com",
+				When: time.Date(2026, 1, 1, 0, i, 0, 0, time.UTC),
+			},
+		})
+		assert.NilError(t, err)
+		shas[i] = h.String()
+	}
+	return shas
+}
+
+func TestCommitRangeIn(t *testing.T) {
+	dir := t.TempDir()
+	shas := commitChain(t, dir, 5)
+
+	commits, err := CommitRangeIn(dir, shas[1][:8], "HEAD")
+	assert.NilError(t, err)
+	assert.Check(t, cmp.DeepEqual(commits, []Commit{
+		{SHA: shas[2], Subject: "c2"},
+		{SHA: shas[3], Subject: "c3"},
+		{SHA: shas[4], Subject: "c4"},
+	}))
+
+	_, err = CommitRangeIn(dir, shas[3], shas[1])
+	assert.Check(t, cmp.ErrorIs(err, ErrNotAncestor))
+
+	_, err = CommitRangeIn(dir, shas[2], shas[2])
+	assert.Check(t, cmp.ErrorIs(err, ErrNotAncestor))
+}
+
+func TestOriginBranchesAtIn(t *testing.T) {
+	origin := t.TempDir()
+	shas := commitChain(t, origin, 2)
+	repo, err := git.PlainOpen(origin)
+	assert.NilError(t, err)
+	assert.NilError(t, repo.Storer.SetReference(plumbing.NewHashReference(
+		"refs/heads/feature", plumbing.NewHash(shas[0]))))
+	assert.NilError(t, repo.Storer.SetReference(plumbing.NewHashReference(
+		"refs/heads/release", plumbing.NewHash(shas[1]))))
+	assert.NilError(t, repo.Close())
+
+	// The clone's fetched refs are stale: origin/feature is where origin's
+	// release branch is now. Only what origin reports counts.
+	dir := t.TempDir()
+	clone, err := git.PlainInit(dir, false)
+	assert.NilError(t, err)
+	_, err = clone.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{origin}})
+	assert.NilError(t, err)
+	assert.NilError(t, clone.Storer.SetReference(plumbing.NewHashReference(
+		"refs/remotes/origin/feature", plumbing.NewHash(shas[1]))))
+	assert.NilError(t, clone.Close())
+
+	branches, err := OriginBranchesAtIn(context.Background(), dir, shas[0])
+	assert.NilError(t, err)
+	assert.Check(t, cmp.DeepEqual(branches, []string{"feature"}))
+
+	branches, err = OriginBranchesAtIn(context.Background(), dir, shas[1])
+	assert.NilError(t, err)
+	assert.Check(t, cmp.Contains(branches, "release"))
+	assert.Check(t, !slices.Contains(branches, "feature"))
+
+	t.Run("an unreachable origin is an error", func(t *testing.T) {
+		_, err := OriginBranchesAtIn(context.Background(), t.TempDir(), shas[0])
+		assert.Check(t, cmp.ErrorContains(err, "git ls-remote origin"))
+	})
+}
+
+func TestPullRequestHeadIn(t *testing.T) {
+	dir := t.TempDir()
+	shas := commitChain(t, dir, 3)
+
+	repo, err := git.PlainOpen(dir)
+	assert.NilError(t, err)
+	for name, sha := range map[string]string{
+		"refs/remotes/origin/pr/7":          shas[0],
+		"refs/remotes/upstream/pull/8/head": shas[1],
+		"refs/pull/9/head":                  shas[2],
+		"refs/remotes/origin/pr/70":         shas[2],
+	} {
+		assert.NilError(t, repo.Storer.SetReference(plumbing.NewHashReference(
+			plumbing.ReferenceName(name), plumbing.NewHash(sha))))
+	}
+	assert.NilError(t, repo.Close())
+
+	for n, want := range map[int]string{7: shas[0], 8: shas[1], 9: shas[2]} {
+		c, err := PullRequestHeadIn(dir, n)
+		assert.NilError(t, err)
+		assert.Check(t, cmp.Equal(c.SHA, want), "pull request #%d", n)
+	}
+
+	_, err = PullRequestHeadIn(dir, 10)
+	assert.Check(t, cmp.ErrorIs(err, ErrPullRequestNotFetched))
+}
@@ -129,6 +129,13 @@ func CopyDecoder(w io.Writer) func(*Request) {
 	}
 }
 
+// ReaderDecoder hands a 2xx response body to fn unread, for callers that
+// decide where it goes from the response itself: headers captured with
+// CaptureHeader are already filled in when fn runs.
+func ReaderDecoder(fn func(io.Reader) error) func(*Request) {
+	return func(r *Request) { r.decoder = fn }
+}
+
 // BytesDecoder decodes a 2xx response body as JSON into v.
 func BytesDecoder(resp *[]byte) func(*Request) {
 	return func(r *Request) {
@@ -25,7 +25,9 @@ package fakes
 
 import (
 	"bytes"
+	"crypto/md5" //#nosec:G501 // artifact ETags, see handleStaticFile
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -59,17 +61,20 @@ type CircleCI struct {
 
 	mu                                sync.RWMutex
 	pipelines                         map[string]PipelineV2
-	projects                          map[string][]PipelineV2 // project slug → ordered pipelines
-	jobArtifacts                      map[string][]any        // "slug/jobNumber" → artifacts
-	jobArtifactsV3                    map[string][]Artifact   // job UUID → V3 artifacts
-	staticFiles                       map[string]string       // path → body content, for artifact downloads
-	triggerResponses                  map[string]any          // project slug → trigger response body
-	triggerPipelineRunResponses       map[string]any          // project slug → trigger run response body
-	triggerPipelineRunStatuses        map[string]int          // project slug → HTTP status (default 201)
-	pipelineDefinitions               map[string][]any        // projectID → list of v3 pipeline entities
-	createPipelineDefinitionResponses map[string]any          // projectID → v3 pipeline entity
-	createTriggerResponses            map[string]any          // "projectID/pipelineID" → v3 trigger entity
-	listTriggerResponses              map[string][]any        // "projectID/pipelineID" → list of v3 trigger entities
+	projects                          map[string][]PipelineV2   // project slug → ordered pipelines
+	jobArtifacts                      map[string][]any          // "slug/jobNumber" → artifacts
+	jobArtifactsV3                    map[string][]Artifact     // job UUID → V3 artifacts
+	staticFiles                       map[string]string         // path → body content, for artifact downloads
+	staticFileHEADStatus              int                       // when set, HEAD on a static file returns it
+	triggerResponses                  map[string]any            // project slug → trigger response body
+	triggerPipelineRunResponses       map[string]any            // project slug → trigger run response body
+	triggerPipelineRunStatuses        map[string]int            // project slug → HTTP status (default 201)
+	triggerPipelineRunBodies          map[string]map[string]any // project slug → last request body
+	triggeredRunsV3                   map[string][]triggeredRun // project slug → runs the next trigger creates
+	pipelineDefinitions               map[string][]any          // projectID → list of v3 pipeline entities
+	createPipelineDefinitionResponses map[string]any            // projectID → v3 pipeline entity
+	createTriggerResponses            map[string]any            // "projectID/pipelineID" → v3 trigger entity
+	listTriggerResponses              map[string][]any          // "projectID/pipelineID" → list of v3 trigger entities
 
 	// GitHub App state.
 	providerConnections     map[string][]string       // orgID → connected providers
@@ -88,8 +93,17 @@ type CircleCI struct {
 	jobStderr          map[string][]byte        // "jobID/index/stepNum" → plain text stderr
 	jobStdoutCondensed map[string][]byte        // "jobID/index/stepNum" → raw condensed text
 	jobTests           map[string][]TestResult  // job UUID → test result objects (served as JSONL)
+	jobApprovals       map[string]int           // "workflowID/jobID" → HTTP status to return
+	jobCancels         map[string]int           // job UUID → HTTP status to return
 	jobResourceUsage   map[string]ResourceUsage // job UUID → sampled CPU/memory usage
 
+	// Insights (v2) state.
+	insights map[string]*insightsProject // project slug → registered metrics
+
+	// Usage export (v2) state.
+	usageExports    map[string]UsageExport     // org UUID → config for new export jobs
+	usageExportJobs map[string]*usageExportJob // job UUID → created job
+
 	// Run (v3) state.
 	runsV3          map[string]RunV3   // run UUID → stored run
 	runsV3ByProject map[string][]RunV3 // project UUID → ordered runs (for search)
@@ -251,6 +265,8 @@ func NewCircleCI(t *testing.T, tokens ...string) *CircleCI {
 		triggerResponses:                  map[string]any{},
 		triggerPipelineRunResponses:       map[string]any{},
 		triggerPipelineRunStatuses:        map[string]int{},
+		triggerPipelineRunBodies:          map[string]map[string]any{},
+		triggeredRunsV3:                   map[string][]triggeredRun{},
 		pipelineDefinitions:               map[string][]any{},
 		createPipelineDefinitionResponses: map[string]any{},
 		createTriggerResponses:            map[string]any{},
@@ -261,6 +277,8 @@ func NewCircleCI(t *testing.T, tokens ...string) *CircleCI {
 		rerunNewIDs:                       map[string]string{},
 		rerunFromFailed:                   map[string]bool{},
 		cancelResponses:                   map[string]int{},
+		jobApprovals:                      map[string]int{},
+		jobCancels:                        map[string]int{},
 		pipelineCancelResponses:           map[string]int{},
 		jobsV3:                            map[string]JobV3{},
 		workflowJobsV3:                    map[string][]JobV3{},
@@ -269,6 +287,9 @@ func NewCircleCI(t *testing.T, tokens ...string) *CircleCI {
 		jobStdoutCondensed:                map[string][]byte{},
 		jobTests:                          map[string][]TestResult{},
 		jobResourceUsage:                  map[string]ResourceUsage{},
+		insights:                          map[string]*insightsProject{},
+		usageExports:                      map[string]UsageExport{},
+		usageExportJobs:                   map[string]*usageExportJob{},
 		runsV3:                            map[string]RunV3{},
 		runsV3ByProject:                   map[string][]RunV3{},
 		workflowsV3:                       map[string]WorkflowV3{},
@@ -342,6 +363,8 @@ func NewCircleCI(t *testing.T, tokens ...string) *CircleCI {
 	r.Post("/api/v2/pipeline/{id}/cancel", f.handleCancelPipeline)
 	r.Post("/api/v3/workflows/{id}/rerun", f.handleRerunWorkflow)
 	r.Post("/api/v3/workflows/{id}/cancel", f.handleCancelWorkflow)
+	r.Post("/api/v2/workflow/{id}/approve/{jobID}", f.handleApproveJob)
+	r.Post("/api/v2/jobs/{id}/cancel", f.handleCancelJob)
 	r.Get("/api/v2/project/{vcs}/{org}/{repo}/pipeline", f.handleListProjectPipelines)
 	r.Get("/api/v2/project/{vcs}/{org}/{repo}/pipeline/{number}", f.handleGetPipelineByNumber)
 	r.Get("/api/v2/project/{vcs}/{org}/{repo}/{jobNumber}/artifacts", f.handleGetJobArtifacts)
@@ -420,6 +443,15 @@ func NewCircleCI(t *testing.T, tokens ...string) *CircleCI {
 	r.Get("/api/v3/jobs/{id}/stderr", f.handleGetJobStderr)
 	r.Get("/api/v3/jobs/{id}/tests", f.handleGetJobTests)
 	r.Get("/api/v3/jobs/{id}/resource-usage", f.handleGetJobResourceUsage)
+	// Insights (v2) routes.
+	r.Get("/api/v2/insights/{vcs}/{org}/{repo}/workflows", f.handleListWorkflowInsights)
+	r.Get("/api/v2/insights/{vcs}/{org}/{repo}/workflows/{workflow}", f.handleListWorkflowRunInsights)
+	r.Get("/api/v2/insights/{vcs}/{org}/{repo}/workflows/{workflow}/jobs", f.handleListJobInsights)
+	r.Get("/api/v2/insights/time-series/{vcs}/{org}/{repo}/workflows/{workflow}/jobs", f.handleListJobInsightsSeries)
+	r.Get("/api/v2/insights/{vcs}/{org}/{repo}/branches", f.handleListInsightsBranches)
+	r.Get("/api/v2/insights/{vcs}/{org}/{repo}/flaky-tests", f.handleGetFlakyTests)
+	r.Post("/api/v2/organizations/{orgID}/usage_export_job", f.handleCreateUsageExport)
+	r.Get("/api/v2/organizations/{orgID}/usage_export_job/{id}", f.handleGetUsageExport)
 	// Workflow (v3) routes.
 	r.Get("/api/v3/workflows/{id}", f.handleGetWorkflowV3ByID)
 	r.Get("/api/v3/workflows", f.handleGetWorkflowsV3)
@@ -469,6 +501,9 @@ func NewCircleCI(t *testing.T, tokens ...string) *CircleCI {
 	r.Delete("/api/v3/projects/{projectID}/dlc", f.handleDLCPurge)
 	// Wildcard route for artifact downloads — populated via AddStaticFile before requests.
 	r.Get("/artifacts/*", f.handleStaticFile)
+	r.Head("/artifacts/*", f.handleStaticFile)
+	// Usage export files, standing in for presigned storage URLs.
+	r.Get(usageExportPrefix+"*", f.handleUsageExportFile)
 	// GraphQL endpoint — dispatches by operation within the request body.
 	r.Post("/graphql-unstable", f.handleGraphQL)
 
@@ -529,10 +564,11 @@ func (f *CircleCI) authMiddleware(next http.Handler) http.Handler {
 
 // authExempt reports whether a path is reachable without authentication. These
 // are the routes the CLI legitimately calls with no (or not-yet-issued) token:
-// the OAuth login flow, signed artifact downloads, the optional-auth config
-// compile endpoint, and the public tool-releases feed.
+// the OAuth login flow, signed artifact and usage export downloads, the
+// optional-auth config compile endpoint, and the public tool-releases feed.
 func authExempt(path string) bool {
-	if strings.HasPrefix(path, "/oauth/") || strings.HasPrefix(path, "/artifacts/") {
+	if strings.HasPrefix(path, "/oauth/") || strings.HasPrefix(path, "/artifacts/") ||
+		strings.HasPrefix(path, usageExportPrefix) {
 		return true
 	}
 	switch path {
@@ -604,6 +640,23 @@ func (f *CircleCI) SetCancelResponse(workflowID string, status int) {
 	f.cancelResponses[workflowID] = status
 }
 
+// SetJobApproveResponse sets the HTTP status code returned for
+// POST /api/v2/workflow/<workflowID>/approve/<jobID>. Use http.StatusAccepted (202)
+// for success; an unregistered pair gets 404.
+func (f *CircleCI) SetJobApproveResponse(workflowID, jobID string, status int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.jobApprovals[workflowID+"/"+jobID] = status
+}
+
+// SetJobCancelResponse sets the HTTP status code returned for POST /api/v2/jobs/<id>/cancel.
+// Use http.StatusAccepted (202) for success.
+func (f *CircleCI) SetJobCancelResponse(jobID string, status int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.jobCancels[jobID] = status
+}
+
 // SetDLCPurgeStatus sets the HTTP status returned for DELETE /private/output/project/{id}/dlc.
 // Default is 204 (success). Use 410 to simulate the gone/deprecated response.
 func (f *CircleCI) SetDLCPurgeStatus(projectID string, status int) {
@@ -765,6 +818,7 @@ type JobV3 struct {
 	PipelineID string
 	UserID     string
 	Executions [][]JobStep // parallel_executions, one inner slice of steps per execution
+	Requires   []string    // dependencies, the IDs of the jobs this job requires
 }
 
 // JobStep is a single step within a JobV3 execution. ExitCode is a pointer so a
@@ -802,6 +856,9 @@ func jobV3Entity(j JobV3) map[string]any {
 	if j.EndedAt != "" {
 		attrs["ended_at"] = j.EndedAt
 	}
+	if len(j.Requires) > 0 {
+		attrs["dependencies"] = j.Requires
+	}
 	if len(j.Executions) > 0 {
 		execs := make([]any, 0, len(j.Executions))
 		for _, steps := range j.Executions {
@@ -827,8 +884,8 @@ func jobV3Entity(j JobV3) map[string]any {
 	return map[string]any{"id": j.ID, "attributes": attrs, "references": refs}
 }
 
-// jobStepEntity renders a single JobStep, omitting exit_code when unset and
-// command when empty.
+// jobStepEntity renders a single JobStep, omitting exit_code when unset,
+// command when empty, and ended_at for a step that is still running.
 func jobStepEntity(s JobStep) map[string]any {
 	step := map[string]any{
 		"name":       s.Name,
@@ -837,7 +894,9 @@ func jobStepEntity(s JobStep) map[string]any {
 		"phase":      s.Phase,
 		"outcome":    s.Outcome,
 		"started_at": s.StartedAt,
-		"ended_at":   s.EndedAt,
+	}
+	if s.EndedAt != "" {
+		step["ended_at"] = s.EndedAt
 	}
 	if s.ExitCode != nil {
 		step["exit_code"] = *s.ExitCode
@@ -936,6 +995,29 @@ func (f *CircleCI) SetTriggerPipelineRunResponse(slug string, resp any) {
 	f.triggerPipelineRunStatuses[slug] = http.StatusCreated
 }
 
+// triggeredRun is a run AddRunV3OnTrigger holds back until it is triggered.
+type triggeredRun struct {
+	id, projectID string
+	run           RunV3
+}
+
+// AddRunV3OnTrigger registers a run as AddRunV3 does, but only once POST
+// /api/v2/project/<slug>/pipeline/run is called, so it cannot be found before
+// it is triggered.
+func (f *CircleCI) AddRunV3OnTrigger(slug, id, projectID string, run RunV3) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.triggeredRunsV3[slug] = append(f.triggeredRunsV3[slug], triggeredRun{id: id, projectID: projectID, run: run})
+}
+
+// LastTriggerPipelineRunBody returns the decoded body of the most recent
+// POST /api/v2/project/<slug>/pipeline/run, or nil if none has been received.
+func (f *CircleCI) LastTriggerPipelineRunBody(slug string) map[string]any {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.triggerPipelineRunBodies[slug]
+}
+
 // SetTriggerPipelineRunSkipped registers a "not triggered" response (200) for
 // POST /api/v2/project/<slug>/pipeline/run.
 func (f *CircleCI) SetTriggerPipelineRunSkipped(slug, message string) {
@@ -954,18 +1036,37 @@ func (f *CircleCI) AddStaticFile(path, content string) {
 	f.staticFiles[path] = content
 }
 
+// SetStaticFileHEADStatus makes HEAD on every static file return status, as
+// storage behind URLs signed for GET alone does.
+func (f *CircleCI) SetStaticFileHEADStatus(status int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.staticFileHEADStatus = status
+}
+
 func (f *CircleCI) handleStaticFile(w http.ResponseWriter, r *http.Request) {
 	path := r.URL.Path
 	f.mu.RLock()
 	content, ok := f.staticFiles[path]
+	headStatus := f.staticFileHEADStatus
 	f.mu.RUnlock()
 
+	if r.Method == http.MethodHead && headStatus != 0 {
+		w.WriteHeader(headStatus)
+		return
+	}
+
 	if !ok {
 		render.Status(r, http.StatusNotFound)
 		render.JSON(w, r, map[string]any{"message": "not found"})
 		return
 	}
-	render.PlainText(w, r, content)
+	// Serve it as an artifact store would: an MD5 ETag, and HEAD, Range and
+	// If-Range all honoured, so downloads can skip and resume.
+	sum := md5.Sum([]byte(content)) //#nosec:G401 // the ETag scheme artifact stores use, not a security boundary
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	http.ServeContent(w, r, path, time.Time{}, strings.NewReader(content))
 }
 
 func (f *CircleCI) handleGetPipeline(w http.ResponseWriter, r *http.Request) {
@@ -1068,10 +1169,23 @@ func (f *CircleCI) handleTriggerPipeline(w http.ResponseWriter, r *http.Request)
 
 func (f *CircleCI) handleTriggerPipelineRun(w http.ResponseWriter, r *http.Request) {
 	slug := chi.URLParam(r, "vcs") + "/" + chi.URLParam(r, "org") + "/" + chi.URLParam(r, "repo")
-	f.mu.RLock()
+	var body map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, map[string]any{"message": "bad request"})
+		return
+	}
+
+	f.mu.Lock()
+	f.triggerPipelineRunBodies[slug] = body
 	resp, ok := f.triggerPipelineRunResponses[slug]
 	status := f.triggerPipelineRunStatuses[slug]
-	f.mu.RUnlock()
+	for _, t := range f.triggeredRunsV3[slug] {
+		f.runsV3[t.id] = t.run
+		f.runsV3ByProject[t.projectID] = append(f.runsV3ByProject[t.projectID], t.run)
+	}
+	delete(f.triggeredRunsV3, slug)
+	f.mu.Unlock()
 
 	if !ok {
 		render.Status(r, http.StatusNotFound)
@@ -1419,6 +1533,9 @@ type RunV3 struct {
 	Revision       string
 	OriginRepoURL  string
 	Errors         []RunError
+	// TriggerType is the trigger's event source type; empty renders "webhook".
+	TriggerType          string
+	PipelineDefinitionID string
 }
 
 // RunError is a config/setup error attached to a run, surfaced by run get.
@@ -1479,15 +1596,23 @@ func runV3Entity(run RunV3) map[string]any {
 			"author":  map[string]any{"name": "Ada Lovelace", "login": "ada"},
 		}
 	}
+	triggerType := run.TriggerType
+	if triggerType == "" {
+		triggerType = "webhook"
+	}
+	refs := map[string]any{
+		"event":   map[string]any{"attributes": map[string]any{"vcs": vcs}},
+		"trigger": map[string]any{"attributes": map[string]any{"event_source": map[string]any{"type": triggerType}}},
+		"project": map[string]any{"id": run.ProjectID},
+		"user":    map[string]any{"id": run.UserID},
+	}
+	if run.PipelineDefinitionID != "" {
+		refs["pipeline_definition"] = map[string]any{"id": run.PipelineDefinitionID}
+	}
 	return map[string]any{
 		"id":         run.ID,
 		"attributes": attrs,
-		"references": map[string]any{
-			"event":   map[string]any{"attributes": map[string]any{"vcs": vcs}},
-			"trigger": map[string]any{"attributes": map[string]any{"event_source": map[string]any{"type": "webhook"}}},
-			"project": map[string]any{"id": run.ProjectID},
-			"user":    map[string]any{"id": run.UserID},
-		},
+		"references": refs,
 	}
 }
 
@@ -1569,6 +1694,7 @@ func (f *CircleCI) handleSearchRunsV3(w http.ResponseWriter, r *http.Request) {
 
 	branch := runBranchFilter(body.Filter)
 	status := runStatusFilterExpr(body.Filter)
+	revision := runFilterValue(body.Filter, "pipeline.git.revision")
 
 	f.mu.RLock()
 	var all []any
@@ -1580,6 +1706,10 @@ func (f *CircleCI) handleSearchRunsV3(w http.ResponseWriter, r *http.Request) {
 			if status != "" && runStatus(run) != status {
 				continue
 			}
+			// Exact match, as the real search API compares revisions.
+			if revision != "" && run.Revision != revision {
+				continue
+			}
 			all = append(all, runV3Entity(run))
 		}
 	}
@@ -1610,24 +1740,21 @@ func (f *CircleCI) handleSearchRunsV3(w http.ResponseWriter, r *http.Request) {
 // like `pipeline.git.branch == "main"`. It returns "" when no branch is pinned,
 // meaning "match every branch".
 func runBranchFilter(filter string) string {
-	const key = `pipeline.git.branch == "`
-	i := strings.Index(filter, key)
-	if i < 0 {
-		return ""
-	}
-	rest := filter[i+len(key):]
-	j := strings.Index(rest, `"`)
-	if j < 0 {
-		return ""
-	}
-	return rest[:j]
+	return runFilterValue(filter, "pipeline.git.branch")
 }
 
 // runStatusFilterExpr extracts the pipeline status pinned by a V3 search filter
 // expression like `pipeline.status == "failed"`. It returns "" when no status is
 // pinned, meaning "match every status".
 func runStatusFilterExpr(filter string) string {
-	const key = `pipeline.status == "`
+	return runFilterValue(filter, "pipeline.status")
+}
+
+// runFilterValue extracts the quoted value a V3 search filter expression pins
+// field to, e.g. "abc123" from `pipeline.git.revision == "abc123"`, or "" when
+// the field is not pinned.
+func runFilterValue(filter, field string) string {
+	key := field + ` == "`
 	i := strings.Index(filter, key)
 	if i < 0 {
 		return ""
@@ -1711,6 +1838,36 @@ func (f *CircleCI) handleCancelWorkflow(w http.ResponseWriter, r *http.Request)
 	render.JSON(w, r, map[string]any{"data": map[string]any{"id": id}})
 }
 
+func (f *CircleCI) handleApproveJob(w http.ResponseWriter, r *http.Request) {
+	key := chi.URLParam(r, "id") + "/" + chi.URLParam(r, "jobID")
+	f.mu.RLock()
+	status, ok := f.jobApprovals[key]
+	f.mu.RUnlock()
+
+	if !ok {
+		render.Status(r, http.StatusNotFound)
+		render.JSON(w, r, map[string]any{"message": "not found"})
+		return
+	}
+	render.Status(r, status)
+	render.JSON(w, r, map[string]any{"message": "Accepted."})
+}
+
+func (f *CircleCI) handleCancelJob(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	f.mu.RLock()
+	status, ok := f.jobCancels[id]
+	f.mu.RUnlock()
+
+	if !ok {
+		render.Status(r, http.StatusNotFound)
+		render.JSON(w, r, map[string]any{"message": "not found"})
+		return
+	}
+	render.Status(r, status)
+	render.JSON(w, r, map[string]any{"message": "Accepted."})
+}
+
 // --- Runner helpers ---
 
 // ResourceClass is a stored runner resource class served by the runner
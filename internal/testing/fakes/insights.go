@@ -0,0 +1,353 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fakes
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// InsightsAllBranches is the branch key a summary is registered under to be
+// served for ?all-branches=true.
+const InsightsAllBranches = "*"
+
+// insightsPageSize is how many items the fake's paginated Insights endpoints
+// serve a page, small enough that a test can exercise paging.
+const insightsPageSize = 20
+
+// InsightsSummary is a workflow's or job's metrics as the Insights summary
+// endpoints report them. Durations are in seconds.
+type InsightsSummary struct {
+	Name        string
+	SuccessRate float64
+	TotalRuns   int
+	FailedRuns  int
+	Throughput  float64
+	MTTR        int64
+	CreditsUsed int64
+	P50         int64
+	P95         int64
+}
+
+// InsightsRun is one run of a workflow, served by the run history endpoint.
+// Duration is in seconds.
+type InsightsRun struct {
+	ID        string
+	Branch    string
+	Status    string
+	Duration  int64
+	CreatedAt time.Time
+}
+
+// InsightsJobBucket is one job's median duration, in seconds, over one bucket
+// of a time series.
+type InsightsJobBucket struct {
+	Name      string
+	Timestamp time.Time
+	TotalRuns int
+	Median    int64
+}
+
+// FlakyTest is a test registered as flaky for a project.
+type FlakyTest struct {
+	TestName     string
+	Classname    string
+	JobName      string
+	WorkflowName string
+	TimesFlaked  int
+	TimeWasted   int64
+}
+
+// insightsProject is everything registered for one project's Insights.
+type insightsProject struct {
+	workflows map[string][]InsightsSummary   // branch key → workflow summaries
+	jobs      map[string][]InsightsSummary   // "workflow@branch key" → job summaries
+	runs      map[string][]InsightsRun       // workflow → runs, newest first
+	series    map[string][]InsightsJobBucket // workflow → job time series
+	branches  map[string][]string            // workflow → branches it ran on
+	flaky     []FlakyTest
+}
+
+// insightsFor returns the registered Insights for slug, creating them. The
+// caller holds f.mu.
+func (f *CircleCI) insightsFor(slug string) *insightsProject {
+	p, ok := f.insights[slug]
+	if !ok {
+		p = &insightsProject{
+			workflows: map[string][]InsightsSummary{},
+			jobs:      map[string][]InsightsSummary{},
+			runs:      map[string][]InsightsRun{},
+			series:    map[string][]InsightsJobBucket{},
+			branches:  map[string][]string{},
+		}
+		f.insights[slug] = p
+	}
+	return p
+}
+
+// AddWorkflowInsights registers the workflow summaries served by
+// GET /api/v2/insights/<slug>/workflows. branch is the ?branch= they answer:
+// "" for the default branch, or InsightsAllBranches for ?all-branches=true.
+// A project with no Insights registered at all returns 404.
+func (f *CircleCI) AddWorkflowInsights(slug, branch string, items ...InsightsSummary) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p := f.insightsFor(slug)
+	p.workflows[branch] = append(p.workflows[branch], items...)
+}
+
+// AddJobInsights registers the job summaries served by
+// GET /api/v2/insights/<slug>/workflows/<workflow>/jobs, keyed on branch as
+// AddWorkflowInsights is. An unregistered workflow returns 404.
+func (f *CircleCI) AddJobInsights(slug, workflow, branch string, items ...InsightsSummary) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p := f.insightsFor(slug)
+	p.jobs[workflow+"@"+branch] = append(p.jobs[workflow+"@"+branch], items...)
+}
+
+// AddWorkflowRunInsights registers a workflow's runs, newest first, served by
+// GET /api/v2/insights/<slug>/workflows/<workflow>. The fake filters on
+// ?branch= alone: without it, every run is listed.
+func (f *CircleCI) AddWorkflowRunInsights(slug, workflow string, runs ...InsightsRun) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p := f.insightsFor(slug)
+	p.runs[workflow] = append(p.runs[workflow], runs...)
+}
+
+// AddJobInsightsSeries registers the buckets served by
+// GET /api/v2/insights/time-series/<slug>/workflows/<workflow>/jobs.
+func (f *CircleCI) AddJobInsightsSeries(slug, workflow string, buckets ...InsightsJobBucket) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p := f.insightsFor(slug)
+	p.series[workflow] = append(p.series[workflow], buckets...)
+}
+
+// SetInsightsBranches registers the branches served by
+// GET /api/v2/insights/<slug>/branches?workflow-name=<workflow>.
+func (f *CircleCI) SetInsightsBranches(slug, workflow string, branches ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.insightsFor(slug).branches[workflow] = branches
+}
+
+// AddFlakyTests registers the tests served by GET /api/v2/insights/<slug>/flaky-tests.
+func (f *CircleCI) AddFlakyTests(slug string, tests ...FlakyTest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p := f.insightsFor(slug)
+	p.flaky = append(p.flaky, tests...)
+}
+
+// insightsProjectFor looks up the project a request's slug names, answering
+// 404 itself when none is registered.
+func (f *CircleCI) insightsProjectFor(w http.ResponseWriter, r *http.Request) (*insightsProject, bool) {
+	slug := chi.URLParam(r, "vcs") + "/" + chi.URLParam(r, "org") + "/" + chi.URLParam(r, "repo")
+	f.mu.RLock()
+	p, ok := f.insights[slug]
+	f.mu.RUnlock()
+	if !ok {
+		insightsNotFound(w, r)
+	}
+	return p, ok
+}
+
+func insightsNotFound(w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusNotFound)
+	render.JSON(w, r, map[string]any{"message": "Project not found"})
+}
+
+// insightsBranchKey is the branch key a summary request asks for.
+func insightsBranchKey(r *http.Request) string {
+	if r.URL.Query().Get("all-branches") == "true" {
+		return InsightsAllBranches
+	}
+	return r.URL.Query().Get("branch")
+}
+
+// renderInsightsPage serves one page of items, the page token being the
+// offset of the first item on it.
+func renderInsightsPage[T any](w http.ResponseWriter, r *http.Request, items []T, convert func(T) map[string]any) {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("page-token"))
+	offset = min(max(offset, 0), len(items))
+	end := min(offset+insightsPageSize, len(items))
+
+	page := make([]map[string]any, 0, end-offset)
+	for _, item := range items[offset:end] {
+		page = append(page, convert(item))
+	}
+	var next any
+	if end < len(items) {
+		next = strconv.Itoa(end)
+	}
+	render.JSON(w, r, map[string]any{"items": page, "next_page_token": next})
+}
+
+func insightsSummaryEntity(s InsightsSummary) map[string]any {
+	return map[string]any{
+		"name": s.Name,
+		"metrics": map[string]any{
+			"success_rate":       s.SuccessRate,
+			"total_runs":         s.TotalRuns,
+			"failed_runs":        s.FailedRuns,
+			"successful_runs":    s.TotalRuns - s.FailedRuns,
+			"throughput":         s.Throughput,
+			"mttr":               s.MTTR,
+			"total_credits_used": s.CreditsUsed,
+			"duration_metrics": map[string]any{
+				"min":                0,
+				"mean":               s.P50,
+				"median":             s.P50,
+				"p95":                s.P95,
+				"max":                s.P95,
+				"standard_deviation": 0,
+			},
+		},
+		"window_start": "2026-09-19T00:00:00Z",
+		"window_end":   "2026-10-19T00:00:00Z",
+	}
+}
+
+func (f *CircleCI) handleListWorkflowInsights(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.insightsProjectFor(w, r)
+	if !ok {
+		return
+	}
+	f.mu.RLock()
+	items := p.workflows[insightsBranchKey(r)]
+	f.mu.RUnlock()
+	renderInsightsPage(w, r, items, insightsSummaryEntity)
+}
+
+func (f *CircleCI) handleListJobInsights(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.insightsProjectFor(w, r)
+	if !ok {
+		return
+	}
+	workflow := chi.URLParam(r, "workflow")
+	f.mu.RLock()
+	items, found := p.jobs[workflow+"@"+insightsBranchKey(r)]
+	f.mu.RUnlock()
+	if !found {
+		render.Status(r, http.StatusNotFound)
+		render.JSON(w, r, map[string]any{"message": "Workflow not found"})
+		return
+	}
+	renderInsightsPage(w, r, items, insightsSummaryEntity)
+}
+
+func (f *CircleCI) handleListWorkflowRunInsights(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.insightsProjectFor(w, r)
+	if !ok {
+		return
+	}
+	branch := r.URL.Query().Get("branch")
+	f.mu.RLock()
+	var runs []InsightsRun
+	for _, run := range p.runs[chi.URLParam(r, "workflow")] {
+		if branch == "" || run.Branch == branch {
+			runs = append(runs, run)
+		}
+	}
+	f.mu.RUnlock()
+	renderInsightsPage(w, r, runs, func(run InsightsRun) map[string]any {
+		return map[string]any{
+			"id":           run.ID,
+			"branch":       run.Branch,
+			"status":       run.Status,
+			"duration":     run.Duration,
+			"credits_used": 0,
+			"is_approval":  false,
+			"created_at":   run.CreatedAt,
+			"stopped_at":   run.CreatedAt.Add(time.Duration(run.Duration) * time.Second),
+		}
+	})
+}
+
+func (f *CircleCI) handleListJobInsightsSeries(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.insightsProjectFor(w, r)
+	if !ok {
+		return
+	}
+	f.mu.RLock()
+	buckets := p.series[chi.URLParam(r, "workflow")]
+	f.mu.RUnlock()
+	renderInsightsPage(w, r, buckets, func(b InsightsJobBucket) map[string]any {
+		return map[string]any{
+			"name":      b.Name,
+			"timestamp": b.Timestamp,
+			"metrics": map[string]any{
+				"total_runs":      b.TotalRuns,
+				"successful_runs": b.TotalRuns,
+				"duration_metrics": map[string]any{
+					"min":    b.Median,
+					"median": b.Median,
+					"max":    b.Median,
+					"p95":    b.Median,
+					"total":  b.Median * int64(b.TotalRuns),
+				},
+			},
+		}
+	})
+}
+
+func (f *CircleCI) handleListInsightsBranches(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.insightsProjectFor(w, r)
+	if !ok {
+		return
+	}
+	f.mu.RLock()
+	branches := p.branches[r.URL.Query().Get("workflow-name")]
+	f.mu.RUnlock()
+	if branches == nil {
+		branches = []string{}
+	}
+	render.JSON(w, r, map[string]any{"org_id": "", "branches": branches})
+}
+
+func (f *CircleCI) handleGetFlakyTests(w http.ResponseWriter, r *http.Request) {
+	p, ok := f.insightsProjectFor(w, r)
+	if !ok {
+		return
+	}
+	f.mu.RLock()
+	tests := make([]map[string]any, len(p.flaky))
+	for i, t := range p.flaky {
+		tests[i] = map[string]any{
+			"test_name":     t.TestName,
+			"classname":     t.Classname,
+			"job_name":      t.JobName,
+			"workflow_name": t.WorkflowName,
+			"times_flaked":  t.TimesFlaked,
+			"time_wasted":   t.TimeWasted,
+		}
+	}
+	f.mu.RUnlock()
+	render.JSON(w, r, map[string]any{"flaky_tests": tests, "total_flaky_tests": len(tests)})
+}
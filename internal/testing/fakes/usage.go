@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fakes
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+)
+
+// usageExportPrefix is where the fake serves usage export files. It stands in
+// for the presigned storage URLs the real API hands out, so it is reachable
+// without a token.
+const usageExportPrefix = "/usage-exports/"
+
+// UsageExport configures the export jobs the fake creates for an org.
+type UsageExport struct {
+	// Files maps a file name to its CSV content. Each is served gzipped
+	// as <name>.csv.gz.
+	Files map[string]string
+	// Polls is how many times a job reports "processing" before it is done.
+	Polls int
+	// FailureReason, when set, makes jobs fail with it rather than complete.
+	FailureReason string
+}
+
+type usageExportJob struct {
+	orgID string
+	polls int
+	UsageExport
+}
+
+// SetUsageExport configures the export jobs created for orgID. The org must
+// also be registered with AddOrg.
+func (f *CircleCI) SetUsageExport(orgID string, e UsageExport) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.usageExports[orgID] = e
+}
+
+func (f *CircleCI) handleCreateUsageExport(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	var body struct {
+		Start string `json:"start"`
+		End   string `json:"end"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Start == "" || body.End == "" {
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, map[string]any{"message": "start and end are required"})
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.orgsByUUID[orgID] {
+		render.Status(r, http.StatusNotFound)
+		render.JSON(w, r, map[string]any{"message": "Organization not found"})
+		return
+	}
+	id := uuid.New().String()
+	f.usageExportJobs[id] = &usageExportJob{orgID: orgID, UsageExport: f.usageExports[orgID]}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, map[string]any{
+		"usage_export_job_id": id,
+		"state":               "created",
+		"start":               body.Start,
+		"end":                 body.End,
+		"download_urls":       []string{},
+	})
+}
+
+func (f *CircleCI) handleGetUsageExport(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgID")
+	id := chi.URLParam(r, "id")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	job, ok := f.usageExportJobs[id]
+	if !ok || job.orgID != orgID {
+		render.Status(r, http.StatusNotFound)
+		render.JSON(w, r, map[string]any{"message": "Usage export job not found"})
+		return
+	}
+
+	resp := map[string]any{
+		"usage_export_job_id": id,
+		"state":               "processing",
+		"download_urls":       []string{},
+	}
+	switch {
+	case job.polls < job.Polls:
+		job.polls++
+	case job.FailureReason != "":
+		resp["state"] = "failed"
+		resp["failure_reason"] = job.FailureReason
+	default:
+		urls := make([]string, 0, len(job.Files))
+		for _, name := range slices.Sorted(maps.Keys(job.Files)) {
+			urls = append(urls, f.server.URL+usageExportPrefix+id+"/"+name+".csv.gz?X-Amz-Signature=fake")
+		}
+		resp["state"] = "completed"
+		resp["download_urls"] = urls
+	}
+	render.JSON(w, r, resp)
+}
+
+func (f *CircleCI) handleUsageExportFile(w http.ResponseWriter, r *http.Request) {
+	id, file, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, usageExportPrefix), "/")
+	name := strings.TrimSuffix(path.Base(file), ".csv.gz")
+
+	f.mu.RLock()
+	var content string
+	ok := false
+	if job, found := f.usageExportJobs[id]; found {
+		content, ok = job.Files[name]
+	}
+	f.mu.RUnlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, _ = zw.Write([]byte(content))
+	_ = zw.Close()
+	w.Header().Set("Content-Type", "application/gzip")
+	_, _ = w.Write(buf.Bytes())
+}
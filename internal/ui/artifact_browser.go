@@ -0,0 +1,531 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package ui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/ui/components"
+	"github.com/CircleCI-Public/circleci-cli/clikit/ui/theme"
+	"github.com/CircleCI-Public/circleci-cli/internal/termrender"
+)
+
+// artifactPreviewGap is the number of blank columns between the tree and the
+// preview pane; below minArtifactPreviewWidth the preview is dropped.
+const (
+	artifactPreviewGap      = 3
+	minArtifactPreviewWidth = 24
+)
+
+// artifactBrowserKeys is the browser's footer hint set. Enter is taken from the
+// tree's "open" for the download, so viewing a file is → alone.
+var artifactBrowserKeys = []key.Binding{
+	components.BindMove,
+	key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "view")),
+	components.BindUpLevel,
+	components.BindMark,
+	key.NewBinding(key.WithKeys("enter"), key.WithHelp("⏎", "download")),
+	components.BindSearch,
+	components.BindQuitEsc,
+}
+
+// ArtifactBrowserOptions configures an ArtifactBrowserModel. The callbacks keep
+// the program decoupled from the API client, as RunGetFlowOptions does for the
+// run-get artifact browser.
+type ArtifactBrowserOptions struct {
+	// Items are the job's artifacts. When they span more than one parallel
+	// execution the tree groups them under a per-execution directory, the same
+	// layout a download writes to disk.
+	Items []RunGetArtifactItem
+	// Dir is where downloads are written, preserving each file's browsed path.
+	Dir string
+	// FetchContent reads one artifact for the preview, reporting whether it is
+	// displayable text (the caller applies the size cap and the binary sniff).
+	FetchContent func(ctx context.Context, item RunGetArtifactItem) (data []byte, text bool, err error)
+	// Download writes the given artifacts under dir. Each item's Path is the one
+	// the browser displayed.
+	Download func(ctx context.Context, items []RunGetArtifactItem, dir string) error
+}
+
+// ArtifactBrowserResult is the outcome of a completed ArtifactBrowserModel run,
+// read via Result() after tea.Program.Run() returns. Downloaded counts the files
+// written across every download made while browsing.
+type ArtifactBrowserResult struct {
+	Downloaded int
+	Cancelled  bool
+}
+
+// artifactPreview is one file's fetched content, cached by browsed path.
+// loading marks a fetch in flight, so moving back and forth over a file does not
+// fetch it again.
+type artifactPreview struct {
+	data    []byte
+	text    bool
+	err     error
+	loading bool
+}
+
+type (
+	artifactPreviewMsg struct {
+		path    string
+		preview artifactPreview
+	}
+	artifactDownloadedMsg struct {
+		count int
+		err   error
+	}
+)
+
+// ArtifactBrowserModel is the full-screen program behind `circleci artifact
+// --browse`: the job's artifacts as a file tree on the left and a preview of the
+// highlighted file on the right — text and JSON as the first screenful, images as
+// a block mosaic. → opens a file full screen in a pager with "/" search; space
+// marks files and enter downloads the marked ones (or, with none marked, the
+// highlighted file). Downloads happen in place, so the user can keep browsing.
+type ArtifactBrowserModel struct {
+	ctx  context.Context
+	opts ArtifactBrowserOptions
+
+	tree    components.FileTreeModel
+	preview components.PreviewModel
+
+	// cache holds fetched previews keyed by browsed path. Shared by reference
+	// across the value-copied model, so a fetch landing after the cursor has
+	// moved on still warms it for later.
+	cache map[string]artifactPreview
+
+	// The full-screen pager: paging is set while it is open, and pagerImage holds
+	// an image's bytes so a resize can re-render the mosaic. openOnLoad is the
+	// path → was pressed on before its content arrived.
+	pager      components.PagerModel
+	paging     bool
+	pagerImage []byte
+	pagerLabel string
+	openOnLoad string
+
+	note        string
+	downloading bool
+
+	width  int
+	height int
+	result ArtifactBrowserResult
+}
+
+// NewArtifactBrowser returns an ArtifactBrowserModel ready to pass to
+// tea.NewProgram.
+func NewArtifactBrowser(ctx context.Context, opts ArtifactBrowserOptions) ArtifactBrowserModel {
+	multi := artifactsSpanExecutions(opts.Items)
+	entries := make([]components.FileTreeEntry, 0, len(opts.Items))
+	for _, item := range opts.Items {
+		if multi {
+			item.Path = fmt.Sprintf("exec-%04d/%s", item.Execution, item.Path)
+		}
+		entries = append(entries, components.FileTreeEntry{Path: item.Path, Ref: item})
+	}
+	return ArtifactBrowserModel{
+		ctx:  ctx,
+		opts: opts,
+		// The hint is cleared here and shown as a full-width footer below the
+		// split, as in the theme picker.
+		tree: components.NewFileTree("Artifacts", entries).
+			WithKeys().
+			WithEmptyNote(theme.HelperStyle.Render("This job produced no artifacts.")),
+		preview: components.NewPreviewModel(),
+		cache:   make(map[string]artifactPreview),
+		pager:   components.NewPager().WithKeys(stepPagerKeys...),
+	}
+}
+
+// Result returns the final outcome. Only valid after tea.Program.Run() returns.
+func (m ArtifactBrowserModel) Result() ArtifactBrowserResult { return m.result }
+
+func (m ArtifactBrowserModel) Init() tea.Cmd { return m.tree.Init() }
+
+func (m ArtifactBrowserModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		if m.paging {
+			m.syncPager()
+			return m, nil
+		}
+		return m.sync()
+
+	case artifactPreviewMsg:
+		m.cache[msg.path] = msg.preview
+		if msg.path == m.openOnLoad {
+			m.openOnLoad = ""
+			return m.openPager(msg.path)
+		}
+		return m.sync()
+
+	case artifactDownloadedMsg:
+		m.downloading = false
+		if msg.err != nil {
+			m.note = theme.ErrorStyle.Render("✗ " + msg.err.Error())
+			return m.sync()
+		}
+		m.result.Downloaded += msg.count
+		m.tree = m.tree.ClearMarks()
+		m.note = theme.SuccessStyle.Render(fmt.Sprintf("✓ Downloaded %s to %s", fileWord(msg.count), m.opts.Dir))
+		return m.sync()
+	}
+
+	if m.paging {
+		return m.updatePager(msg)
+	}
+	return m.updateTree(msg)
+}
+
+// updateTree drives the browser. Moving around, descending and the "/" filter
+// belong to the tree component; this binds the keys it leaves alone, gated on
+// the filter prompt being closed so a space typed into a pattern is text.
+func (m ArtifactBrowserModel) updateTree(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(tea.KeyPressMsg); ok {
+		// Any key moves on from a file that was waiting to open.
+		m.openOnLoad = ""
+	}
+	if k, ok := msg.(tea.KeyPressMsg); ok && !m.tree.Searching() {
+		switch {
+		case key.Matches(k, components.KeyCtrlC):
+			m.result.Cancelled = true
+			return m, tea.Quit
+		case key.Matches(k, components.BindQuit):
+			return m, tea.Quit
+		case key.Matches(k, components.KeyEsc):
+			// esc unwinds one thing at a time: the filter, then the directory, then
+			// the browser itself.
+			switch {
+			case m.tree.FilterActive():
+				m.tree = m.tree.ClearFilter()
+			case !m.tree.AtRoot():
+				m.tree = m.tree.Ascend()
+			default:
+				return m, tea.Quit
+			}
+			return m.sync()
+		case key.Matches(k, components.BindMark):
+			m.tree = m.tree.ToggleMark()
+			m.note = ""
+			return m.sync()
+		case key.Matches(k, components.KeyEnter):
+			if items := m.downloadItems(); len(items) > 0 {
+				return m.download(items)
+			}
+		}
+	}
+
+	updated, cmd := m.tree.Update(msg)
+	m.tree = updated
+	if m.tree.Done() {
+		p := m.tree.Selected().Path
+		m.tree = m.tree.ClearSelection()
+		model, open := m.openPager(p)
+		return model, tea.Batch(cmd, open)
+	}
+	model, fetch := m.sync()
+	return model, tea.Batch(cmd, fetch)
+}
+
+// downloadItems is what enter would download: the marked files, or with none
+// marked the highlighted file. A highlighted directory with nothing marked
+// yields nothing, leaving enter to descend into it.
+func (m ArtifactBrowserModel) downloadItems() []RunGetArtifactItem {
+	entries := m.tree.Marked()
+	if len(entries) == 0 && !m.tree.HighlightedIsDir() {
+		entries = m.tree.HighlightedEntries()
+	}
+	items := make([]RunGetArtifactItem, 0, len(entries))
+	for _, e := range entries {
+		if item, ok := e.Ref.(RunGetArtifactItem); ok {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// download starts writing items under the download directory. Only one download
+// runs at a time; enter while one is in flight is ignored.
+func (m ArtifactBrowserModel) download(items []RunGetArtifactItem) (tea.Model, tea.Cmd) {
+	if m.downloading || m.opts.Download == nil {
+		return m, nil
+	}
+	m.downloading = true
+	m.note = theme.HelperStyle.Render(fmt.Sprintf("Downloading %s to %s…", fileWord(len(items)), m.opts.Dir))
+	ctx, dl, dir := m.ctx, m.opts.Download, m.opts.Dir
+	model, fetch := m.sync()
+	return model, tea.Batch(fetch, func() tea.Msg {
+		return artifactDownloadedMsg{count: len(items), err: dl(ctx, items, dir)}
+	})
+}
+
+// sync lays the tree and preview out for the current size and note, and starts
+// fetching the highlighted file's content if it has not been already.
+func (m ArtifactBrowserModel) sync() (ArtifactBrowserModel, tea.Cmd) {
+	if m.width <= 0 || m.height <= 0 {
+		return m, nil
+	}
+	bodyHeight := max(m.height-1, 1) // one row for the footer
+	m.tree = m.tree.WithNote(m.noteLine()).WithHeight(bodyHeight)
+	if pw := m.previewWidth(); pw > 0 {
+		m.preview = m.preview.WithSize(pw, bodyHeight)
+	}
+
+	var cmd tea.Cmd
+	if !m.tree.HighlightedIsDir() {
+		if entries := m.tree.HighlightedEntries(); len(entries) == 1 {
+			cmd = m.fetch(entries[0])
+		}
+	}
+	m.preview = m.preview.WithContent(m.previewContent())
+	return m, cmd
+}
+
+// noteLine is the line above the rows: the last action's outcome, or the marked
+// count so it is clear what enter would download.
+func (m ArtifactBrowserModel) noteLine() string {
+	if m.note != "" {
+		return m.note
+	}
+	if n := len(m.tree.Marked()); n > 0 {
+		return theme.AccentStyle.Render(fileWord(n) + " marked")
+	}
+	return ""
+}
+
+// treeWidth is the columns given to the tree, and previewWidth the rest; the
+// preview is dropped on a terminal too narrow to show it usefully.
+func (m ArtifactBrowserModel) treeWidth() int {
+	if m.previewWidth() == 0 {
+		return m.width
+	}
+	return m.width * 2 / 5
+}
+
+func (m ArtifactBrowserModel) previewWidth() int {
+	w := m.width - m.width*2/5 - artifactPreviewGap
+	if w < minArtifactPreviewWidth {
+		return 0
+	}
+	return w
+}
+
+// fetch returns a command reading one artifact for the preview, or nil when it
+// is cached or already on its way.
+func (m ArtifactBrowserModel) fetch(e components.FileTreeEntry) tea.Cmd {
+	item, ok := e.Ref.(RunGetArtifactItem)
+	if _, seen := m.cache[e.Path]; seen || !ok || m.opts.FetchContent == nil {
+		return nil
+	}
+	m.cache[e.Path] = artifactPreview{loading: true}
+	ctx, fetch, p := m.ctx, m.opts.FetchContent, e.Path
+	return func() tea.Msg {
+		data, text, err := fetch(ctx, item)
+		return artifactPreviewMsg{path: p, preview: artifactPreview{data: data, text: text, err: err}}
+	}
+}
+
+// previewContent renders the highlighted row for the preview pane: a summary of
+// a directory, or a file's content fitted to the pane.
+func (m ArtifactBrowserModel) previewContent() string {
+	w, h := m.preview.ContentWidth(), m.preview.ContentHeight()
+	if m.tree.Empty() {
+		return ""
+	}
+	if m.tree.HighlightedIsDir() {
+		return m.directorySummary(w, h)
+	}
+	entries := m.tree.HighlightedEntries()
+	if len(entries) != 1 {
+		return ""
+	}
+	p, cached := m.cache[entries[0].Path]
+	switch {
+	case !cached || p.loading:
+		return theme.HelperStyle.Render("Loading…")
+	case p.err != nil:
+		return theme.ErrorStyle.Render("✗ " + p.err.Error())
+	}
+	if format, iw, ih, ok := components.ImageInfo(p.data); ok {
+		// One row goes to the label naming what the blocks are a rendering of.
+		out, err := components.RenderImage(p.data, w, h-1)
+		if err != nil {
+			return theme.WarningStyle.Render("Could not render this image: " + err.Error())
+		}
+		return theme.HelperStyle.Render(fmt.Sprintf("%s %d×%d", format, iw, ih)) + "\n" + out
+	}
+	if !p.text {
+		return theme.HelperStyle.Render("Not text or an image — press enter to download.")
+	}
+	lines := strings.Split(artifactText(entries[0].Path, p.data), "\n")
+	if len(lines) > h {
+		lines = lines[:h]
+	}
+	for i, line := range lines {
+		lines[i] = ansi.Truncate(line, w, "…")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// directorySummary lists what a directory row stands for: its file count and as
+// many of the files beneath it as fit.
+func (m ArtifactBrowserModel) directorySummary(w, h int) string {
+	entries := m.tree.HighlightedEntries()
+	lines := []string{theme.TitleStyle.Render(m.tree.HighlightedLabel() + "/"), fileWord(len(entries)), ""}
+	prefix := strings.TrimSuffix(strings.TrimPrefix(m.tree.Dir(), "/"), "/")
+	for i, e := range entries {
+		if len(lines) == h-1 && i < len(entries)-1 {
+			lines = append(lines, theme.HelperStyle.Render(fmt.Sprintf("… and %d more", len(entries)-i)))
+			break
+		}
+		rel := strings.TrimPrefix(e.Path, prefix+"/")
+		lines = append(lines, ansi.Truncate(rel, w, "…"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// artifactText is a text artifact as it is shown: JSON pretty-printed so a
+// minified report is readable, and everything else replayed through a terminal
+// model so progress redraws in a log collapse to what a human would have seen.
+func artifactText(p string, data []byte) string {
+	if strings.EqualFold(path.Ext(p), ".json") {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err == nil {
+			return buf.String()
+		}
+	}
+	var buf strings.Builder
+	if err := termrender.RenderStyled(&buf, bytes.NewReader(data)); err != nil {
+		return string(data)
+	}
+	return buf.String()
+}
+
+// --- pager ---
+
+// openPager shows a file full screen. A file still loading opens once its
+// content arrives; one that cannot be shown says why in the note instead.
+func (m ArtifactBrowserModel) openPager(p string) (tea.Model, tea.Cmd) {
+	cached, ok := m.cache[p]
+	if !ok || cached.loading {
+		m.openOnLoad = p
+		return m.sync()
+	}
+	format, w, h, image := components.ImageInfo(cached.data)
+	switch {
+	case cached.err != nil:
+		m.note = theme.ErrorStyle.Render("✗ " + cached.err.Error())
+		return m.sync()
+	case image:
+		m.pagerImage = cached.data
+		m.pagerLabel = fmt.Sprintf("%s %d×%d", format, w, h)
+	case !cached.text:
+		m.note = theme.WarningStyle.Render(p + " is not text or a supported image — press enter to download it")
+		return m.sync()
+	default:
+		m.pagerImage, m.pagerLabel = nil, ""
+		m.pager = m.pager.SetContent(artifactText(p, cached.data))
+	}
+	m.paging = true
+	m.pager = m.pager.ResetSearch()
+	m.syncPager()
+	m.pager = m.pager.GotoTop()
+	return m, nil
+}
+
+// syncPager sizes the pager to the terminal, re-rendering an image to fit.
+func (m *ArtifactBrowserModel) syncPager() {
+	if m.width > 0 && m.height > 0 {
+		m.pager = m.pager.SetSize(m.width, m.height)
+	}
+	if m.pagerImage == nil {
+		return
+	}
+	out, err := components.RenderImage(m.pagerImage, m.width, m.height-components.PagerFooterHeight)
+	if err != nil {
+		out = theme.WarningStyle.Render("Could not render this image: " + err.Error())
+	}
+	m.pager = m.pager.SetContent(out)
+}
+
+// updatePager drives the full-screen pager. Scrolling and the "/" search belong
+// to the pager component; esc dismisses a search or, with none, returns to the
+// tree.
+func (m ArtifactBrowserModel) updatePager(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if k, ok := msg.(tea.KeyPressMsg); ok && !m.pager.Searching() {
+		switch {
+		case key.Matches(k, components.KeyCtrlC):
+			m.result.Cancelled = true
+			return m, tea.Quit
+		case key.Matches(k, components.KeyEsc):
+			if m.pager.SearchActive() {
+				m.pager = m.pager.ClearSearch()
+				return m, nil
+			}
+			m.paging = false
+			m.pagerImage = nil
+			return m.sync()
+		}
+	}
+	var cmd tea.Cmd
+	m.pager, cmd = m.pager.Update(msg)
+	return m, cmd
+}
+
+// --- view ---
+
+func (m ArtifactBrowserModel) View() tea.View {
+	return components.WithWindowTitle(m.buildView(), components.FlowTitle("artifact"))
+}
+
+func (m ArtifactBrowserModel) buildView() tea.View {
+	if m.paging {
+		status := ""
+		if m.pagerImage != nil {
+			status = theme.AccentStyle.Render(m.pagerLabel) + "  "
+		}
+		return m.pager.View(status)
+	}
+	if m.width <= 0 {
+		return tea.NewView("")
+	}
+	tw := m.treeWidth()
+	body := lipgloss.NewStyle().Width(tw).MaxWidth(tw).Render(m.tree.View().Content)
+	if m.previewWidth() > 0 {
+		body = lipgloss.JoinHorizontal(lipgloss.Top,
+			lipgloss.NewStyle().MarginRight(artifactPreviewGap).Render(body), m.preview.View())
+	}
+	v := tea.NewView(lipgloss.JoinVertical(lipgloss.Left, body, components.Hints(artifactBrowserKeys...)))
+	v.AltScreen = true
+	return v
+}
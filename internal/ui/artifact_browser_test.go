@@ -0,0 +1,224 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package ui_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/exp/teatest/v2"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/ui"
+)
+
+// browserHarness drives an ArtifactBrowserModel the way flowHarness drives the
+// run-get flow, so the shared snapshot helpers work on it.
+type browserHarness struct {
+	m ui.ArtifactBrowserModel
+}
+
+func (h browserHarness) Init() tea.Cmd { return h.m.Init() }
+
+func (h browserHarness) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case quitMsg:
+		return h, tea.Quit
+	case snapshotMsg:
+		msg.frame <- h.m.View().Content
+		return h, nil
+	}
+	u, cmd := h.m.Update(msg)
+	h.m = u.(ui.ArtifactBrowserModel)
+	return h, cmd
+}
+
+func (h browserHarness) View() tea.View { return h.m.View() }
+
+// browserFiles maps each artifact path to its content; a path missing from it
+// previews as binary.
+var browserFiles = map[string]string{
+	"coverage.out":              "mode: set\ntotal coverage 91.2%",
+	"reports/summary.json":      `{"passed":12,"failed":0}`,
+	"reports/junit/results.xml": "<testsuites/>",
+}
+
+// downloads records what the browser asked to download, from the program's
+// goroutine.
+type downloads struct {
+	mu    sync.Mutex
+	items []ui.RunGetArtifactItem
+	dir   string
+}
+
+func (d *downloads) paths() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return artifactItemPaths(d.items)
+}
+
+func startBrowser(t *testing.T, items []ui.RunGetArtifactItem, dl *downloads) *teatest.TestModel {
+	t.Helper()
+	m := ui.NewArtifactBrowser(context.Background(), ui.ArtifactBrowserOptions{
+		Items: items,
+		Dir:   "./out",
+		FetchContent: func(_ context.Context, item ui.RunGetArtifactItem) ([]byte, bool, error) {
+			content, ok := browserFiles[item.Path]
+			if !ok {
+				return []byte{0x7f, 'E', 'L', 'F', 0}, false, nil
+			}
+			return []byte(content), true, nil
+		},
+		Download: func(_ context.Context, items []ui.RunGetArtifactItem, dir string) error {
+			dl.mu.Lock()
+			defer dl.mu.Unlock()
+			dl.items = append(dl.items, items...)
+			dl.dir = dir
+			return nil
+		},
+	})
+	tm := teatest.NewTestModel(t, browserHarness{m: m}, teatest.WithInitialTermSize(100, 24))
+	t.Cleanup(func() {
+		tm.Send(quitMsg{})
+		tm.WaitFinished(t, teatest.WithFinalTimeout(teaTimeout))
+	})
+	waitForFrame(t, tm, "Artifacts /")
+	return tm
+}
+
+var (
+	keySpace   = tea.KeyPressMsg{Code: tea.KeySpace, Text: " "}
+	browserSet = []ui.RunGetArtifactItem{
+		artifactItem("coverage.out"),
+		artifactItem("reports/junit/results.xml"),
+		artifactItem("reports/summary.json"),
+		artifactItem("bin/app"),
+	}
+)
+
+// TestArtifactBrowser_Preview walks the cursor over each kind of row and checks
+// the pane beside the tree follows it.
+func TestArtifactBrowser_Preview(t *testing.T) {
+	tm := startBrowser(t, browserSet, &downloads{})
+
+	assert.Assert(t, t.Run("a directory lists the files beneath it", func(t *testing.T) {
+		// bin/ sorts first.
+		tm.Send(keyDown) // → reports/
+		waitForFrame(t, tm, "2 files")
+		v := flowSnapshot(t, tm)
+		assert.Check(t, cmp.Contains(v, "junit/results.xml"))
+		assert.Check(t, cmp.Contains(v, "summary.json"))
+	}))
+
+	assert.Assert(t, t.Run("a text file shows its first lines", func(t *testing.T) {
+		tm.Send(keyDown) // → coverage.out
+		waitForFrame(t, tm, "total coverage 91.2%")
+	}))
+
+	assert.Assert(t, t.Run("JSON is pretty-printed", func(t *testing.T) {
+		tm.Send(keyUp)
+		tm.Send(keyRight) // into reports/
+		tm.Send(keyDown)  // → summary.json
+		waitForFrame(t, tm, `"passed": 12,`)
+	}))
+
+	assert.Assert(t, t.Run("a binary file points at the download key", func(t *testing.T) {
+		tm.Send(keyLeft)
+		tm.Send(keyUp)    // → bin/
+		tm.Send(keyRight) // → app
+		waitForFrame(t, tm, "Not text or an image")
+	}))
+}
+
+// TestArtifactBrowser_MarkAndDownload marks files across directories and
+// downloads them together, under the per-execution layout a parallel job's
+// artifacts are written with.
+func TestArtifactBrowser_MarkAndDownload(t *testing.T) {
+	items := []ui.RunGetArtifactItem{
+		{Path: "coverage.out", URL: "https://artifacts.example/0", Execution: 0},
+		{Path: "coverage.out", URL: "https://artifacts.example/1", Execution: 1},
+		{Path: "log.txt", URL: "https://artifacts.example/2", Execution: 1},
+	}
+	dl := &downloads{}
+	tm := startBrowser(t, items, dl)
+
+	assert.Assert(t, t.Run("executions are grouped by directory", func(t *testing.T) {
+		v := flowSnapshot(t, tm)
+		assert.Check(t, cmp.Contains(v, "exec-0000/"))
+		assert.Check(t, cmp.Contains(v, "exec-0001/"))
+	}))
+
+	assert.Assert(t, t.Run("space marks, and the note counts the marks", func(t *testing.T) {
+		tm.Send(keySpace) // all of exec-0000/
+		tm.Send(keyDown)
+		tm.Send(keyRight) // into exec-0001/
+		tm.Send(keyDown)  // → log.txt
+		tm.Send(keySpace)
+		waitForFrame(t, tm, "2 files marked")
+	}))
+
+	assert.Assert(t, t.Run("enter downloads every marked file", func(t *testing.T) {
+		tm.Send(keyEnt)
+		waitForFrame(t, tm, "Downloaded 2 files to ./out")
+		assert.Check(t, cmp.DeepEqual(dl.paths(), []string{"exec-0000/coverage.out", "exec-0001/log.txt"}))
+		assert.Check(t, !strings.Contains(flowSnapshot(t, tm), "marked"))
+	}))
+
+	assert.Assert(t, t.Run("with nothing marked, enter downloads the highlighted file", func(t *testing.T) {
+		tm.Send(keyUp) // → coverage.out
+		tm.Send(keyEnt)
+		waitForFrame(t, tm, "Downloaded 1 file to ./out")
+		assert.Check(t, cmp.Contains(strings.Join(dl.paths(), " "), "exec-0001/coverage.out"))
+	}))
+}
+
+// TestArtifactBrowser_ViewInPager opens a file full screen, searches it, and
+// comes back to the tree.
+func TestArtifactBrowser_ViewInPager(t *testing.T) {
+	tm := startBrowser(t, browserSet, &downloads{})
+	tm.Send(keyDown)
+	tm.Send(keyDown) // → coverage.out
+
+	assert.Assert(t, t.Run("→ on a file pages it", func(t *testing.T) {
+		tm.Send(keyRight)
+		waitForFrame(t, tm, "scroll")
+		assert.Check(t, cmp.Contains(flowSnapshot(t, tm), "total coverage 91.2%"))
+	}))
+
+	assert.Assert(t, t.Run("/ searches the page", func(t *testing.T) {
+		tm.Send(keySlash)
+		tm.Send(tea.KeyPressMsg{Code: 'm', Text: "m"})
+		tm.Send(keyEnt)
+		waitForFrame(t, tm, "/m 1/1")
+	}))
+
+	assert.Assert(t, t.Run("esc clears the search, then returns to the tree", func(t *testing.T) {
+		tm.Send(keyEsc)
+		tm.Send(keyEsc)
+		waitForFrame(t, tm, "Artifacts /")
+	}))
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package ui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"github.com/google/uuid"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/ui/components"
+	"github.com/CircleCI-Public/circleci-cli/clikit/ui/theme"
+)
+
+// ResourceUsagePollInterval is how often `job resource-usage get --follow`
+// polls a running job. The platform samples every few seconds, so polling
+// faster only redraws the same chart.
+const ResourceUsagePollInterval = 5 * time.Second
+
+// ResourceUsageFollowResult is the outcome of a completed
+// ResourceUsageFollowModel, read via Result() after tea.Program.Run() returns.
+// When neither Cancelled nor Err is set, the job finished.
+type ResourceUsageFollowResult struct {
+	Cancelled bool
+	Err       error
+}
+
+// ResourceUsageFollowOptions configures a ResourceUsageFollowModel. Fetch is
+// called once on start and then every PollInterval; it returns a renderer for
+// the latest usage, which the view calls with the terminal width, and whether
+// the job has finished.
+type ResourceUsageFollowOptions struct {
+	JobID uuid.UUID
+
+	Color   bool
+	Animate bool
+
+	// PollInterval overrides ResourceUsagePollInterval when set.
+	PollInterval time.Duration
+
+	Fetch func(ctx context.Context) (render func(width int) string, done bool, err error)
+}
+
+type (
+	resourceUsageFrameMsg struct {
+		render func(width int) string
+		done   bool
+		err    error
+	}
+	resourceUsagePollMsg struct{}
+)
+
+var resourceUsageFollowKeys = []key.Binding{components.BindQuit}
+
+// ResourceUsageFollowModel is the bubbletea program behind `circleci job
+// resource-usage get --follow` in an interactive terminal: the usage report,
+// redrawn in place each time the job is polled, until the job ends or the user
+// stops following. Like the run watch flow it is inline rather than
+// full-screen, so the last frame stays on screen when it exits.
+type ResourceUsageFollowModel struct {
+	ctx  context.Context
+	opts ResourceUsageFollowOptions
+
+	width  int
+	start  time.Time
+	spin   spinner.Model
+	render func(width int) string
+
+	done   bool
+	result ResourceUsageFollowResult
+}
+
+// NewResourceUsageFollow returns a ResourceUsageFollowModel ready to pass to
+// tea.NewProgram.
+func NewResourceUsageFollow(ctx context.Context, opts ResourceUsageFollowOptions) ResourceUsageFollowModel {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = ResourceUsagePollInterval
+	}
+	return ResourceUsageFollowModel{
+		ctx:   ctx,
+		opts:  opts,
+		start: time.Now(),
+		spin:  components.NewSpinner(opts.Color),
+	}
+}
+
+// Result returns the final outcome. Only valid after tea.Program.Run() returns.
+func (m ResourceUsageFollowModel) Result() ResourceUsageFollowResult { return m.result }
+
+func (m ResourceUsageFollowModel) Init() tea.Cmd {
+	cmds := []tea.Cmd{m.cmdFetch()}
+	if m.opts.Animate {
+		cmds = append(cmds, m.spin.Tick)
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m ResourceUsageFollowModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		return m, nil
+
+	case tea.KeyPressMsg:
+		if key.Matches(msg, components.KeyCtrlC, components.KeyEsc, components.BindQuit) {
+			m.result.Cancelled = true
+			return m.quit()
+		}
+		return m, nil
+
+	case resourceUsageFrameMsg:
+		return m.onFrame(msg)
+
+	case resourceUsagePollMsg:
+		if m.done {
+			return m, nil
+		}
+		return m, m.cmdFetch()
+
+	case spinner.TickMsg:
+		if m.done {
+			return m, nil
+		}
+		s, cmd := m.spin.Update(msg)
+		m.spin = s
+		return m, cmd
+	}
+	return m, nil
+}
+
+// onFrame keeps the latest usage and schedules the next poll, or ends the
+// program once the job has. A fetch failure ends it too, for the reason the
+// run watch flow gives: polling on through errors would hide an expired token
+// behind a chart that has stopped moving.
+func (m ResourceUsageFollowModel) onFrame(msg resourceUsageFrameMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		if errors.Is(msg.err, context.Canceled) {
+			m.result.Cancelled = true
+		} else {
+			m.result.Err = msg.err
+		}
+		return m.quit()
+	}
+	m.render = msg.render
+	if msg.done {
+		return m.quit()
+	}
+	return m, tea.Tick(m.opts.PollInterval, func(time.Time) tea.Msg { return resourceUsagePollMsg{} })
+}
+
+func (m ResourceUsageFollowModel) quit() (tea.Model, tea.Cmd) {
+	m.done = true
+	return m, tea.Quit
+}
+
+func (m ResourceUsageFollowModel) cmdFetch() tea.Cmd {
+	ctx, fetch := m.ctx, m.opts.Fetch
+	return func() tea.Msg {
+		if fetch == nil {
+			return resourceUsageFrameMsg{done: true}
+		}
+		render, done, err := fetch(ctx)
+		return resourceUsageFrameMsg{render: render, done: done, err: err}
+	}
+}
+
+// --- view ---
+
+func (m ResourceUsageFollowModel) View() tea.View {
+	return components.WithWindowTitle(tea.NewView(m.buildView()), components.FlowTitle("job resource-usage"))
+}
+
+func (m ResourceUsageFollowModel) buildView() string {
+	var b strings.Builder
+	b.WriteString(theme.TitleStyle.Render("Following job "+m.opts.JobID.String()) + "\n\n")
+
+	if m.render == nil {
+		b.WriteString("  " + m.spinnerPrefix() + m.muted("Fetching resource usage…") + "\n")
+		return b.String()
+	}
+	b.WriteString(strings.TrimRight(m.render(m.width), "\n") + "\n")
+
+	// As with the run watch flow, the footer goes once the job has ended: the
+	// final frame is the finished report alone.
+	if !m.done {
+		b.WriteString("\n  " + m.spinnerPrefix() +
+			m.muted("Elapsed "+FormatElapsed(time.Since(m.start))+" · updates every "+FormatElapsed(m.opts.PollInterval)) + "\n")
+		b.WriteString("  " + components.Hints(resourceUsageFollowKeys...) + "\n")
+	}
+	return b.String()
+}
+
+func (m ResourceUsageFollowModel) spinnerPrefix() string {
+	if m.done || !m.opts.Animate {
+		return ""
+	}
+	return m.spin.View() + " "
+}
+
+func (m ResourceUsageFollowModel) muted(s string) string {
+	if m.opts.Color {
+		return theme.HelperStyle.Render(s)
+	}
+	return s
+}
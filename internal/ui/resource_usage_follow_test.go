@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package ui_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/exp/teatest/v2"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/ui"
+)
+
+var followJobID = uuid.MustParse("8e50c384-0083-43d0-bc8f-93f0db589d6b")
+
+// followHarness drives a ResourceUsageFollowModel the way watchHarness drives
+// the run watch flow: snapshots and quit requests are answered inside the
+// loop, and the inner model's result is exposed for the final assertions.
+type followHarness struct {
+	m ui.ResourceUsageFollowModel
+}
+
+func (h followHarness) Init() tea.Cmd { return h.m.Init() }
+
+func (h followHarness) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case quitMsg:
+		return h, tea.Quit
+	case snapshotMsg:
+		msg.frame <- h.m.View().Content
+		return h, nil
+	}
+	u, cmd := h.m.Update(msg)
+	h.m = u.(ui.ResourceUsageFollowModel)
+	return h, cmd
+}
+
+func (h followHarness) View() tea.View { return h.m.View() }
+
+func startFollow(t *testing.T, opts ui.ResourceUsageFollowOptions) *teatest.TestModel {
+	t.Helper()
+	opts.JobID = followJobID
+	if opts.PollInterval == 0 {
+		opts.PollInterval = 10 * time.Millisecond
+	}
+	tm := teatest.NewTestModel(t, followHarness{m: ui.NewResourceUsageFollow(context.Background(), opts)},
+		teatest.WithInitialTermSize(80, 24))
+	t.Cleanup(func() {
+		tm.Send(quitMsg{})
+		tm.WaitFinished(t, teatest.WithFinalTimeout(teaTimeout))
+	})
+	return tm
+}
+
+func followResult(t *testing.T, tm *teatest.TestModel) ui.ResourceUsageFollowResult {
+	t.Helper()
+	tm.WaitFinished(t, teatest.WithFinalTimeout(teaTimeout))
+	return tm.FinalModel(t).(followHarness).m.Result()
+}
+
+// fetchSamples reports one more sample on each poll, and the job done once it
+// has n. The renderer echoes the width it was given, so the view can be seen
+// to pass the terminal's along.
+func fetchSamples(n int32) (func(context.Context) (func(int) string, bool, error), *atomic.Int32) {
+	var calls atomic.Int32
+	return func(context.Context) (func(int) string, bool, error) {
+		c := calls.Add(1)
+		return func(width int) string {
+			return strconv.Itoa(int(c)) + " samples at width " + strconv.Itoa(width)
+		}, c >= n, nil
+	}, &calls
+}
+
+// TestResourceUsageFollow_Live confirms the report is redrawn as polls come
+// in, under a header naming the job and above a footer with the cadence.
+func TestResourceUsageFollow_Live(t *testing.T) {
+	fetch, _ := fetchSamples(1 << 30)
+	tm := startFollow(t, ui.ResourceUsageFollowOptions{Fetch: fetch, PollInterval: time.Hour})
+
+	waitForOutput(t, tm, "samples at width")
+	view := flowSnapshot(t, tm)
+
+	t.Run("header", func(t *testing.T) {
+		assert.Check(t, cmp.Contains(view, "Following job "+followJobID.String()))
+	})
+
+	t.Run("report is drawn at the terminal width", func(t *testing.T) {
+		assert.Check(t, cmp.Contains(view, "1 samples at width 80"))
+	})
+
+	t.Run("footer", func(t *testing.T) {
+		assert.Check(t, cmp.Contains(view, "updates every 1h"))
+		assert.Check(t, cmp.Contains(view, "q quit"))
+	})
+}
+
+// TestResourceUsageFollow_EndsWithTheJob confirms the flow polls on its own
+// until the job ends, and that the final frame is the report without a footer.
+func TestResourceUsageFollow_EndsWithTheJob(t *testing.T) {
+	fetch, calls := fetchSamples(3)
+	tm := startFollow(t, ui.ResourceUsageFollowOptions{Fetch: fetch})
+
+	res := followResult(t, tm)
+	final := tm.FinalModel(t).(followHarness).m.View().Content
+
+	assert.Check(t, !res.Cancelled)
+	assert.Check(t, res.Err == nil)
+	assert.Check(t, cmp.Equal(calls.Load(), int32(3)))
+	assert.Check(t, cmp.Contains(final, "3 samples"))
+	assert.Check(t, !strings.Contains(final, "q quit"), "final frame kept its footer:\n%s", final)
+}
+
+// TestResourceUsageFollow_QuitCancels confirms stopping early is a
+// cancellation: the job is still running, so the caller must not report it
+// finished.
+func TestResourceUsageFollow_QuitCancels(t *testing.T) {
+	fetch, _ := fetchSamples(1 << 30)
+	tm := startFollow(t, ui.ResourceUsageFollowOptions{Fetch: fetch, PollInterval: time.Hour})
+	waitForOutput(t, tm, "samples at width")
+
+	tm.Send(keyQ)
+	res := followResult(t, tm)
+
+	assert.Check(t, res.Cancelled)
+	assert.Check(t, res.Err == nil)
+}
+
+// TestResourceUsageFollow_FetchErrorEnds confirms a failed poll ends the flow
+// with the error rather than leaving a stale chart on screen.
+func TestResourceUsageFollow_FetchErrorEnds(t *testing.T) {
+	boom := errors.New("token expired")
+	tm := startFollow(t, ui.ResourceUsageFollowOptions{
+		Fetch: func(context.Context) (func(int) string, bool, error) { return nil, false, boom },
+	})
+
+	res := followResult(t, tm)
+
+	assert.Check(t, cmp.ErrorIs(res.Err, boom))
+	assert.Check(t, !res.Cancelled)
+}
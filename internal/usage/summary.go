@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package usage
+
+import (
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/CircleCI-Public/circleci-cli/clikit/mdtable"
+)
+
+// The export columns a summary reads. Headers are matched case-insensitively.
+const (
+	columnProject       = "project_name"
+	columnResourceClass = "resource_class"
+	columnCredits       = "total_credits"
+)
+
+// Credits is the credits one project or resource class used.
+type Credits struct {
+	Name    string  `json:"name"`
+	Credits float64 `json:"credits"`
+}
+
+// Summary totals an export's credits, and the projects and resource classes
+// that used the most of them.
+type Summary struct {
+	TotalCredits    float64   `json:"total_credits"`
+	Rows            int       `json:"rows"`
+	Projects        []Credits `json:"projects"`
+	ResourceClasses []Credits `json:"resource_classes"`
+}
+
+// Summarize reads the CSV files at paths and totals their credits by project
+// and by resource class, keeping the top entries of each.
+func Summarize(paths []string, top int) (Summary, error) {
+	var s Summary
+	projects := map[string]float64{}
+	classes := map[string]float64{}
+	for _, p := range paths {
+		if err := summarizeFile(p, &s, projects, classes); err != nil {
+			return Summary{}, fmt.Errorf("summarizing %s: %w", p, err)
+		}
+	}
+	s.Projects = topCredits(projects, top)
+	s.ResourceClasses = topCredits(classes, top)
+	return s, nil
+}
+
+func summarizeFile(path string, s *Summary, projects, classes map[string]float64) error {
+	f, err := os.Open(path) //#nosec:G304 // a file Download just wrote
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	r := csv.NewReader(f)
+	r.ReuseRecord = true
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	col := map[string]int{}
+	for i, h := range header {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	credits, ok := col[columnCredits]
+	if !ok {
+		return fmt.Errorf("no %s column", strings.ToUpper(columnCredits))
+	}
+	project, hasProject := col[columnProject]
+	class, hasClass := col[columnResourceClass]
+
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseFloat(strings.TrimSpace(rec[credits]), 64)
+		if err != nil {
+			// Blank for rows that used no credits.
+			n = 0
+		}
+		s.Rows++
+		s.TotalCredits += n
+		if hasProject {
+			projects[nameOrUnknown(rec[project])] += n
+		}
+		if hasClass {
+			classes[nameOrUnknown(rec[class])] += n
+		}
+	}
+}
+
+func nameOrUnknown(s string) string {
+	if s = strings.TrimSpace(s); s != "" {
+		return s
+	}
+	return "(none)"
+}
+
+// topCredits is the n largest totals, most credits first; ties are broken by
+// name so the order is stable.
+func topCredits(totals map[string]float64, n int) []Credits {
+	out := make([]Credits, 0, len(totals))
+	for name, c := range totals {
+		out = append(out, Credits{Name: name, Credits: c})
+	}
+	slices.SortFunc(out, func(a, b Credits) int {
+		return cmp.Or(cmp.Compare(b.Credits, a.Credits), cmp.Compare(a.Name, b.Name))
+	})
+	if n > 0 && len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+// FormatSummaryMarkdown renders s as a Markdown report with a table each for
+// projects and resource classes.
+func FormatSummaryMarkdown(s Summary) string {
+	var md strings.Builder
+	md.WriteString("# Usage Summary\n")
+	_, _ = fmt.Fprintf(&md, "- Total credits: %s\n", formatCredits(s.TotalCredits))
+	_, _ = fmt.Fprintf(&md, "- Rows: %d\n", s.Rows)
+	writeCreditsTable(&md, "Top Projects", "Project", s.Projects, s.TotalCredits)
+	writeCreditsTable(&md, "Top Resource Classes", "Resource class", s.ResourceClasses, s.TotalCredits)
+	return md.String()
+}
+
+func writeCreditsTable(md *strings.Builder, title, heading string, rows []Credits, total float64) {
+	if len(rows) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(md, "\n## %s\n\n", title)
+	t := mdtable.New(heading, "Credits", "Share")
+	for _, c := range rows {
+		share := "-"
+		if total > 0 {
+			share = fmt.Sprintf("%.1f%%", c.Credits/total*100)
+		}
+		t.Row(c.Name, formatCredits(c.Credits), share)
+	}
+	md.WriteString(t.Render())
+}
+
+// formatCredits prints whole credits without a fraction, and fractional ones
+// to two places.
+func formatCredits(c float64) string {
+	if c == float64(int64(c)) {
+		return strconv.FormatInt(int64(c), 10)
+	}
+	return strconv.FormatFloat(c, 'f', 2, 64)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package usage implements downloading and summarizing an organization's
+// usage exports.
+package usage
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/bulkhead"
+)
+
+// maxDownloads bounds the export files fetched at once.
+const maxDownloads = 4
+
+// Client is the subset of apiclient.Client methods we need.
+type Client interface {
+	DownloadUsageExport(ctx context.Context, downloadURL string, dst io.Writer) error
+}
+
+// Download fetches each of an export's files into dir, decompressing them as
+// they arrive, and returns the paths written in the order of urls. A file is
+// written under a temporary name and renamed into place once complete, so an
+// interrupted download never leaves a truncated CSV behind.
+func Download(ctx context.Context, client Client, urls []string, dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	paths := fileNames(urls)
+	for i, name := range paths {
+		paths[i] = filepath.Join(dir, name)
+	}
+	err := bulkhead.Do(ctx, maxDownloads, urls, func(u string, i int) error {
+		if err := downloadOne(ctx, client, u, paths[i]); err != nil {
+			return fmt.Errorf("downloading %s: %w", filepath.Base(paths[i]), err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// fileNames names the file each URL is saved as: the last segment of its path
+// without the ".gz", or a numbered name for a URL with none. Names are made
+// unique, since nothing promises the export's files have distinct ones.
+func fileNames(urls []string) []string {
+	names := make([]string, len(urls))
+	seen := map[string]bool{}
+	for i, raw := range urls {
+		name := ""
+		if u, err := url.Parse(raw); err == nil {
+			name = strings.TrimSuffix(path.Base(u.Path), ".gz")
+		}
+		if name == "" || name == "." || name == "/" {
+			name = fmt.Sprintf("usage-%d.csv", i+1)
+		}
+		for n := 2; seen[name]; n++ {
+			ext := filepath.Ext(name)
+			name = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, ext), n, ext)
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+func downloadOne(ctx context.Context, client Client, downloadURL, dest string) error {
+	part := dest + ".part"
+	f, err := os.Create(part) //#nosec:G304 // dest is built from the output directory the user chose
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(part) }()
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(client.DownloadUsageExport(ctx, downloadURL, pw))
+	}()
+	err = decompress(f, pr)
+	_ = pr.CloseWithError(err)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(part, dest)
+}
+
+// decompress copies r to w, gunzipping it when it is gzipped. The export's
+// files are, but a storage layer that has already decoded them — or a proxy
+// that honoured Content-Encoding — hands over plain CSV.
+func decompress(w io.Writer, r io.Reader) error {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(2)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if len(magic) < 2 || magic[0] != 0x1f || magic[1] != 0x8b {
+		_, err = io.Copy(w, br)
+		return err
+	}
+	gz, err := gzip.NewReader(br)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = gz.Close() }()
+	_, err = io.Copy(w, gz) //#nosec:G110 // the export is the organization's own data, fetched on request
+	return err
+}
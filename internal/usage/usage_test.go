@@ -0,0 +1,170 @@
+// Copyright (c) 2026 Circle Internet Services, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package usage_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+
+	"github.com/CircleCI-Public/circleci-cli/internal/usage"
+)
+
+// storeClient serves each URL's body from files, failing for any other.
+type storeClient struct {
+	files map[string][]byte
+}
+
+func (c *storeClient) DownloadUsageExport(_ context.Context, downloadURL string, dst io.Writer) error {
+	body, ok := c.files[downloadURL]
+	if !ok {
+		return errors.New("404 Not Found")
+	}
+	_, err := dst.Write(body)
+	return err
+}
+
+func gzipped(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write([]byte(s))
+	assert.NilError(t, err)
+	assert.NilError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path) //#nosec:G304 // a file the test just downloaded
+	assert.NilError(t, err)
+	return string(b)
+}
+
+func TestDownload(t *testing.T) {
+	store := &storeClient{files: map[string][]byte{
+		"https://s3/a/usage.csv.gz?sig=1": gzipped(t, "PROJECT_NAME,TOTAL_CREDITS\napi,10\n"),
+		"https://s3/b/usage.csv.gz?sig=2": gzipped(t, "PROJECT_NAME,TOTAL_CREDITS\nweb,5\n"),
+		"https://s3/plain.csv":            []byte("PROJECT_NAME,TOTAL_CREDITS\ndocs,1\n"),
+		"https://s3/":                     gzipped(t, ""),
+	}}
+	dir := filepath.Join(t.TempDir(), "out")
+
+	paths, err := usage.Download(context.Background(), store, []string{
+		"https://s3/a/usage.csv.gz?sig=1",
+		"https://s3/b/usage.csv.gz?sig=2",
+		"https://s3/plain.csv",
+		"https://s3/",
+	}, dir)
+	assert.NilError(t, err)
+
+	t.Run("files are named from their URLs, made unique, in order", func(t *testing.T) {
+		assert.Check(t, cmp.DeepEqual(paths, []string{
+			filepath.Join(dir, "usage.csv"),
+			filepath.Join(dir, "usage-2.csv"),
+			filepath.Join(dir, "plain.csv"),
+			filepath.Join(dir, "usage-4.csv"),
+		}))
+	})
+
+	t.Run("gzipped files are decompressed and plain ones copied", func(t *testing.T) {
+		assert.Check(t, cmp.Equal(readFile(t, paths[0]), "PROJECT_NAME,TOTAL_CREDITS\napi,10\n"))
+		assert.Check(t, cmp.Equal(readFile(t, paths[1]), "PROJECT_NAME,TOTAL_CREDITS\nweb,5\n"))
+		assert.Check(t, cmp.Equal(readFile(t, paths[2]), "PROJECT_NAME,TOTAL_CREDITS\ndocs,1\n"))
+		assert.Check(t, cmp.Equal(readFile(t, paths[3]), ""))
+	})
+}
+
+func TestDownloadFailureLeavesNoPartialFile(t *testing.T) {
+	store := &storeClient{files: map[string][]byte{
+		// Truncated gzip: the header is there, the stream ends early.
+		"https://s3/broken.csv.gz": gzipped(t, strings.Repeat("x", 1000))[:20],
+	}}
+	dir := t.TempDir()
+
+	_, err := usage.Download(context.Background(), store, []string{"https://s3/broken.csv.gz", "https://s3/missing.csv.gz"}, dir)
+	assert.Check(t, err != nil)
+
+	entries, err := os.ReadDir(dir)
+	assert.NilError(t, err)
+	assert.Check(t, cmp.Len(entries, 0))
+}
+
+func TestSummarize(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		p := filepath.Join(dir, name)
+		assert.NilError(t, os.WriteFile(p, []byte(content), 0o600))
+		return p
+	}
+	paths := []string{
+		write("a.csv", "ORGANIZATION_NAME,PROJECT_NAME,RESOURCE_CLASS,TOTAL_CREDITS\n"+
+			"acme,api,medium,100\n"+
+			"acme,web,large,40.5\n"+
+			"acme,api,large,20\n"),
+		write("b.csv", "organization_name,project_name,resource_class,total_credits\n"+
+			"acme,docs,small,\n"+
+			"acme,,medium,9.5\n"),
+		write("empty.csv", ""),
+	}
+
+	s, err := usage.Summarize(paths, 2)
+	assert.NilError(t, err)
+
+	t.Run("credits are totalled across files", func(t *testing.T) {
+		assert.Check(t, cmp.Equal(s.TotalCredits, 170.0))
+		assert.Check(t, cmp.Equal(s.Rows, 5))
+	})
+
+	t.Run("only the top entries are kept, most credits first", func(t *testing.T) {
+		assert.Check(t, cmp.DeepEqual(s.Projects, []usage.Credits{
+			{Name: "api", Credits: 120},
+			{Name: "web", Credits: 40.5},
+		}))
+		assert.Check(t, cmp.DeepEqual(s.ResourceClasses, []usage.Credits{
+			{Name: "medium", Credits: 109.5},
+			{Name: "large", Credits: 60.5},
+		}))
+	})
+
+	t.Run("markdown lists totals and shares", func(t *testing.T) {
+		md := usage.FormatSummaryMarkdown(s)
+		assert.Check(t, cmp.Contains(md, "- Total credits: 170\n"))
+		assert.Check(t, cmp.Contains(md, "| api     | 120     | 70.6% |"))
+		assert.Check(t, cmp.Contains(md, "| web     | 40.50   | 23.8% |"))
+	})
+
+	t.Run("a file without a credits column is an error", func(t *testing.T) {
+		_, err := usage.Summarize([]string{write("bad.csv", "PROJECT_NAME\napi\n")}, 10)
+		assert.Check(t, cmp.ErrorContains(err, "no TOTAL_CREDITS column"))
+	})
+}